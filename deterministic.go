@@ -0,0 +1,30 @@
+package gotch
+
+import (
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// SetDeterministic sets whether libtorch should only use deterministic
+// algorithms. It also sets cudnn deterministic mode accordingly and turns off
+// cudnn benchmark mode when enabled, as benchmarking may select different
+// algorithms between runs.
+//
+// NOTE: when enabled, ops without a deterministic implementation fail. The
+// libtorch error is returned as a Go error by the corresponding tensor
+// function (or makes its `Must` variant exit).
+func SetDeterministic(b bool) {
+	switch b {
+	case true:
+		lib.AtcSetDeterministic(1)
+		lib.AtcSetDeterministicCudnn(1)
+		lib.AtcSetBenchmarkCudnn(0)
+	case false:
+		lib.AtcSetDeterministic(0)
+		lib.AtcSetDeterministicCudnn(0)
+	}
+}
+
+// IsDeterministic returns whether libtorch only uses deterministic algorithms.
+func IsDeterministic() bool {
+	return lib.AtcIsDeterministic()
+}
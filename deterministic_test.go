@@ -0,0 +1,21 @@
+package gotch_test
+
+import (
+	"testing"
+
+	"github.com/sugarme/gotch"
+)
+
+func TestSetDeterministic(t *testing.T) {
+	defer gotch.SetDeterministic(gotch.IsDeterministic())
+
+	gotch.SetDeterministic(true)
+	if !gotch.IsDeterministic() {
+		t.Errorf("Expected deterministic mode to be enabled\n")
+	}
+
+	gotch.SetDeterministic(false)
+	if gotch.IsDeterministic() {
+		t.Errorf("Expected deterministic mode to be disabled\n")
+	}
+}
@@ -87,7 +87,7 @@ func (d Device) CInt() CInt {
 func (d Device) OfCInt(v CInt) Device {
 	switch {
 	case v == -1:
-		return Device{Name: "CPU", Value: 1}
+		return CPU
 	case v >= 0:
 		return CudaBuilder(uint(v))
 	default:
@@ -124,3 +124,18 @@ func CudaIfAvailable() Device {
 		return CPU
 	}
 }
+
+// CudaIsAvailable returns true if cuda support is available.
+func CudaIsAvailable() bool {
+	return CUDA.IsAvailable()
+}
+
+// CudaDeviceCount returns the number of GPU that can be used.
+func CudaDeviceCount() int {
+	return int(CUDA.DeviceCount())
+}
+
+// CudnnIsAvailable returns true if cudnn support is available.
+func CudnnIsAvailable() bool {
+	return CUDA.CudnnIsAvailable()
+}
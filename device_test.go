@@ -0,0 +1,40 @@
+package gotch_test
+
+import (
+	"testing"
+
+	"github.com/sugarme/gotch"
+)
+
+func TestCudaIfAvailable(t *testing.T) {
+	device := gotch.CudaIfAvailable()
+
+	if !gotch.CudaIsAvailable() {
+		if device != gotch.CPU {
+			t.Errorf("Expected CPU device on a CPU-only build, got %v\n", device)
+		}
+		if gotch.CudaDeviceCount() != 0 {
+			t.Errorf("Expected no cuda device on a CPU-only build, got %v\n", gotch.CudaDeviceCount())
+		}
+		return
+	}
+
+	if !device.IsCuda() {
+		t.Errorf("Expected a cuda device when cuda is available, got %v\n", device)
+	}
+	if gotch.CudaDeviceCount() < 1 {
+		t.Errorf("Expected at least 1 cuda device, got %v\n", gotch.CudaDeviceCount())
+	}
+}
+
+func TestOfCInt(t *testing.T) {
+	var d gotch.Device
+	if got := d.OfCInt(-1); got != gotch.CPU {
+		t.Errorf("Expected device: %v\n", gotch.CPU)
+		t.Errorf("Got device: %v\n", got)
+	}
+	if got := d.OfCInt(1); got != gotch.CudaBuilder(1) {
+		t.Errorf("Expected device: %v\n", gotch.CudaBuilder(1))
+		t.Errorf("Got device: %v\n", got)
+	}
+}
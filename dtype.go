@@ -16,27 +16,44 @@ type DType struct {
 	reflect.Type
 }
 
+// GoFloat16 holds the bits of an IEEE 754 half-precision float as Go has no
+// float16 type. See `Float32ToHalf` and `GoFloat16.Float32` for conversions.
+// Ref: https://github.com/golang/go/issues/32022
+type GoFloat16 uint16
+
+// GoBFloat16 holds the bits of a bfloat16 (brain floating point) value, i.e.
+// the upper 16 bits of a float32. See `Float32ToBFloat16` and
+// `GoBFloat16.Float32` for conversions.
+type GoBFloat16 uint16
+
+// GoQInt8, GoQUInt8 and GoQInt32 are the integer representations of
+// quantized values. Real values are `scale * (q - zeroPoint)`.
+type GoQInt8 int8
+type GoQUInt8 uint8
+type GoQInt32 int32
+
 /*
- * // Custom-made Float16 as not exist in Go
- * // Ref: https://github.com/golang/go/issues/32022
- * type GoFloat16 = int16 // not implemented yet
  * type GoComplexHalf = interface{} // not implemented yet!
  *  */
 
 // TODO: double check these Torch DType to Go type
 var (
-	Uint8 DType = DType{reflect.TypeOf(uint8(1))} // 0
-	Int8  DType = DType{reflect.TypeOf(int8(1))}  // 1
-	Int16 DType = DType{reflect.TypeOf(int16(1))} // 2
-	Int   DType = DType{reflect.TypeOf(int32(1))} // 3
-	Int64 DType = DType{reflect.TypeOf(int64(1))} // 4
-	// Half       DType   = DType{reflect.TypeOf(GoFloat16(1))}     // 5
-	Float  DType = DType{reflect.TypeOf(float32(1))} // 6
-	Double DType = DType{reflect.TypeOf(float64(1))} // 7
+	Uint8  DType = DType{reflect.TypeOf(uint8(1))}     // 0
+	Int8   DType = DType{reflect.TypeOf(int8(1))}      // 1
+	Int16  DType = DType{reflect.TypeOf(int16(1))}     // 2
+	Int    DType = DType{reflect.TypeOf(int32(1))}     // 3
+	Int64  DType = DType{reflect.TypeOf(int64(1))}     // 4
+	Half   DType = DType{reflect.TypeOf(GoFloat16(1))} // 5
+	Float  DType = DType{reflect.TypeOf(float32(1))}   // 6
+	Double DType = DType{reflect.TypeOf(float64(1))}   // 7
 	// ComplexHalf DType  = DType{reflect.TypeOf(GoComplexHalf(1))} // 8
-	// ComplexFloat DType  = DType{reflect.TypeOf(complex64(1))}  // 9
-	// ComplexDouble DType = DType{reflect.TypeOf(complex128(1))} // 10
-	Bool DType = DType{reflect.TypeOf(true)} // 11
+	ComplexFloat  DType = DType{reflect.TypeOf(complex64(1))}  // 9
+	ComplexDouble DType = DType{reflect.TypeOf(complex128(1))} // 10
+	Bool          DType = DType{reflect.TypeOf(true)}          // 11
+	QInt8         DType = DType{reflect.TypeOf(GoQInt8(1))}    // 12
+	QUInt8        DType = DType{reflect.TypeOf(GoQUInt8(1))}   // 13
+	QInt32        DType = DType{reflect.TypeOf(GoQInt32(1))}   // 14
+	BFloat16      DType = DType{reflect.TypeOf(GoBFloat16(1))} // 15
 )
 
 var dtypeGoType = map[DType]reflect.Type{
@@ -45,9 +62,17 @@ var dtypeGoType = map[DType]reflect.Type{
 	Int16:  reflect.TypeOf(int16(1)),
 	Int:    reflect.TypeOf(int32(1)),
 	Int64:  reflect.TypeOf(int64(1)),
+	Half:   reflect.TypeOf(GoFloat16(1)),
 	Float:  reflect.TypeOf(float32(1)),
 	Double: reflect.TypeOf(float64(1)),
 	Bool:   reflect.TypeOf(true),
+
+	ComplexFloat:  reflect.TypeOf(complex64(1)),
+	ComplexDouble: reflect.TypeOf(complex128(1)),
+	QInt8:         reflect.TypeOf(GoQInt8(1)),
+	QUInt8:        reflect.TypeOf(GoQUInt8(1)),
+	QInt32:        reflect.TypeOf(GoQInt32(1)),
+	BFloat16:      reflect.TypeOf(GoBFloat16(1)),
 }
 
 // ToDType infers and returns supported equivalent DType from given Go type
@@ -87,14 +112,23 @@ var dtypeCInt = map[DType]CInt{
 	Int16:  2,
 	Int:    3,
 	Int64:  4,
+	Half:   5,
 	Float:  6,
 	Double: 7,
 	Bool:   11,
+
+	ComplexFloat:  9,
+	ComplexDouble: 10,
+	QInt8:         12,
+	QUInt8:        13,
+	QInt32:        14,
+	BFloat16:      15,
 }
 
 func DType2CInt(dt DType) (retVal CInt, err error) {
 	if _, ok := dtypeCInt[dt]; !ok {
 		err = fmt.Errorf("Unsupported CInt conversion from DType: %v\n", dt)
+		return retVal, err
 	}
 
 	retVal = dtypeCInt[dt]
@@ -137,9 +171,17 @@ var dtypeSize = map[DType]uint{
 	Int16:  2,
 	Int:    4,
 	Int64:  8,
+	Half:   2,
 	Float:  4,
 	Double: 8,
 	Bool:   1,
+
+	ComplexFloat:  8,
+	ComplexDouble: 16,
+	QInt8:         1,
+	QUInt8:        1,
+	QInt32:        4,
+	BFloat16:      2,
 }
 
 // DTypeSize returns DType size in Bytes
@@ -212,7 +254,7 @@ func dataCheck(data interface{}, count int) (k reflect.Type, n int, err error) {
 
 		return goType, total, nil
 
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		total++
 		if goType.String() != "invalid" {
 			goType = v.Type()
@@ -234,7 +276,7 @@ func ElementGoType(data interface{}) (retVal reflect.Type, err error) {
 func elementType(data reflect.Value) (dataType reflect.Type, err error) {
 	dataKind := data.Kind()
 	switch dataKind {
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		dataType = data.Type()
 	case reflect.Slice, reflect.Array:
 		data = data.Elem()
@@ -327,14 +369,17 @@ func TypeOf(dt DType, shape []int64) (retVal reflect.Type, err error) {
  *  */
 
 var supportedTypes = map[reflect.Kind]bool{
-	reflect.Uint8:   true,
-	reflect.Int8:    true,
-	reflect.Int16:   true,
-	reflect.Int32:   true,
-	reflect.Int64:   true,
-	reflect.Float32: true,
-	reflect.Float64: true,
-	reflect.Bool:    true,
+	reflect.Uint8:      true,
+	reflect.Int8:       true,
+	reflect.Int16:      true,
+	reflect.Uint16:     true,
+	reflect.Int32:      true,
+	reflect.Int64:      true,
+	reflect.Float32:    true,
+	reflect.Float64:    true,
+	reflect.Complex64:  true,
+	reflect.Complex128: true,
+	reflect.Bool:       true,
 }
 
 var scalarTypes = map[reflect.Kind]bool{
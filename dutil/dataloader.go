@@ -2,16 +2,21 @@ package dutil
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
+	"time"
 )
 
 // DataLoader combines a dataset and a sampler and provides
 // an iterable over the given dataset.
 type DataLoader struct {
 	dataset   Dataset
+	sampler   Sampler
 	indexes   []int // order of samples in dataset for interation.
 	batchSize int
 	currIdx   int
+	baseSeed  int64 // base seed for per-worker random generators.
+	epoch     int   // number of times the loader has been reset.
 }
 
 func NewDataLoader(data Dataset, s Sampler) (*DataLoader, error) {
@@ -35,9 +40,12 @@ func NewDataLoader(data Dataset, s Sampler) (*DataLoader, error) {
 
 	return &DataLoader{
 		dataset:   data,
+		sampler:   s,
 		indexes:   s.Sample(),
 		batchSize: s.BatchSize(),
 		currIdx:   0,
+		baseSeed:  time.Now().UnixNano(),
+		epoch:     0,
 	}, nil
 }
 
@@ -61,7 +69,13 @@ func checkDKind(data Dataset) (DatasetKind, error) {
 	}
 }
 
-// Next acts as iterator to return next sample(s) from dataset.
+// Next acts as iterator to return next sample(s) from dataset in the order
+// drawn by the sampler.
+//
+// With a batch size greater than 1, a batch is returned as a slice of samples,
+// e.g. a `[]tensor.Sample` to be collated into batched tensors with
+// `tensor.Collate`. The last
+// batch of an epoch can be smaller unless the sampler drops it.
 func (dl *DataLoader) Next() (interface{}, error) {
 	if !dl.HasNext() {
 		err := fmt.Errorf("Next Error: no more item to iterate.\n")
@@ -70,7 +84,7 @@ func (dl *DataLoader) Next() (interface{}, error) {
 
 	// Non-batching
 	if dl.batchSize == 1 {
-		item, err := dl.dataset.Item(dl.currIdx)
+		item, err := dl.dataset.Item(dl.indexes[dl.currIdx])
 		if err != nil {
 			return nil, err
 		}
@@ -95,7 +109,7 @@ func (dl *DataLoader) Next() (interface{}, error) {
 		nextIndex = len(dl.indexes)
 	}
 	for i := dl.currIdx; i < nextIndex; i++ {
-		item, err := dl.dataset.Item(i)
+		item, err := dl.dataset.Item(dl.indexes[i])
 		if err != nil {
 			return nil, err
 		}
@@ -111,7 +125,54 @@ func (dl *DataLoader) HasNext() bool {
 	return dl.currIdx < len(dl.indexes)
 }
 
-// Reset reset index to start position.
+// Reset reset index to start position and moves to next epoch.
+//
+// Samples are drawn again from the sampler, e.g. a shuffling BatchSampler
+// reshuffles them for the new epoch.
 func (dl *DataLoader) Reset() {
+	dl.indexes = dl.sampler.Sample()
 	dl.currIdx = 0
+	dl.epoch += 1
+}
+
+// SetBaseSeed sets the base seed from which per-worker seeds are derived.
+//
+// Setting the same base seed makes random augmentations using `Rand`
+// reproducible across runs.
+func (dl *DataLoader) SetBaseSeed(seed int64) {
+	dl.baseSeed = seed
+}
+
+// Epoch returns the current epoch (number of calls to `Reset`).
+func (dl *DataLoader) Epoch() int {
+	return dl.epoch
+}
+
+// WorkerSeed returns the seed for a given worker at the current epoch.
+func (dl *DataLoader) WorkerSeed(workerID int) int64 {
+	return WorkerSeed(dl.baseSeed, workerID, dl.epoch)
+}
+
+// Rand returns a random generator seeded for a given worker at the current
+// epoch. Workers should use it for random data augmentation.
+func (dl *DataLoader) Rand(workerID int) *rand.Rand {
+	return rand.New(rand.NewSource(dl.WorkerSeed(workerID)))
+}
+
+// WorkerSeed derives a deterministic seed from a base seed, a worker id and an
+// epoch so that every (worker, epoch) pair gets a distinct random stream.
+func WorkerSeed(baseSeed int64, workerID, epoch int) int64 {
+	h := splitMix64(uint64(baseSeed))
+	h = splitMix64(h ^ uint64(workerID))
+	h = splitMix64(h ^ uint64(epoch))
+
+	return int64(h)
+}
+
+// splitMix64 is the SplitMix64 mixing function.
+func splitMix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
 }
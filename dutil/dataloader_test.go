@@ -3,6 +3,7 @@ package dutil_test
 import (
 	// "reflect"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/sugarme/gotch/dutil"
@@ -42,3 +43,140 @@ func TestDataLoader_Next(t *testing.T) {
 		t.Errorf("Got: %v\n", got)
 	}
 }
+
+func TestDataLoader_SetBaseSeed(t *testing.T) {
+	// augmentedBatches runs 2 epochs, adding random noise drawn from the
+	// worker random generator to each item.
+	augmentedBatches := func(seed int64) []float64 {
+		data, err := dutil.NewSliceDataset([]float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dl, err := dutil.NewDataLoader(data, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dl.SetBaseSeed(seed)
+
+		var out []float64
+		for epoch := 0; epoch < 2; epoch++ {
+			r := dl.Rand(0)
+			for dl.HasNext() {
+				item, err := dl.Next()
+				if err != nil {
+					t.Fatal(err)
+				}
+				out = append(out, item.(float64)+r.Float64())
+			}
+			dl.Reset()
+		}
+
+		return out
+	}
+
+	want := augmentedBatches(42)
+	got := augmentedBatches(42)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+
+	if reflect.DeepEqual(want, augmentedBatches(43)) {
+		t.Errorf("Expected different augmentations for different base seeds\n")
+	}
+
+	if dutil.WorkerSeed(42, 0, 0) == dutil.WorkerSeed(42, 1, 0) {
+		t.Errorf("Expected distinct seeds for different workers\n")
+	}
+	if dutil.WorkerSeed(42, 0, 0) == dutil.WorkerSeed(42, 0, 1) {
+		t.Errorf("Expected distinct seeds for different epochs\n")
+	}
+}
+
+// epochBatches returns the batches of one epoch of dl.
+func epochBatches(t *testing.T, dl *dutil.DataLoader) [][]int {
+	var batches [][]int
+	for dl.HasNext() {
+		batch, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		batches = append(batches, batch.([]int))
+	}
+
+	return batches
+}
+
+func TestDataLoader_Shuffle(t *testing.T) {
+	data, err := dutil.NewSliceDataset([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	epochs := func(seed int64) [][][]int {
+		s, err := dutil.NewBatchSampler(data.Len(), 3, false, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.SetSeed(seed)
+		dl, err := dutil.NewDataLoader(data, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var retVal [][][]int
+		for epoch := 0; epoch < 3; epoch++ {
+			retVal = append(retVal, epochBatches(t, dl))
+			dl.Reset()
+		}
+		return retVal
+	}
+
+	want := epochs(42)
+	for epoch, batches := range want {
+		var sizes, items []int
+		for _, batch := range batches {
+			sizes = append(sizes, len(batch))
+			items = append(items, batch...)
+		}
+
+		// final partial batch is kept
+		if wantSizes := []int{3, 3, 3, 1}; !reflect.DeepEqual(wantSizes, sizes) {
+			t.Errorf("Want batch sizes at epoch %v: %v\n", epoch, wantSizes)
+			t.Errorf("Got batch sizes at epoch %v: %v\n", epoch, sizes)
+		}
+
+		// every sample visited exactly once
+		sort.Ints(items)
+		if wantItems := seq(data.Len()); !reflect.DeepEqual(wantItems, items) {
+			t.Errorf("Want visited samples at epoch %v: %v\n", epoch, wantItems)
+			t.Errorf("Got visited samples at epoch %v: %v\n", epoch, items)
+		}
+	}
+
+	if got := epochs(42); !reflect.DeepEqual(want, got) {
+		t.Errorf("Want: %v\n", want)
+		t.Errorf("Got: %v\n", got)
+	}
+	if reflect.DeepEqual(want[0], want[1]) && reflect.DeepEqual(want[1], want[2]) {
+		t.Errorf("Expected samples reshuffled at every epoch\n")
+	}
+
+	// drop last
+	s, err := dutil.NewBatchSampler(data.Len(), 3, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dl, err := dutil.NewDataLoader(data, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sizes []int
+	for _, batch := range epochBatches(t, dl) {
+		sizes = append(sizes, len(batch))
+	}
+	if want := []int{3, 3, 3}; !reflect.DeepEqual(want, sizes) {
+		t.Errorf("Want batch sizes with drop last: %v\n", want)
+		t.Errorf("Got batch sizes with drop last: %v\n", sizes)
+	}
+}
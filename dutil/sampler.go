@@ -168,6 +168,7 @@ type BatchSampler struct {
 	batchSize int
 	shuffle   bool
 	dropLast  bool
+	rng       *rand.Rand // random generator for shuffling
 }
 
 // NewBatchSampler creates a new BatchSampler.
@@ -187,9 +188,18 @@ func NewBatchSampler(n, batchSize int, dropLast bool, shuffleOpt ...bool) (*Batc
 		batchSize: batchSize,
 		shuffle:   shuffle,
 		dropLast:  dropLast,
+		rng:       rand.New(rand.NewSource(time.Now().UnixNano())),
 	}, nil
 }
 
+// SetSeed seeds the random generator used for shuffling.
+//
+// Samples are reshuffled on every call to `Sample` (i.e. at every epoch of a
+// DataLoader), so a fixed seed gives a reproducible sequence of epoch orders.
+func (s *BatchSampler) SetSeed(seed int64) {
+	s.rng = rand.New(rand.NewSource(seed))
+}
+
 // Sample implements Sampler interface
 func (s *BatchSampler) Sample() []int {
 	var (
@@ -205,8 +215,7 @@ func (s *BatchSampler) Sample() []int {
 		}
 	case true:
 		// random permutation
-		r := rand.New(rand.NewSource(time.Now().UnixNano()))
-		indices = r.Perm(s.n)
+		indices = s.rng.Perm(s.n)
 	}
 
 	for _, i := range indices {
@@ -29,7 +29,7 @@ func runLinear() {
 	for epoch := 0; epoch < epochs; epoch++ {
 
 		weight := ts.NewTensor()
-		reduction := int64(1) // Mean of loss
+		reduction := int64(ts.ReductionMean.ToInt())
 		ignoreIndex := int64(-100)
 
 		logits := ds.TrainImages.MustMm(ws, false).MustAdd(bs, true)
@@ -518,6 +518,37 @@ module Func = struct
               an an an an
         | TensorOptions -> "" )
     |> String.concat ~sep:""
+
+  (* NOTE. keeps tensor inputs reachable until the C call returns so that
+     tensors freed by finalizers (see `EnableAutoFree`) are not freed while
+     libtorch still uses them. *)
+  let go_keep_alive t =
+    List.filter_map t.args ~f:(fun arg ->
+        let an = go_variable arg.arg_name in
+        match arg.arg_type with
+        | Tensor | TensorOption | TensorList ->
+            if String.( = ) an "self" then Some " runtime.KeepAlive(ts)\n"
+            else Some (Printf.sprintf " runtime.KeepAlive(%s)\n" an)
+        | _ -> None )
+    |> String.concat ~sep:""
+
+  (* NOTE. checks tensor list inputs of ops requiring a non-empty list. It is
+     emitted before the receiver is deferred to be dropped so that a rejected
+     input does not delete the receiver. *)
+  let go_empty_list_check t ~gofunc_name =
+    List.filter_map t.args ~f:(fun arg ->
+        let an = go_variable arg.arg_name in
+        match arg.arg_type with
+        | TensorList ->
+            Some
+              (Printf.sprintf
+                 " if len(%s) == 0 {\n\
+                 \  err = fmt.Errorf(\"%s() failed: empty tensor list input (%s)\\n\")\n\
+                 \  return\n\
+                 \  }\n"
+                 an gofunc_name an)
+        | _ -> None )
+    |> String.concat ~sep:""
 end
 
 exception Not_a_simple_arg
@@ -699,6 +730,8 @@ let write_wrapper funcs filename =
       pm "" ;
       pm "\n\n" ;
       pm "import(\n" ;
+      pm "  \"fmt\"\n" ;
+      pm "  \"runtime\"\n" ;
       pm "  \"unsafe\"\n" ;
       pm "\n" ;
       pm "  \"github.com/sugarme/gotch\"\n" ;
@@ -731,6 +764,35 @@ let write_wrapper funcs filename =
           in
           let cfunc_name = "lib.Atg" ^ gofunc_name in
           let go_args_list = Func.go_typed_args_list func in
+          (* NOTE. ops failing in libtorch on an empty tensor list input *)
+          let nonempty_list_funcs =
+            [ "_Cat"
+            ; "_CatOut"
+            ; "CartesianProd"
+            ; "Cat"
+            ; "CatOut"
+            ; "ChainMatmul"
+            ; "Dstack"
+            ; "DstackOut"
+            ; "Einsum"
+            ; "Hstack"
+            ; "HstackOut"
+            ; "Index"
+            ; "IndexPut"
+            ; "IndexPut_"
+            ; "_IndexPutImpl_"
+            ; "Stack"
+            ; "StackOut"
+            ; "Vstack"
+            ; "VstackOut" ]
+          in
+          let empty_list_check =
+            if
+              List.exists nonempty_list_funcs ~f:(fun name ->
+                  String.( = ) name gofunc_name )
+            then Func.go_empty_list_check func ~gofunc_name
+            else ""
+          in
           (* NOTE. temporarily excluding these functions as not implemented at FFI *)
           (* TODO. implement multiple tensors return function []Tensor *)
           let excluded_funcs =
@@ -765,19 +827,21 @@ let write_wrapper funcs filename =
                 else pm "func %s(" gofunc_name ;
                 pm "%s" go_args_list ;
                 pm ")(%s) { \n" (Func.go_return_type func ~fallible:true) ;
+                pm "%s" empty_list_check ;
                 if is_method && not is_inplace then
                   pm "if del { defer ts.MustDrop() }\n" ;
                 pm "  ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))\n" ;
                 pm "  \n" ;
                 pm "  %s" (Func.go_binding_body func) ;
                 pm "%s(ptr, %s)\n" cfunc_name (Func.go_binding_args func) ;
+                pm "%s" (Func.go_keep_alive func) ;
                 pm "  if err = TorchErr(); err != nil {\n" ;
                 pm "    return %s\n"
                   (Func.go_return_notype func ~fallible:true) ;
                 pm "  }\n" ;
                 (* NOTE. if in_place method, no retVal return *)
                 if not (Func.is_inplace func) then
-                  pm "  retVal = &Tensor{ctensor: *ptr}\n" ;
+                  pm "  retVal = newTensor(*ptr)\n" ;
                 pm "  \n" ;
                 pm "  return %s\n" (Func.go_return_notype func ~fallible:true) ;
                 pm "} \n"
@@ -787,19 +851,21 @@ let write_wrapper funcs filename =
                 else pm "func %s(" gofunc_name ;
                 pm "%s" go_args_list ;
                 pm ")(%s) { \n" (Func.go_return_type func ~fallible:true) ;
+                pm "%s" empty_list_check ;
                 if is_method && not is_inplace then
                   pm "if del { defer ts.MustDrop() }\n" ;
                 pm "  ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))\n" ;
                 pm "  \n" ;
                 pm "  %s" (Func.go_binding_body func) ;
                 pm "%s(ptr, %s)\n" cfunc_name (Func.go_binding_args func) ;
+                pm "%s" (Func.go_keep_alive func) ;
                 pm "  if err = TorchErr(); err != nil {\n" ;
                 pm "    return %s\n"
                   (Func.go_return_notype func ~fallible:true) ;
                 pm "  }\n" ;
                 (* NOTE. if in_place method, no retVal return *)
                 if not (Func.is_inplace func) then
-                  pm "  retVal = &Tensor{ctensor: *ptr}\n" ;
+                  pm "  retVal = newTensor(*ptr)\n" ;
                 pm "  \n" ;
                 pm "  return %s\n" (Func.go_return_notype func ~fallible:true) ;
                 pm "} \n"
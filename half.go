@@ -0,0 +1,133 @@
+package gotch
+
+// Conversions between float32 and 16-bit floating point types.
+
+import (
+	"math"
+)
+
+// Float32ToHalf converts a float32 to IEEE 754 half precision, rounding to
+// nearest even. Values out of half range become +/-Inf.
+func Float32ToHalf(f float32) GoFloat16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int32(b>>23) & 0xff
+	mant := b & 0x7fffff
+
+	switch {
+	case exp == 0xff: // Inf or NaN
+		if mant != 0 {
+			return GoFloat16(sign | 0x7e00)
+		}
+		return GoFloat16(sign | 0x7c00)
+
+	case exp > 142: // overflow (unbiased exponent > 15)
+		return GoFloat16(sign | 0x7c00)
+
+	case exp < 113: // subnormal or zero (unbiased exponent < -14)
+		if exp < 102 {
+			return GoFloat16(sign)
+		}
+		mant |= 0x800000
+		shift := uint32(126 - exp)
+		half := mant >> shift
+		rem := mant & (1<<shift - 1)
+		mid := uint32(1) << (shift - 1)
+		if rem > mid || (rem == mid && half&1 == 1) {
+			half++
+		}
+		return GoFloat16(sign | uint16(half))
+	}
+
+	half := uint32(exp-112)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// NOTE: a carry into the exponent correctly rounds up to the next
+		// power of two or to Inf.
+		half++
+	}
+
+	return GoFloat16(sign | uint16(half))
+}
+
+// Float32 converts a half precision value to float32.
+func (h GoFloat16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f: // Inf or NaN
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// Subnormal: normalize mantissa.
+		e := uint32(113)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&0x3ff)<<13)
+	}
+
+	return math.Float32frombits(sign | (exp+112)<<23 | mant<<13)
+}
+
+// Float32ToBFloat16 converts a float32 to bfloat16, rounding to nearest even.
+func Float32ToBFloat16(f float32) GoBFloat16 {
+	b := math.Float32bits(f)
+	if f != f { // NaN
+		return GoBFloat16(b>>16 | 0x40)
+	}
+	b += 0x7fff + (b>>16)&1
+
+	return GoBFloat16(b >> 16)
+}
+
+// Float32 converts a bfloat16 value to float32.
+func (b GoBFloat16) Float32() float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// Float32sToHalf converts a slice of float32 to half precision values.
+func Float32sToHalf(data []float32) []GoFloat16 {
+	retVal := make([]GoFloat16, len(data))
+	for i, v := range data {
+		retVal[i] = Float32ToHalf(v)
+	}
+
+	return retVal
+}
+
+// HalfToFloat32s converts a slice of half precision values to float32.
+func HalfToFloat32s(data []GoFloat16) []float32 {
+	retVal := make([]float32, len(data))
+	for i, v := range data {
+		retVal[i] = v.Float32()
+	}
+
+	return retVal
+}
+
+// Float32sToBFloat16 converts a slice of float32 to bfloat16 values.
+func Float32sToBFloat16(data []float32) []GoBFloat16 {
+	retVal := make([]GoBFloat16, len(data))
+	for i, v := range data {
+		retVal[i] = Float32ToBFloat16(v)
+	}
+
+	return retVal
+}
+
+// BFloat16ToFloat32s converts a slice of bfloat16 values to float32.
+func BFloat16ToFloat32s(data []GoBFloat16) []float32 {
+	retVal := make([]float32, len(data))
+	for i, v := range data {
+		retVal[i] = v.Float32()
+	}
+
+	return retVal
+}
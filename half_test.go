@@ -0,0 +1,104 @@
+package gotch_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+)
+
+func TestHalfDType(t *testing.T) {
+	for _, tc := range []struct {
+		dtype gotch.DType
+		cint  gotch.CInt
+	}{
+		{gotch.Half, 5},
+		{gotch.BFloat16, 15},
+	} {
+		size, err := gotch.DTypeSize(tc.dtype)
+		if err != nil || size != 2 {
+			t.Errorf("Expected %v size: 2\n", tc.dtype)
+			t.Errorf("Got %v size: %v (err: %v)\n", tc.dtype, size, err)
+		}
+
+		cint, err := gotch.DType2CInt(tc.dtype)
+		if err != nil || cint != tc.cint {
+			t.Errorf("Expected %v CInt: %v\n", tc.dtype, tc.cint)
+			t.Errorf("Got %v CInt: %v (err: %v)\n", tc.dtype, cint, err)
+		}
+
+		dtype, err := gotch.CInt2DType(tc.cint)
+		if err != nil || dtype != tc.dtype {
+			t.Errorf("Expected DType from CInt %v: %v\n", tc.cint, tc.dtype)
+			t.Errorf("Got DType from CInt %v: %v (err: %v)\n", tc.cint, dtype, err)
+		}
+	}
+}
+
+func TestHalfConversion(t *testing.T) {
+	inf := float32(math.Inf(1))
+	for _, tc := range []struct {
+		input float32
+		want  float32
+	}{
+		{1.0, 1.0},
+		{-2.5, -2.5},
+		{65504, 65504},                 // max half
+		{65520, inf},                   // overflow
+		{0.1, 0.099975586},             // rounded
+		{5.9604645e-08, 5.9604645e-08}, // min subnormal
+		{1e-8, 0},                      // underflow
+		{-inf, -inf},
+	} {
+		got := gotch.Float32ToHalf(tc.input).Float32()
+		if got != tc.want {
+			t.Errorf("Expected half of %v: %v\n", tc.input, tc.want)
+			t.Errorf("Got half of %v: %v\n", tc.input, got)
+		}
+	}
+
+	nan := gotch.Float32ToHalf(float32(math.NaN())).Float32()
+	if nan == nan {
+		t.Errorf("Expected half of NaN: NaN\n")
+		t.Errorf("Got half of NaN: %v\n", nan)
+	}
+
+	// Every half value is exactly representable as float32.
+	for i := 0; i < 1<<16; i++ {
+		h := gotch.GoFloat16(i)
+		f := h.Float32()
+		if f == f && gotch.Float32ToHalf(f) != h {
+			t.Errorf("Expected round-trip of half bits: %#04x\n", i)
+			t.Errorf("Got round-trip of half bits: %#04x\n", gotch.Float32ToHalf(f))
+		}
+	}
+}
+
+func TestBFloat16Conversion(t *testing.T) {
+	for _, tc := range []struct {
+		input float32
+		want  float32
+	}{
+		{1.0, 1.0},
+		{-2.5, -2.5},
+		{65504, 65536},
+		{0.1, 0.100097656},
+		{1e-8, 1.0011718e-08},
+	} {
+		got := gotch.Float32ToBFloat16(tc.input).Float32()
+		if got != tc.want {
+			t.Errorf("Expected bfloat16 of %v: %v\n", tc.input, tc.want)
+			t.Errorf("Got bfloat16 of %v: %v\n", tc.input, got)
+		}
+	}
+
+	vals := []float32{3.14159, -0.001, 1000}
+	got := gotch.BFloat16ToFloat32s(gotch.Float32sToBFloat16(vals))
+	for i := range vals {
+		if math.Abs(float64(got[i]-vals[i])) > math.Abs(float64(vals[i]))/128 {
+			t.Errorf("Expected bfloat16 round-trip: %v\n", vals)
+			t.Errorf("Got bfloat16 round-trip: %v\n", got)
+			break
+		}
+	}
+}
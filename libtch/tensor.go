@@ -19,6 +19,7 @@ type Cscalar = C.scalar
 type Coptimizer = C.optimizer
 type Civalue = C.ivalue
 type Cmodule = C.module
+type Cprofile = C.profile
 
 type NamedCtensor struct {
 	Name    string
@@ -35,6 +36,12 @@ func AtNewTensor() Ctensor {
 	return C.at_new_tensor()
 }
 
+// void at_manual_seed(int64_t);
+func AtManualSeed(seed int64) {
+	cseed := *(*C.int64_t)(unsafe.Pointer(&seed))
+	C.at_manual_seed(cseed)
+}
+
 // tensor at_new_tensor();
 func NewTensor() Ctensor {
 	return C.at_new_tensor()
@@ -87,10 +94,52 @@ func AtScalarType(t Ctensor) int32 {
 	return *(*int32)(unsafe.Pointer(&result))
 }
 
+// void at_autocast_clear_cache();
+func AtAutocastClearCache() {
+	C.at_autocast_clear_cache()
+}
+
+// int at_autocast_decrement_nesting();
+func AtAutocastDecrementNesting() int {
+	result := C.at_autocast_decrement_nesting()
+	return *(*int)(unsafe.Pointer(&result))
+}
+
+// int at_autocast_increment_nesting();
+func AtAutocastIncrementNesting() int {
+	result := C.at_autocast_increment_nesting()
+	return *(*int)(unsafe.Pointer(&result))
+}
+
+// bool at_autocast_is_enabled();
+func AtAutocastIsEnabled() bool {
+	result := C.at_autocast_is_enabled()
+	return bool(result)
+}
+
+// bool at_autocast_set_enabled(bool b);
+func AtAutocastSetEnabled(b bool) bool {
+	result := C.at_autocast_set_enabled(C.bool(b))
+	return bool(result)
+}
+
 func GetAndResetLastErr() *C.char {
 	return C.get_and_reset_last_err()
 }
 
+// GetAndResetLastErrString returns the last libtorch error message, or an
+// empty string if none, and frees up its C memory. It can be used by packages
+// which cannot handle the C pointer returned by GetAndResetLastErr.
+func GetAndResetLastErrString() string {
+	cptr := C.get_and_reset_last_err()
+	if cptr == nil {
+		return ""
+	}
+	defer C.free(unsafe.Pointer(cptr))
+
+	return C.GoString(cptr)
+}
+
 // int atc_cuda_device_count();
 func AtcCudaDeviceCount() int {
 	result := C.atc_cuda_device_count()
@@ -109,12 +158,92 @@ func AtcCudnnIsAvailable() bool {
 	return *(*bool)(unsafe.Pointer(&result))
 }
 
+// void atc_manual_seed_all(int64_t);
+func AtcManualSeedAll(seed int64) {
+	cseed := *(*C.int64_t)(unsafe.Pointer(&seed))
+	C.atc_manual_seed_all(cseed)
+}
+
 // void atc_set_benchmark_cudnn(int b);
 func AtcSetBenchmarkCudnn(b int) {
 	cb := *(*C.int)(unsafe.Pointer(&b))
 	C.atc_set_benchmark_cudnn(cb)
 }
 
+// void atc_set_deterministic(int b);
+func AtcSetDeterministic(b int) {
+	cb := *(*C.int)(unsafe.Pointer(&b))
+	C.atc_set_deterministic(cb)
+}
+
+// int atc_is_deterministic();
+func AtcIsDeterministic() bool {
+	result := C.atc_is_deterministic()
+	return result != 0
+}
+
+// void atc_set_deterministic_cudnn(int b);
+func AtcSetDeterministicCudnn(b int) {
+	cb := *(*C.int)(unsafe.Pointer(&b))
+	C.atc_set_deterministic_cudnn(cb)
+}
+
+// void atp_enable_profiler(int use_cuda, int record_shapes);
+func AtpEnableProfiler(useCuda, recordShapes bool) {
+	var cuda, shapes C.int
+	if useCuda {
+		cuda = 1
+	}
+	if recordShapes {
+		shapes = 1
+	}
+	C.atp_enable_profiler(cuda, shapes)
+}
+
+// profile atp_disable_profiler();
+func AtpDisableProfiler() Cprofile {
+	return C.atp_disable_profiler()
+}
+
+// int atp_profile_len(profile);
+func AtpProfileLen(p Cprofile) int {
+	return int(C.atp_profile_len(p))
+}
+
+// char *atp_profile_event_name(profile, int i);
+func AtpProfileEventName(p Cprofile, i int) string {
+	cname := C.atp_profile_event_name(p, C.int(i))
+	defer C.free(unsafe.Pointer(cname))
+	return C.GoString(cname)
+}
+
+// double atp_profile_event_cpu_us(profile, int i);
+func AtpProfileEventCpuUs(p Cprofile, i int) float64 {
+	return float64(C.atp_profile_event_cpu_us(p, C.int(i)))
+}
+
+// double atp_profile_event_cuda_us(profile, int i);
+func AtpProfileEventCudaUs(p Cprofile, i int) float64 {
+	return float64(C.atp_profile_event_cuda_us(p, C.int(i)))
+}
+
+// int atp_profile_event_ndims(profile, int i);
+// void atp_profile_event_shape(profile, int i, int64_t *dims);
+func AtpProfileEventShape(p Cprofile, i int) []int64 {
+	ndims := int(C.atp_profile_event_ndims(p, C.int(i)))
+	if ndims == 0 {
+		return nil
+	}
+	dims := make([]int64, ndims)
+	C.atp_profile_event_shape(p, C.int(i), (*C.int64_t)(unsafe.Pointer(&dims[0])))
+	return dims
+}
+
+// void atp_profile_free(profile);
+func AtpProfileFree(p Cprofile) {
+	C.atp_profile_free(p)
+}
+
 // double at_double_value_at_indexes(tensor, int64_t *indexes, int indexes_len);
 func AtDoubleValueAtIndexes(ts Ctensor, indexes unsafe.Pointer, indexesLen int) float64 {
 	ctensor := (C.tensor)(ts)
@@ -151,6 +280,24 @@ func AtIsSparse(ts Ctensor) bool {
 	return *(*bool)(unsafe.Pointer(&retVal))
 }
 
+// int at_is_pinned(tensor);
+func AtIsPinned(ts Ctensor) bool {
+	retVal := C.at_is_pinned(ts)
+	return *(*bool)(unsafe.Pointer(&retVal))
+}
+
+// double at_q_scale(tensor);
+func AtQScale(ts Ctensor) float64 {
+	retVal := C.at_q_scale(ts)
+	return *(*float64)(unsafe.Pointer(&retVal))
+}
+
+// int64_t at_q_zero_point(tensor);
+func AtQZeroPoint(ts Ctensor) int64 {
+	retVal := C.at_q_zero_point(ts)
+	return *(*int64)(unsafe.Pointer(&retVal))
+}
+
 // void at_backward(tensor, int, int);
 func AtBackward(ts Ctensor, keepGraph int, createGraph int) {
 	ckeepGraph := *(*C.int)(unsafe.Pointer(&keepGraph))
@@ -199,6 +346,11 @@ func AtCopy_(dst Ctensor, src Ctensor) {
 	C.at_copy_(dst, src)
 }
 
+// void at_set_data(tensor, tensor new_data);
+func AtSetData(ts Ctensor, newData Ctensor) {
+	C.at_set_data(ts, newData)
+}
+
 // void at_save(tensor, char *filename);
 func AtSave(ts Ctensor, path string) {
 	cstringPtr := C.CString(path)
@@ -432,6 +584,12 @@ func AtoAddParameters(coptimizer Coptimizer, tensors []Ctensor, ntensors int) {
 	C.ato_add_parameters(coptimizer, ctensors[0], cntensors)
 }
 
+// void ato_set_parameter_group(optimizer, tensor, size_t group);
+func AtoSetParameterGroup(coptimizer Coptimizer, tensor Ctensor, group uint) {
+	cgroup := *(*C.size_t)(unsafe.Pointer(&group))
+	C.ato_set_parameter_group(coptimizer, tensor, cgroup)
+}
+
 // void ato_set_learning_rate(optimizer, double learning_rate);
 func AtoSetLearningRate(coptimizer Coptimizer, learningRate float64) {
 	clearningRate := *(*C.double)(unsafe.Pointer(&learningRate))
@@ -445,6 +603,20 @@ func AtoSetMomentum(coptimizer Coptimizer, momentum float64) {
 	C.ato_set_momentum(coptimizer, cmomentum)
 }
 
+// void ato_set_learning_rate_group(optimizer, size_t group, double learning_rate);
+func AtoSetLearningRateGroup(coptimizer Coptimizer, group uint, learningRate float64) {
+	cgroup := *(*C.size_t)(unsafe.Pointer(&group))
+	clearningRate := *(*C.double)(unsafe.Pointer(&learningRate))
+	C.ato_set_learning_rate_group(coptimizer, cgroup, clearningRate)
+}
+
+// void ato_set_weight_decay_group(optimizer t, size_t group, double weight_decay);
+func AtoSetWeightDecayGroup(coptimizer Coptimizer, group uint, weightDecay float64) {
+	cgroup := *(*C.size_t)(unsafe.Pointer(&group))
+	cweightDecay := *(*C.double)(unsafe.Pointer(&weightDecay))
+	C.ato_set_weight_decay_group(coptimizer, cgroup, cweightDecay)
+}
+
 // void ato_zero_grad(optimizer);
 func AtoZeroGrad(coptimizer Coptimizer) {
 
@@ -457,6 +629,20 @@ func AtoStep(coptimizer Coptimizer) {
 	C.ato_step(coptimizer)
 }
 
+// void ato_save(optimizer, char *filename);
+func AtoSave(coptimizer Coptimizer, path string) {
+	cstringPtr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstringPtr))
+	C.ato_save(coptimizer, cstringPtr)
+}
+
+// void ato_load(optimizer, char *filename);
+func AtoLoad(coptimizer Coptimizer, path string) {
+	cstringPtr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstringPtr))
+	C.ato_load(coptimizer, cstringPtr)
+}
+
 // void ato_free(optimizer);
 func AtoFree(coptimizer Coptimizer) {
 	C.ato_free(coptimizer)
@@ -765,6 +951,14 @@ func AtmTo(m Cmodule, device int32, dtype int32, nonBlocking bool) {
 	C.atm_to(m, cdevice, cdtype, cnonBlocking)
 }
 
+// void atm_to_device(module m, int device, bool non_blocking);
+func AtmToDevice(m Cmodule, device int32, nonBlocking bool) {
+	cdevice := *(*C.int)(unsafe.Pointer(&device))
+	cnonBlocking := *(*C.bool)(unsafe.Pointer(&nonBlocking))
+
+	C.atm_to_device(m, cdevice, cnonBlocking)
+}
+
 // int atm_get_profiling_mode();
 func AtmGetProfilingMode() bool {
 	retVal := C.atm_get_profiling_mode()
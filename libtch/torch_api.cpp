@@ -1,8 +1,10 @@
 #include<torch/csrc/autograd/engine.h>
+#include<torch/csrc/autograd/profiler.h>
 #include<torch/csrc/jit/runtime/graph_executor.h>
 #include<torch/torch.h>
 #include<ATen/autocast_mode.h>
 #include<torch/script.h>
+#include<algorithm>
 #include<stdexcept>
 #include<vector>
 #include "torch_api.h"
@@ -111,6 +113,21 @@ int at_is_sparse(tensor t) {
   return -1;
 }
 
+int at_is_pinned(tensor t) {
+  PROTECT(return t->is_pinned();)
+  return -1;
+}
+
+double at_q_scale(tensor t) {
+  PROTECT(return t->q_scale();)
+  return 0.;
+}
+
+int64_t at_q_zero_point(tensor t) {
+  PROTECT(return t->q_zero_point();)
+  return 0;
+}
+
 size_t at_dim(tensor t) {
   PROTECT(return t->dim();)
   return -1;
@@ -278,6 +295,12 @@ void at_copy_(tensor dst, tensor src) {
   )
 }
 
+void at_set_data(tensor t, tensor new_data) {
+  PROTECT(
+    t->set_data(*new_data);
+  )
+}
+
 void at_save(tensor t, char *filename) {
   PROTECT(torch::save(*t, filename);)
 }
@@ -548,6 +571,23 @@ void ato_add_parameters(optimizer t, tensor tensor, size_t group) {
   )
 }
 
+void ato_set_parameter_group(optimizer t, tensor tensor, size_t group) {
+  PROTECT(
+    auto &groups = t->param_groups();
+    auto impl = tensor->unsafeGetTensorImpl();
+    for (auto &param_group: groups) {
+      auto &params = param_group.params();
+      params.erase(std::remove_if(params.begin(), params.end(),
+                                  [impl](const torch::Tensor &p) { return p.unsafeGetTensorImpl() == impl; }),
+                   params.end());
+    }
+    while (groups.size() <= group) {
+      groups.push_back(torch::optim::OptimizerParamGroup({}, t->defaults().clone()));
+    }
+    groups[group].params().push_back(*tensor);
+  )
+}
+
 template <class T>
 void set_lr(optimizer t, double learning_rate) {
   torch::optim::OptimizerOptions* d = &(t->defaults());
@@ -710,7 +750,31 @@ void ato_zero_grad(optimizer t) {
 }
 
 void ato_step(optimizer t) {
-  PROTECT(t->step();)
+  PROTECT(
+    // Parameters not requiring grad (frozen) may hold stale gradients. Reset
+    // them so that step() skips these parameters entirely.
+    for (auto &group : t->param_groups())
+      for (auto &p : group.params())
+        if (!p.requires_grad() && p.grad().defined())
+          p.mutable_grad() = torch::Tensor();
+    t->step();
+  )
+}
+
+void ato_save(optimizer t, char *filename) {
+  PROTECT(
+    torch::serialize::OutputArchive archive;
+    t->save(archive);
+    archive.save_to(filename);
+  )
+}
+
+void ato_load(optimizer t, char *filename) {
+  PROTECT(
+    torch::serialize::InputArchive archive;
+    archive.load_from(std::string(filename));
+    t->load(archive);
+  )
 }
 
 void ato_free(optimizer t) {
@@ -770,6 +834,96 @@ void atc_set_benchmark_cudnn(int b) {
   at::globalContext().setBenchmarkCuDNN(b);
 }
 
+void atc_manual_seed_all(int64_t seed) {
+  PROTECT(torch::cuda::manual_seed_all(seed);)
+}
+
+void atc_set_deterministic(int b) {
+  at::globalContext().setDeterministic(b);
+}
+
+int atc_is_deterministic() {
+  return at::globalContext().deterministic();
+}
+
+void atc_set_deterministic_cudnn(int b) {
+  at::globalContext().setDeterministicCuDNN(b);
+}
+
+struct profile_event {
+  string name;
+  double cpu_us;
+  double cuda_us;
+  vector<int64_t> shape;
+};
+
+struct profile_result {
+  vector<profile_event> events;
+};
+
+void atp_enable_profiler(int use_cuda, int record_shapes) {
+  PROTECT(
+    torch::autograd::profiler::ProfilerState state = use_cuda ? torch::autograd::profiler::ProfilerState::CUDA : torch::autograd::profiler::ProfilerState::CPU;
+    torch::autograd::profiler::enableProfiler(torch::autograd::profiler::ProfilerConfig(state, (bool)record_shapes));
+  )
+}
+
+// Pairs push and pop events of each thread into op events.
+profile atp_disable_profiler() {
+  PROTECT(
+    auto lists = torch::autograd::profiler::disableProfiler();
+    profile_result *result = new profile_result();
+    for (auto &list : lists) {
+      vector<torch::autograd::profiler::Event*> stack;
+      for (auto &e : list) {
+        if (strcmp(e.kind(), "push") == 0) {
+          stack.push_back(&e);
+        } else if (strcmp(e.kind(), "pop") == 0 && !stack.empty()) {
+          torch::autograd::profiler::Event *start = stack.back();
+          stack.pop_back();
+          profile_event event;
+          event.name = start->name();
+          event.cpu_us = start->cpuElapsedUs(e);
+          event.cuda_us = start->hasCuda() ? start->cudaElapsedUs(e) : 0;
+          auto shapes = start->shapes();
+          if (!shapes.empty()) event.shape = shapes[0];
+          result->events.push_back(event);
+        }
+      }
+    }
+    return result;
+  )
+  return nullptr;
+}
+
+int atp_profile_len(profile p) {
+  return p->events.size();
+}
+
+char *atp_profile_event_name(profile p, int i) {
+  return strdup(p->events[i].name.c_str());
+}
+
+double atp_profile_event_cpu_us(profile p, int i) {
+  return p->events[i].cpu_us;
+}
+
+double atp_profile_event_cuda_us(profile p, int i) {
+  return p->events[i].cuda_us;
+}
+
+int atp_profile_event_ndims(profile p, int i) {
+  return p->events[i].shape.size();
+}
+
+void atp_profile_event_shape(profile p, int i, int64_t *dims) {
+  for (size_t j = 0; j < p->events[i].shape.size(); ++j) dims[j] = p->events[i].shape[j];
+}
+
+void atp_profile_free(profile p) {
+  delete p;
+}
+
 module atm_load(char *filename) {
   PROTECT(
     return new torch::jit::script::Module(torch::jit::load(filename));
@@ -878,6 +1032,12 @@ void atm_to(module m, int device, int dtype, bool non_blocking) {
   )
 }
 
+void atm_to_device(module m, int device, bool non_blocking) {
+  PROTECT(
+    m->to(device_of_int(device), non_blocking);
+  )
+}
+
 int atm_get_profiling_mode() {
   PROTECT(
     return torch::jit::getProfilingMode();
@@ -11,6 +11,7 @@ typedef torch::Scalar *scalar;
 typedef torch::optim::Optimizer *optimizer;
 typedef torch::jit::script::Module *module;
 typedef torch::jit::IValue *ivalue;
+typedef struct profile_result *profile;
 #define PROTECT(x)                                                             \
   try {                                                                        \
     x                                                                          \
@@ -23,6 +24,7 @@ typedef void *optimizer;
 typedef void *scalar;
 typedef void *module;
 typedef void *ivalue;
+typedef void *profile;
 #endif
 
 char *get_and_reset_last_err(); // thread-local
@@ -41,6 +43,9 @@ void *at_data_ptr(tensor);
 int at_defined(tensor);
 int at_is_mkldnn(tensor);
 int at_is_sparse(tensor);
+int at_is_pinned(tensor);
+double at_q_scale(tensor);
+int64_t at_q_zero_point(tensor);
 int at_device(tensor);
 size_t at_dim(tensor);
 void at_shape(tensor, int64_t *);
@@ -71,6 +76,7 @@ void at_set_int64_value_at_indexes(tensor, int *indexes, int indexes_len,
                                    int64_t v);
 
 void at_copy_(tensor dst, tensor src);
+void at_set_data(tensor, tensor new_data);
 
 void at_print(tensor);
 char *at_to_string(tensor, int line_size);
@@ -120,6 +126,8 @@ optimizer ato_sgd(double learning_rate, double momentum, double dampening,
 // Backward compat
 void ato_add_parameters_old(optimizer, tensor *, int ntensors);
 void ato_add_parameters(optimizer, tensor, size_t group);
+// Moves a parameter to a param group, removing it from any other group.
+void ato_set_parameter_group(optimizer, tensor, size_t group);
 void ato_set_learning_rate(optimizer, double learning_rate);
 void ato_set_momentum(optimizer, double momentum);
 void ato_set_learning_rate_group(optimizer, size_t group, double learning_rate);
@@ -128,6 +136,9 @@ void ato_set_weight_decay(optimizer t, double weight_decay);
 void ato_set_weight_decay_group(optimizer t, size_t group, double weight_decay);
 void ato_zero_grad(optimizer);
 void ato_step(optimizer);
+// Saves/loads the optimizer state, e.g. Adam moments and step counts.
+void ato_save(optimizer, char *filename);
+void ato_load(optimizer, char *filename);
 void ato_free(optimizer);
 
 scalar ats_int(int64_t);
@@ -141,6 +152,20 @@ int atc_cuda_device_count();
 int atc_cuda_is_available();
 int atc_cudnn_is_available();
 void atc_set_benchmark_cudnn(int b);
+void atc_manual_seed_all(int64_t);
+void atc_set_deterministic(int b);
+int atc_is_deterministic();
+void atc_set_deterministic_cudnn(int b);
+
+void atp_enable_profiler(int use_cuda, int record_shapes);
+profile atp_disable_profiler();
+int atp_profile_len(profile);
+char *atp_profile_event_name(profile, int i);
+double atp_profile_event_cpu_us(profile, int i);
+double atp_profile_event_cuda_us(profile, int i);
+int atp_profile_event_ndims(profile, int i);
+void atp_profile_event_shape(profile, int i, int64_t *dims);
+void atp_profile_free(profile);
 
 module atm_load(char *);
 module atm_load_on_device(char *, int device);
@@ -152,6 +177,7 @@ tensor atm_method(module, char *method_name, tensor *tensors, int ntensors);
 ivalue atm_method_(module, char *method_name, ivalue *ivalues, int nivalues);
 void atm_free(module);
 void atm_to(module m, int device, int dtype, bool non_blocking);
+void atm_to_device(module m, int device, bool non_blocking);
 void atm_save(module m, char *);
 int atm_get_profiling_mode();
 void atm_set_profiling_mode(int);
@@ -0,0 +1,136 @@
+package nn
+
+// Stateless activation layers.
+
+import (
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// ReLU applies the rectified linear unit function element-wise.
+type ReLU struct{}
+
+// NewReLU creates a ReLU layer.
+func NewReLU() *ReLU {
+	return &ReLU{}
+}
+
+// Forward implements Module interface for ReLU.
+func (r *ReLU) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustRelu(false)
+}
+
+// ForwardT implements ModuleT interface for ReLU.
+//
+// NOTE: train param will not be used.
+func (r *ReLU) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return r.Forward(xs)
+}
+
+// LeakyReLU applies element-wise `max(0, x) + NegativeSlope * min(0, x)`.
+type LeakyReLU struct {
+	NegativeSlope float64
+}
+
+// NewLeakyReLU creates a LeakyReLU layer with a given negative slope.
+func NewLeakyReLU(negativeSlope float64) *LeakyReLU {
+	return &LeakyReLU{NegativeSlope: negativeSlope}
+}
+
+// Forward implements Module interface for LeakyReLU.
+func (l *LeakyReLU) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	pos := xs.MustClampMin(ts.FloatScalar(0.0), false)
+	neg := xs.MustClampMax(ts.FloatScalar(0.0), false).MustMul1(ts.FloatScalar(l.NegativeSlope), true)
+	retVal = pos.MustAdd(neg, true)
+	neg.MustDrop()
+
+	return retVal
+}
+
+// ForwardT implements ModuleT interface for LeakyReLU.
+//
+// NOTE: train param will not be used.
+func (l *LeakyReLU) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return l.Forward(xs)
+}
+
+// GELU applies the Gaussian error linear unit function element-wise.
+type GELU struct{}
+
+// NewGELU creates a GELU layer.
+func NewGELU() *GELU {
+	return &GELU{}
+}
+
+// Forward implements Module interface for GELU.
+func (g *GELU) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustGelu(false)
+}
+
+// ForwardT implements ModuleT interface for GELU.
+//
+// NOTE: train param will not be used.
+func (g *GELU) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return g.Forward(xs)
+}
+
+// Sigmoid applies the logistic sigmoid function element-wise.
+type Sigmoid struct{}
+
+// NewSigmoid creates a Sigmoid layer.
+func NewSigmoid() *Sigmoid {
+	return &Sigmoid{}
+}
+
+// Forward implements Module interface for Sigmoid.
+func (s *Sigmoid) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustSigmoid(false)
+}
+
+// ForwardT implements ModuleT interface for Sigmoid.
+//
+// NOTE: train param will not be used.
+func (s *Sigmoid) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return s.Forward(xs)
+}
+
+// Tanh applies the hyperbolic tangent function element-wise.
+type Tanh struct{}
+
+// NewTanh creates a Tanh layer.
+func NewTanh() *Tanh {
+	return &Tanh{}
+}
+
+// Forward implements Module interface for Tanh.
+func (t *Tanh) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustTanh(false)
+}
+
+// ForwardT implements ModuleT interface for Tanh.
+//
+// NOTE: train param will not be used.
+func (t *Tanh) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return t.Forward(xs)
+}
+
+// Softmax applies the softmax function along dimension `Dim`.
+type Softmax struct {
+	Dim int64
+}
+
+// NewSoftmax creates a Softmax layer applied along a given dimension.
+func NewSoftmax(dim int64) *Softmax {
+	return &Softmax{Dim: dim}
+}
+
+// Forward implements Module interface for Softmax.
+func (s *Softmax) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustSoftmax(s.Dim, xs.DType(), false)
+}
+
+// ForwardT implements ModuleT interface for Softmax.
+//
+// NOTE: train param will not be used.
+func (s *Softmax) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return s.Forward(xs)
+}
@@ -0,0 +1,60 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestActivationSequential(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	seq := nn.Seq()
+	seq.Add(nn.NewLinear(path.Sub("fc1"), 10, 32, nn.DefaultLinearConfig()))
+	seq.Add(nn.NewReLU())
+	seq.Add(nn.NewLinear(path.Sub("fc2"), 32, 5, nn.DefaultLinearConfig()))
+
+	input := ts.MustRandn([]int64{4, 10}, gotch.Float, gotch.CPU)
+	got := seq.Forward(input).MustSize()
+	want := []int64{4, 5}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
+
+func TestActivations(t *testing.T) {
+	xs := ts.MustOfSlice([]float64{-2.0, 0.0, 3.0})
+
+	want := []float64{0.0, 0.0, 3.0}
+	got := nn.NewReLU().Forward(xs).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected ReLU values: %v\n", want)
+		t.Errorf("Got ReLU values: %v\n", got)
+	}
+
+	want = []float64{-0.2, 0.0, 3.0}
+	got = nn.NewLeakyReLU(0.1).Forward(xs).Float64Values()
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 1e-6 {
+			t.Errorf("Expected LeakyReLU values: %v\n", want)
+			t.Errorf("Got LeakyReLU values: %v\n", got)
+			break
+		}
+	}
+
+	// softmax along rows sums to 1 for each row
+	ys := ts.MustRandn([]int64{3, 4}, gotch.Double, gotch.CPU)
+	sums := nn.NewSoftmax(1).Forward(ys).MustSum1([]int64{1}, false, gotch.Double, true).Float64Values()
+	for _, s := range sums {
+		if math.Abs(s-1.0) > 1e-6 {
+			t.Errorf("Expected softmax rows to sum to 1, got %v\n", sums)
+			break
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package nn
+
+// A multi-head attention layer.
+
+import (
+	"log"
+	"math"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Multi-head attention config.
+type MultiheadAttentionConfig struct {
+	Dropout     float64 // dropout probability on attention weights
+	Bias        bool    // whether to add bias to the in- and out-projections
+	NeedWeights bool    // whether Forward also returns the attention weights
+}
+
+func DefaultMultiheadAttentionConfig() *MultiheadAttentionConfig {
+	return &MultiheadAttentionConfig{
+		Dropout:     0.0,
+		Bias:        true,
+		NeedWeights: false,
+	}
+}
+
+// MultiheadAttention is a scaled dot-product multi-head attention layer.
+//
+// Inputs are batch first with shape [batch, seqLen, embedDim].
+//
+// Ref. https://arxiv.org/abs/1706.03762
+type MultiheadAttention struct {
+	Config   *MultiheadAttentionConfig
+	InProjWs *ts.Tensor // query, key and value projections stacked with shape [3*embedDim, embedDim]
+	InProjBs *ts.Tensor // undefined when Bias is false
+	OutProj  *Linear
+	EmbedDim int64
+	NumHeads int64
+	HeadDim  int64
+}
+
+// NewMultiheadAttention creates a new MultiheadAttention layer.
+//
+// EmbedDim must be divisible by NumHeads.
+func NewMultiheadAttention(vs *Path, embedDim, numHeads int64, config *MultiheadAttentionConfig) *MultiheadAttention {
+	if numHeads <= 0 {
+		log.Fatalf("NewMultiheadAttention method call: numHeads should be positive. Got %v\n", numHeads)
+	}
+	if embedDim%numHeads != 0 {
+		log.Fatalf("NewMultiheadAttention method call: embedDim (%v) should be divisible by numHeads (%v)\n", embedDim, numHeads)
+	}
+
+	// Xavier uniform
+	bound := math.Sqrt(6.0 / float64(embedDim+3*embedDim))
+	ws := vs.MustNewVar("in_proj_weight", []int64{3 * embedDim, embedDim}, NewUniformInit(-bound, bound))
+	bs := ts.NewTensor()
+	if config.Bias {
+		bs = vs.MustNewVar("in_proj_bias", []int64{3 * embedDim}, NewConstInit(0.0))
+	}
+
+	outProjConfig := DefaultLinearConfig()
+	outProjConfig.Bias = config.Bias
+
+	return &MultiheadAttention{
+		Config:   config,
+		InProjWs: ws,
+		InProjBs: bs,
+		OutProj:  NewLinear(vs.Sub("out_proj"), embedDim, embedDim, outProjConfig),
+		EmbedDim: embedDim,
+		NumHeads: numHeads,
+		HeadDim:  embedDim / numHeads,
+	}
+}
+
+// CausalMask returns an additive attention mask of shape [seqLen, seqLen]
+// with -inf above the diagonal so that each position only attends to itself
+// and earlier positions.
+func CausalMask(seqLen int64, device gotch.Device) *ts.Tensor {
+	future := ts.MustOnes([]int64{seqLen, seqLen}, gotch.Float, device).MustTriu(1, true).MustEq(ts.FloatScalar(1.0), true)
+	mask := ts.MustZeros([]int64{seqLen, seqLen}, gotch.Float, device).MustMaskedFill(future, ts.FloatScalar(math.Inf(-1)), true)
+	future.MustDrop()
+
+	return mask
+}
+
+// project applies the i-th (0: query, 1: key, 2: value) in-projection to xs
+// and splits the result into heads of shape [batch, numHeads, seqLen, headDim].
+func (m *MultiheadAttention) project(xs *ts.Tensor, i int64) *ts.Tensor {
+	size := xs.MustSize()
+	if len(size) != 3 || size[2] != m.EmbedDim {
+		log.Fatalf("MultiheadAttention Forward: expected input of shape [batch, seqLen, %v], got %v\n", m.EmbedDim, size)
+	}
+
+	ws := m.InProjWs.MustNarrow(0, i*m.EmbedDim, m.EmbedDim, false)
+	bs := ts.NewTensor()
+	if m.InProjBs.MustDefined() {
+		bs = m.InProjBs.MustNarrow(0, i*m.EmbedDim, m.EmbedDim, false)
+	}
+	proj := ts.MustLinear(xs, ws, bs)
+	ws.MustDrop()
+	bs.MustDrop()
+
+	return proj.MustView([]int64{size[0], size[1], m.NumHeads, m.HeadDim}, true).MustTranspose(1, 2, true)
+}
+
+// Forward computes attention of query over key and value.
+//
+// query has shape [batch, tgtLen, embedDim], key and value have shape
+// [batch, srcLen, embedDim]. attnMask is an optional (can be nil) additive
+// float mask broadcastable to [batch, numHeads, tgtLen, srcLen], e.g. a
+// [tgtLen, srcLen] mask from `CausalMask` or a [batch, 1, 1, srcLen] padding
+// mask with -inf at padded positions.
+//
+// It returns the attended output of shape [batch, tgtLen, embedDim] and, if
+// NeedWeights is set in config, the attention weights of shape
+// [batch, numHeads, tgtLen, srcLen] (nil otherwise).
+func (m *MultiheadAttention) Forward(query, key, value *ts.Tensor, attnMask *ts.Tensor, train bool) (retVal *ts.Tensor, attnWeights *ts.Tensor) {
+	q := m.project(query, 0)
+	k := m.project(key, 1)
+	v := m.project(value, 2)
+
+	kT := k.MustTranspose(-2, -1, true)
+	scores := q.MustMatmul(kT, true).MustDiv1(ts.FloatScalar(math.Sqrt(float64(m.HeadDim))), true)
+	kT.MustDrop()
+	if attnMask != nil {
+		scores = scores.MustAdd(attnMask, true)
+	}
+
+	weights := scores.MustSoftmax(-1, scores.DType(), true)
+	dropped := ts.MustDropout(weights, m.Config.Dropout, train)
+
+	size := query.MustSize()
+	attended := dropped.MustMatmul(v, true).MustTranspose(1, 2, true).MustReshape([]int64{size[0], size[1], m.EmbedDim}, true)
+	v.MustDrop()
+
+	retVal = m.OutProj.Forward(attended)
+	attended.MustDrop()
+
+	if m.Config.NeedWeights {
+		return retVal, weights
+	}
+	weights.MustDrop()
+
+	return retVal, nil
+}
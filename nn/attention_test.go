@@ -0,0 +1,87 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestMultiheadAttention(t *testing.T) {
+	var (
+		batch    int64 = 2
+		seqLen   int64 = 4
+		embedDim int64 = 8
+		numHeads int64 = 2
+	)
+
+	vs := nn.NewVarStore(gotch.CPU)
+	config := nn.DefaultMultiheadAttentionConfig()
+	config.NeedWeights = true
+	mha := nn.NewMultiheadAttention(vs.Root(), embedDim, numHeads, config)
+
+	// in-projection weight, bias and out-projection weight, bias
+	if vs.Len() != 4 {
+		t.Errorf("Expected 4 variables in var store, got %v\n", vs.Len())
+	}
+
+	xs := ts.MustRandn([]int64{batch, seqLen, embedDim}, gotch.Float, gotch.CPU)
+	mask := nn.CausalMask(seqLen, gotch.CPU)
+	out, weights := mha.Forward(xs, xs, xs, mask, false)
+
+	if want, got := []int64{batch, seqLen, embedDim}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+	if want, got := []int64{batch, numHeads, seqLen, seqLen}, weights.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected attention weights shape: %v\n", want)
+		t.Errorf("Got attention weights shape: %v\n", got)
+	}
+
+	vals := weights.Float64Values()
+	for bh := int64(0); bh < batch*numHeads; bh++ {
+		for i := int64(0); i < seqLen; i++ {
+			var sum float64
+			for j := int64(0); j < seqLen; j++ {
+				w := vals[(bh*seqLen+i)*seqLen+j]
+				sum += w
+				if j > i && w != 0 {
+					t.Errorf("Expected zero attention from position %v to future position %v, got %v\n", i, j, w)
+				}
+			}
+			if sum < 1-1e-5 || sum > 1+1e-5 {
+				t.Errorf("Expected attention weights of position %v to sum to 1, got %v\n", i, sum)
+			}
+		}
+	}
+
+	// without NeedWeights, no attention weights are returned
+	mha.Config.NeedWeights = false
+	if _, weights := mha.Forward(xs, xs, xs, nil, false); weights != nil {
+		t.Errorf("Expected nil attention weights\n")
+	}
+}
+
+func TestMultiheadAttentionNoBias(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	config := nn.DefaultMultiheadAttentionConfig()
+	config.Bias = false
+	mha := nn.NewMultiheadAttention(vs.Root(), 8, 2, config)
+
+	// in-projection and out-projection weights only
+	if vs.Len() != 2 {
+		t.Errorf("Expected 2 variables in var store, got %v\n", vs.Len())
+	}
+	if mha.OutProj.Bs.MustDefined() {
+		t.Errorf("Expected undefined out-projection bias\n")
+	}
+
+	xs := ts.MustRandn([]int64{2, 4, 8}, gotch.Float, gotch.CPU)
+	out, _ := mha.Forward(xs, xs, xs, nil, false)
+	if want, got := []int64{2, 4, 8}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
@@ -43,8 +43,8 @@ func NewBatchNorm(vs *Path, nd uint, outDim int64, config *BatchNormConfig) *Bat
 		config:      config,
 		RunningMean: vs.ZerosNoTrain("running_mean", []int64{outDim}),
 		RunningVar:  vs.OnesNoTrain("running_var", []int64{outDim}),
-		Ws:          vs.NewVar("weight", []int64{outDim}, config.WsInit),
-		Bs:          vs.NewVar("bias", []int64{outDim}, config.BsInit),
+		Ws:          vs.MustNewVar("weight", []int64{outDim}, config.WsInit),
+		Bs:          vs.MustNewVar("bias", []int64{outDim}, config.BsInit),
 	}
 }
 
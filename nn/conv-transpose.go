@@ -66,6 +66,8 @@ func NewConvTranspose1D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *Conv
 		log.Fatalf("NewConvTranspose1D method call: Kernel size should be 1. Got %v\n", len(ksizes))
 	}
 
+	checkConvGroups("NewConvTranspose1D", inDim, outDim, cfg.Groups)
+
 	var (
 		ws *ts.Tensor
 		bs *ts.Tensor = ts.NewTensor()
@@ -73,10 +75,10 @@ func NewConvTranspose1D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *Conv
 
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, ksizes...)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 
 	return &ConvTranspose1D{
@@ -98,17 +100,19 @@ func NewConvTranspose2D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *Conv
 		log.Fatalf("NewConvTranspose2D method call: Kernel size should be 2. Got %v\n", len(ksizes))
 	}
 
+	checkConvGroups("NewConvTranspose2D", inDim, outDim, cfg.Groups)
+
 	var (
 		ws *ts.Tensor
 		bs *ts.Tensor = ts.NewTensor()
 	)
 
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, ksizes...)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &ConvTranspose2D{
 		Ws:     ws,
@@ -128,17 +132,19 @@ func NewConvTranspose3D(vs *Path, inDim, outDim int64, ksizes []int64, cfg *Conv
 		log.Fatalf("NewConvTranspose3D method call: Kernel size should be 3. Got %v\n", len(ksizes))
 	}
 
+	checkConvGroups("NewConvTranspose3D", inDim, outDim, cfg.Groups)
+
 	var (
 		ws *ts.Tensor
 		bs *ts.Tensor = ts.NewTensor()
 	)
 
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, ksizes...)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &ConvTranspose3D{
 		Ws:     ws,
@@ -160,3 +166,12 @@ func (c *ConvTranspose2D) Forward(xs *ts.Tensor) *ts.Tensor {
 func (c *ConvTranspose3D) Forward(xs *ts.Tensor) *ts.Tensor {
 	return ts.MustConvTranspose3d(xs, c.Ws, c.Bs, c.Config.Stride, c.Config.Padding, c.Config.OutputPadding, c.Config.Groups, c.Config.Dilation)
 }
+
+// ConvTransposeOutputSize returns the size of one spatial dimension of the
+// output of a transposed convolution given the input size and the layer
+// parameters along that dimension:
+//
+//	out = (in-1)*stride - 2*padding + dilation*(kernel-1) + outputPadding + 1
+func ConvTransposeOutputSize(inSize, kernel, stride, padding, outputPadding, dilation int64) int64 {
+	return (inSize-1)*stride - 2*padding + dilation*(kernel-1) + outputPadding + 1
+}
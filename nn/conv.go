@@ -4,6 +4,7 @@ package nn
 
 import (
 	"fmt"
+	"log"
 	"reflect"
 
 	ts "github.com/sugarme/gotch/tensor"
@@ -65,6 +66,30 @@ func DefaultConv2DConfig() *Conv2DConfig {
 	}
 }
 
+// DefaultConv3DConfig creates a default 3D ConvConfig
+func DefaultConv3DConfig() *Conv3DConfig {
+	return &Conv3DConfig{
+		Stride:   []int64{1, 1, 1},
+		Padding:  []int64{0, 0, 0},
+		Dilation: []int64{1, 1, 1},
+		Groups:   1,
+		Bias:     true,
+		WsInit:   NewKaimingUniformInit(),
+		BsInit:   NewConstInit(float64(0.0)),
+	}
+}
+
+// checkConvGroups validates that input and output channels are divisible by
+// the number of groups.
+func checkConvGroups(layer string, inDim, outDim, groups int64) {
+	if groups <= 0 {
+		log.Fatalf("%v method call: Groups should be positive. Got %v\n", layer, groups)
+	}
+	if inDim%groups != 0 || outDim%groups != 0 {
+		log.Fatalf("%v method call: input (%v) and output (%v) channels should be divisible by Groups (%v)\n", layer, inDim, outDim, groups)
+	}
+}
+
 type Conv1D struct {
 	Ws     *ts.Tensor
 	Bs     *ts.Tensor // optional
@@ -76,12 +101,13 @@ func NewConv1D(vs *Path, inDim, outDim, k int64, cfg *Conv1DConfig) *Conv1D {
 		ws *ts.Tensor
 		bs *ts.Tensor = ts.NewTensor()
 	)
+	checkConvGroups("NewConv1D", inDim, outDim, cfg.Groups)
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, k)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &Conv1D{
 		Ws:     ws,
@@ -101,12 +127,13 @@ func NewConv2D(vs *Path, inDim, outDim int64, k int64, cfg *Conv2DConfig) *Conv2
 		ws *ts.Tensor
 		bs *ts.Tensor = ts.NewTensor()
 	)
+	checkConvGroups("NewConv2D", inDim, outDim, cfg.Groups)
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, k, k)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &Conv2D{
 		Ws:     ws,
@@ -126,12 +153,13 @@ func NewConv3D(vs *Path, inDim, outDim, k int64, cfg *Conv3DConfig) *Conv3D {
 		ws *ts.Tensor
 		bs *ts.Tensor = ts.NewTensor()
 	)
+	checkConvGroups("NewConv3D", inDim, outDim, cfg.Groups)
 	if cfg.Bias {
-		bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+		bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 	}
 	weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 	weightSize = append(weightSize, k, k, k)
-	ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+	ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 
 	return &Conv3D{
 		Ws:     ws,
@@ -201,12 +229,13 @@ func NewConv(vs *Path, inDim, outDim int64, ksizes []int64, config interface{})
 	switch {
 	case len(ksizes) == 1 && configT.String() == "*nn.Conv1DConfig":
 		cfg := config.(*Conv1DConfig)
+		checkConvGroups("NewConv", inDim, outDim, cfg.Groups)
 		if cfg.Bias {
-			bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 		}
 		weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 		weightSize = append(weightSize, ksizes...)
-		ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+		ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 		return &Conv1D{
 			Ws:     ws,
 			Bs:     bs,
@@ -214,12 +243,13 @@ func NewConv(vs *Path, inDim, outDim int64, ksizes []int64, config interface{})
 		}
 	case len(ksizes) == 2 && configT.String() == "*nn.Conv2DConfig":
 		cfg := config.(*Conv2DConfig)
+		checkConvGroups("NewConv", inDim, outDim, cfg.Groups)
 		if cfg.Bias {
-			bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 		}
 		weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 		weightSize = append(weightSize, ksizes...)
-		ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+		ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 		return &Conv2D{
 			Ws:     ws,
 			Bs:     bs,
@@ -227,12 +257,13 @@ func NewConv(vs *Path, inDim, outDim int64, ksizes []int64, config interface{})
 		}
 	case len(ksizes) == 3 && configT.String() == "*nn.Conv3DConfig":
 		cfg := config.(*Conv3DConfig)
+		checkConvGroups("NewConv", inDim, outDim, cfg.Groups)
 		if cfg.Bias {
-			bs = vs.NewVar("bias", []int64{outDim}, cfg.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, cfg.BsInit)
 		}
 		weightSize := []int64{outDim, int64(inDim / cfg.Groups)}
 		weightSize = append(weightSize, ksizes...)
-		ws = vs.NewVar("weight", weightSize, cfg.WsInit)
+		ws = vs.MustNewVar("weight", weightSize, cfg.WsInit)
 		return &Conv3D{
 			Ws:     ws,
 			Bs:     bs,
@@ -273,3 +304,32 @@ func (c *Conv2D) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
 func (c *Conv3D) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
 	return ts.MustConv3d(xs, c.Ws, c.Bs, c.Config.Stride, c.Config.Padding, c.Config.Dilation, c.Config.Groups)
 }
+
+// Implement WeightedModule for Conv1D, Conv2D, Conv3D:
+// ====================================================
+
+func (c *Conv1D) WeightTensor() *ts.Tensor { return c.Ws }
+func (c *Conv2D) WeightTensor() *ts.Tensor { return c.Ws }
+func (c *Conv3D) WeightTensor() *ts.Tensor { return c.Ws }
+
+func (c *Conv1D) SetWeightTensor(w *ts.Tensor) { c.Ws = w }
+func (c *Conv2D) SetWeightTensor(w *ts.Tensor) { c.Ws = w }
+func (c *Conv3D) SetWeightTensor(w *ts.Tensor) { c.Ws = w }
+
+// ConvOutputSize returns the size of one spatial dimension of the output of a
+// convolution (or pooling) given the input size and the layer parameters
+// along that dimension:
+//
+//	out = floor((in + 2*padding - dilation*(kernel-1) - 1) / stride) + 1
+//
+// NOTE. a result smaller than 1 means the input is too small for the kernel.
+func ConvOutputSize(inSize, kernel, stride, padding, dilation int64) int64 {
+	n := inSize + 2*padding - dilation*(kernel-1) - 1
+	out := n / stride
+	// floor division for negative numerators
+	if n%stride != 0 && n < 0 {
+		out--
+	}
+
+	return out + 1
+}
@@ -0,0 +1,125 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestConvPreserveSize(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+
+	cfg1 := nn.DefaultConv1DConfig()
+	cfg1.Padding = []int64{1}
+	conv1 := nn.NewConv1D(root.Sub("conv1"), 4, 8, 3, cfg1)
+	out := conv1.Forward(ts.MustRandn([]int64{2, 4, 10}, gotch.Float, gotch.CPU))
+	if want, got := []int64{2, 8, 10}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected Conv1D output shape: %v\n", want)
+		t.Errorf("Got Conv1D output shape: %v\n", got)
+	}
+
+	cfg2 := nn.DefaultConv2DConfig()
+	cfg2.Padding = []int64{1, 1}
+	conv2 := nn.NewConv2D(root.Sub("conv2"), 4, 8, 3, cfg2)
+	out = conv2.Forward(ts.MustRandn([]int64{2, 4, 10, 12}, gotch.Float, gotch.CPU))
+	if want, got := []int64{2, 8, 10, 12}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected Conv2D output shape: %v\n", want)
+		t.Errorf("Got Conv2D output shape: %v\n", got)
+	}
+
+	cfg3 := nn.DefaultConv3DConfig()
+	cfg3.Padding = []int64{1, 1, 1}
+	conv3 := nn.NewConv3D(root.Sub("conv3"), 4, 8, 3, cfg3)
+	out = conv3.Forward(ts.MustRandn([]int64{2, 4, 5, 6, 7}, gotch.Float, gotch.CPU))
+	if want, got := []int64{2, 8, 5, 6, 7}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected Conv3D output shape: %v\n", want)
+		t.Errorf("Got Conv3D output shape: %v\n", got)
+	}
+}
+
+func TestConv2DGroups(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := nn.DefaultConv2DConfig()
+	cfg.Groups = 2
+	conv := nn.NewConv2D(vs.Root(), 4, 6, 3, cfg)
+
+	if want, got := []int64{6, 2, 3, 3}, conv.Ws.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected weight shape: %v\n", want)
+		t.Errorf("Got weight shape: %v\n", got)
+	}
+
+	out := conv.Forward(ts.MustRandn([]int64{1, 4, 8, 8}, gotch.Float, gotch.CPU))
+	if want, got := []int64{1, 6, 6, 6}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
+
+func TestConvOutputSize(t *testing.T) {
+	tests := []struct {
+		name                                       string
+		in, kernel, stride, padding, dilation, out int64
+	}{
+		{"same padding", 10, 3, 1, 1, 1, 10},
+		{"valid", 32, 5, 1, 0, 1, 28},
+		{"strided", 224, 7, 2, 3, 1, 112},
+		{"strided floor", 7, 3, 2, 0, 1, 3},
+		{"pool", 112, 3, 2, 1, 1, 56},
+		{"dilated", 50, 3, 1, 0, 2, 46},
+		{"too small", 2, 5, 1, 0, 1, -2},
+		{"too small strided", 1, 5, 2, 0, 1, -1},
+	}
+	for _, tt := range tests {
+		if got := nn.ConvOutputSize(tt.in, tt.kernel, tt.stride, tt.padding, tt.dilation); got != tt.out {
+			t.Errorf("Expected %v output size: %v\n", tt.name, tt.out)
+			t.Errorf("Got %v output size: %v\n", tt.name, got)
+		}
+	}
+
+	// agrees with the actual layer
+	cfg := nn.DefaultConv2DConfig()
+	cfg.Stride = []int64{2, 2}
+	cfg.Padding = []int64{1, 1}
+	cfg.Dilation = []int64{2, 2}
+	vs := nn.NewVarStore(gotch.CPU)
+	conv := nn.NewConv2D(vs.Root(), 1, 1, 3, cfg)
+	out := conv.Forward(ts.MustRandn([]int64{1, 1, 11, 12}, gotch.Float, gotch.CPU))
+	want := []int64{1, 1, nn.ConvOutputSize(11, 3, 2, 1, 2), nn.ConvOutputSize(12, 3, 2, 1, 2)}
+	if got := out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected conv output shape: %v\n", want)
+		t.Errorf("Got conv output shape: %v\n", got)
+	}
+}
+
+func TestConvTransposeOutputSize(t *testing.T) {
+	tests := []struct {
+		name                                                      string
+		in, kernel, stride, padding, outputPadding, dilation, out int64
+	}{
+		{"identity", 10, 1, 1, 0, 0, 1, 10},
+		{"upsample", 16, 4, 2, 1, 0, 1, 32},
+		{"output padding", 7, 3, 2, 1, 1, 1, 14},
+		{"no padding", 5, 3, 2, 0, 0, 1, 11},
+		{"dilated", 5, 3, 1, 0, 0, 2, 9},
+	}
+	for _, tt := range tests {
+		got := nn.ConvTransposeOutputSize(tt.in, tt.kernel, tt.stride, tt.padding, tt.outputPadding, tt.dilation)
+		if got != tt.out {
+			t.Errorf("Expected %v output size: %v\n", tt.name, tt.out)
+			t.Errorf("Got %v output size: %v\n", tt.name, got)
+		}
+	}
+
+	// inverts the size of a strided conv on an even input when given an output
+	// padding of 1
+	in := int64(8)
+	down := nn.ConvOutputSize(in, 3, 2, 1, 1)
+	if got := nn.ConvTransposeOutputSize(down, 3, 2, 1, 1, 1); got != in {
+		t.Errorf("Expected round-trip size: %v\n", in)
+		t.Errorf("Got round-trip size: %v\n", got)
+	}
+}
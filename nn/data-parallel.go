@@ -0,0 +1,289 @@
+package nn
+
+// Data-parallel training across multiple devices.
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// DataParallel replicates a module on multiple devices. Each forward pass
+// splits the input batch along the first dimension, runs the replicas in
+// parallel and gathers the outputs on the first device.
+//
+// The first replica is the module built on the source var-store. Other
+// replicas are built on their own var-stores with copied variable values.
+// After backward pass, `ReduceGrads` accumulates replica gradients into the
+// source var-store so that an optimizer built on it can update variables.
+// Then `Sync` copies the updated values back to the replicas.
+type DataParallel struct {
+	vs       *VarStore
+	replicas []ts.ModuleT
+	stores   []*VarStore // var-stores of replicas (stores[0] is the source var-store)
+	devices  []gotch.Device
+}
+
+// NewDataParallel creates a DataParallel module.
+//
+// `build` builds the module from a path. It is called once on the source
+// var-store `vs` and once for each of the other devices. `devices[0]` should be
+// the device of `vs`.
+func NewDataParallel(vs *VarStore, build func(p *Path) ts.ModuleT, devices []gotch.Device) *DataParallel {
+	if len(devices) == 0 {
+		log.Fatalf("NewDataParallel - expected at least one device\n")
+	}
+	if devices[0] != vs.Device() {
+		log.Fatalf("NewDataParallel - first device (%v) should be the var-store device (%v)\n", devices[0], vs.Device())
+	}
+
+	dp := &DataParallel{
+		vs:       vs,
+		replicas: []ts.ModuleT{build(vs.Root())},
+		stores:   []*VarStore{vs},
+		devices:  devices,
+	}
+
+	for _, device := range devices[1:] {
+		rvs := NewVarStore(device)
+		m := build(rvs.Root())
+		if err := rvs.Copy(*vs); err != nil {
+			log.Fatalf("NewDataParallel - copying variables to %v failed: %v\n", device, err)
+		}
+		dp.replicas = append(dp.replicas, m)
+		dp.stores = append(dp.stores, rvs)
+	}
+
+	return dp
+}
+
+// Forward implements Module interface for DataParallel.
+func (dp *DataParallel) Forward(xs *ts.Tensor) *ts.Tensor {
+	return dp.ForwardT(xs, false)
+}
+
+// ForwardT implements ModuleT interface for DataParallel.
+//
+// The replicas run with the grad mode (see `ts.NoGrad`) and autocast mode
+// (see `ts.Autocast`) of the caller.
+func (dp *DataParallel) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	retVal, err := dp.forwardT(xs, train)
+	if err != nil {
+		log.Fatalf("DataParallel - ForwardT method call error: %v\n", err)
+	}
+
+	return retVal
+}
+
+func (dp *DataParallel) forwardT(xs *ts.Tensor, train bool) (*ts.Tensor, error) {
+	chunks, err := xs.Chunk(int64(len(dp.devices)), 0)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]*ts.Tensor, len(chunks))
+	errs := make([]error, len(chunks))
+
+	// NOTE. grad and autocast modes are thread-local in libtorch, they have to
+	// be set again on the thread running each replica.
+	gradEnabled := ts.GradIsEnabled()
+	autocastEnabled := ts.AutocastIsEnabled()
+
+	var wg sync.WaitGroup
+	for i := range chunks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+
+			forward := func() {
+				outputs[i], errs[i] = dp.forwardReplica(i, &chunks[i], train)
+			}
+			ts.Autocast(autocastEnabled, func() {
+				if gradEnabled {
+					ts.WithGrad(forward)
+				} else {
+					ts.NoGrad(forward)
+				}
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	var msgs []string
+	for i, err := range errs {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("replica %v on %v: %v", i, dp.devices[i], err))
+		}
+	}
+
+	var retVal *ts.Tensor
+	if len(msgs) == 0 {
+		gathered := make([]ts.Tensor, len(outputs))
+		for i := range outputs {
+			gathered[i] = *outputs[i]
+		}
+		retVal, err = ts.Cat(gathered, 0)
+	} else {
+		err = fmt.Errorf("%v", strings.Join(msgs, "; "))
+	}
+
+	for i := range chunks {
+		chunks[i].MustDrop()
+		if outputs[i] != nil {
+			outputs[i].MustDrop()
+		}
+	}
+
+	return retVal, err
+}
+
+// forwardReplica runs the i-th replica on its chunk of the input and moves
+// the output to the first device.
+func (dp *DataParallel) forwardReplica(i int, chunk *ts.Tensor, train bool) (*ts.Tensor, error) {
+	input, err := chunk.ToDevice(dp.devices[i], false)
+	if err != nil {
+		return nil, err
+	}
+	out := dp.replicas[i].ForwardT(input, train)
+	input.MustDrop()
+
+	retVal, err := out.ToDevice(dp.devices[0], false)
+	out.MustDrop()
+
+	return retVal, err
+}
+
+// ReduceGrads accumulates gradients of all replicas into the variables of the
+// source var-store.
+func (dp *DataParallel) ReduceGrads() error {
+	dp.vs.Vars.mutex.Lock()
+	defer dp.vs.Vars.mutex.Unlock()
+
+	for name, v := range dp.vs.Vars.NamedVariables {
+		grad := v.MustGrad(false)
+		for _, rvs := range dp.stores[1:] {
+			rvs.Vars.mutex.Lock()
+			rv, ok := rvs.Vars.NamedVariables[name]
+			rvs.Vars.mutex.Unlock()
+			if !ok {
+				grad.MustDrop()
+				return fmt.Errorf("ReduceGrads error: cannot find %v in replica var store.\n", name)
+			}
+			rgrad := rv.MustGrad(false)
+			if !rgrad.MustDefined() {
+				rgrad.MustDrop()
+				continue
+			}
+			if !grad.MustDefined() {
+				grad.MustDrop()
+				return fmt.Errorf("ReduceGrads error: undefined gradient for %v in source var store.\n", name)
+			}
+			g := rgrad.MustToDevice(dp.devices[0], false)
+			grad.MustAdd_(g)
+			g.MustDrop()
+			rgrad.MustDrop()
+		}
+		grad.MustDrop()
+	}
+
+	return nil
+}
+
+// Sync copies variable values of the source var-store to all replicas. It
+// should be called after each optimization step.
+func (dp *DataParallel) Sync() error {
+	for _, rvs := range dp.stores[1:] {
+		if err := rvs.Copy(*dp.vs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ZeroGrad zeroes gradients of the replicas. Gradients of the source
+// var-store are zeroed by its optimizer.
+func (dp *DataParallel) ZeroGrad() {
+	for _, rvs := range dp.stores[1:] {
+		rvs.Vars.mutex.Lock()
+		for _, v := range rvs.Vars.NamedVariables {
+			v.ZeroGrad()
+		}
+		rvs.Vars.mutex.Unlock()
+	}
+}
+
+// AllReduceGrads reduces gradients of all variables of a var-store with a
+// user-supplied reduction, e.g. averaging across processes through any
+// transport.
+//
+// `reduce` receives the gradients of the variables ordered by variable name
+// (undefined gradients are given as zeros) and should return a flat 1D tensor
+// holding the reduced gradients concatenated in the same order. The result is
+// written back to the gradients in place.
+func AllReduceGrads(vs *VarStore, reduce func([]ts.Tensor) *ts.Tensor) error {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	var names []string
+	for name := range vs.Vars.NamedVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	grads := make([]ts.Tensor, len(names))
+	var total int64
+	for i, name := range names {
+		v := vs.Vars.NamedVariables[name]
+		grad := v.MustGrad(false)
+		if !grad.MustDefined() {
+			grad.MustDrop()
+			grad = v.MustZerosLike(false)
+		}
+		grads[i] = *grad
+		total += int64(grad.Numel())
+	}
+
+	reduced := reduce(grads)
+	if reduced == nil {
+		for i := range grads {
+			grads[i].MustDrop()
+		}
+		return fmt.Errorf("AllReduceGrads error: reduce returned a nil tensor.\n")
+	}
+	defer reduced.MustDrop()
+
+	if reduced.Dim() != 1 || reduced.MustSize()[0] != total {
+		for i := range grads {
+			grads[i].MustDrop()
+		}
+		return fmt.Errorf("AllReduceGrads error: expected reduced gradients of shape [%v], got %v.\n", total, reduced.MustSize())
+	}
+
+	var offset int64
+	for i, name := range names {
+		numel := int64(grads[i].Numel())
+		src := reduced.MustNarrow(0, offset, numel, false).MustView(grads[i].MustSize(), true)
+		offset += numel
+
+		v := vs.Vars.NamedVariables[name]
+		grad := v.MustGrad(false)
+		if grad.MustDefined() {
+			ts.NoGrad(func() {
+				grad.Copy_(src)
+			})
+		}
+		grad.MustDrop()
+		src.MustDrop()
+		grads[i].MustDrop()
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package nn_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestDataParallel(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	build := func(p *nn.Path) ts.ModuleT {
+		return nn.NewLinear(p, 4, 3, nn.DefaultLinearConfig())
+	}
+
+	// CPU replicas
+	dp := nn.NewDataParallel(vs, build, []gotch.Device{gotch.CPU, gotch.CPU})
+	single, err := vs.Root().Get("weight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bias, err := vs.Root().Get("bias")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xs := ts.MustRandn([]int64{6, 4}, gotch.Float, gotch.CPU)
+
+	// single-device forward using source var-store variables
+	want := xs.MustMatmul(single.MustT(false), false).MustAdd(bias, true)
+	got := dp.ForwardT(xs, true)
+	assertAllClose(t, "output", want.Float64Values(), got.Float64Values())
+
+	// gradients reduce across replicas
+	loss := got.MustSum(gotch.Float, true)
+	loss.MustBackward()
+	if err := dp.ReduceGrads(); err != nil {
+		t.Fatal(err)
+	}
+	// d(sum(x*W^T + b))/dW = sum of rows of x for each output
+	wantGrad := xs.MustSum1([]int64{0}, true, gotch.Float, false).MustExpand([]int64{3, 4}, true, true)
+	assertAllClose(t, "weight grad", wantGrad.Float64Values(), single.MustGrad(false).Float64Values())
+}
+
+func TestDataParallelNoGrad(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	build := func(p *nn.Path) ts.ModuleT {
+		return nn.NewLinear(p, 4, 3, nn.DefaultLinearConfig())
+	}
+	dp := nn.NewDataParallel(vs, build, []gotch.Device{gotch.CPU, gotch.CPU})
+
+	xs := ts.MustRandn([]int64{6, 4}, gotch.Float, gotch.CPU)
+
+	// grad mode of the caller applies to all replicas
+	var got *ts.Tensor
+	ts.NoGrad(func() {
+		got = dp.ForwardT(xs, false)
+	})
+	if got.MustRequiresGrad() {
+		t.Errorf("Expected output not requiring grad under NoGrad\n")
+	}
+
+	got = dp.ForwardT(xs, false)
+	if !got.MustRequiresGrad() {
+		t.Errorf("Expected output requiring grad\n")
+	}
+}
+
+func assertAllClose(t *testing.T, name string, want, got []float64) {
+	if len(want) != len(got) {
+		t.Errorf("Expected %v: %v\n", name, want)
+		t.Errorf("Got %v: %v\n", name, got)
+		return
+	}
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 1e-5 {
+			t.Errorf("Expected %v: %v\n", name, want)
+			t.Errorf("Got %v: %v\n", name, got)
+			return
+		}
+	}
+}
+
+func TestAllReduceGrads(t *testing.T) {
+	newModel := func() (*nn.VarStore, *nn.Linear) {
+		vs := nn.NewVarStore(gotch.CPU)
+		return vs, nn.NewLinear(vs.Root(), 3, 2, nn.DefaultLinearConfig())
+	}
+	vs1, m1 := newModel()
+	vs2, m2 := newModel()
+
+	m1.Forward(ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)).MustSum(gotch.Float, true).MustBackward()
+	m2.Forward(ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)).MustSum(gotch.Float, true).MustBackward()
+
+	flatten := func(grads []ts.Tensor) *ts.Tensor {
+		var flat []ts.Tensor
+		for _, g := range grads {
+			flat = append(flat, *g.MustView([]int64{-1}, false))
+		}
+		return ts.MustCat(flat, 0)
+	}
+
+	// in-process transport: capture grads of the 2nd store first.
+	var grads2 *ts.Tensor
+	err := nn.AllReduceGrads(vs2, func(grads []ts.Tensor) *ts.Tensor {
+		grads2 = flatten(grads)
+		return flatten(grads)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var grads1 *ts.Tensor
+	err = nn.AllReduceGrads(vs1, func(grads []ts.Tensor) *ts.Tensor {
+		grads1 = flatten(grads)
+		return grads1.MustAdd(grads2, false).MustDiv1(ts.FloatScalar(2.0), true)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// grads are ordered by name: bias, weight
+	want := grads1.MustAdd(grads2, false).MustDiv1(ts.FloatScalar(2.0), true).Float64Values()
+	got := append(m1.Bs.MustGrad(false).Float64Values(), vs1.Variables()["weight"].MustGrad(false).Float64Values()...)
+	assertAllClose(t, "averaged grads", want, got)
+
+	err = nn.AllReduceGrads(vs1, func(grads []ts.Tensor) *ts.Tensor {
+		return nil
+	})
+	if err == nil {
+		t.Errorf("Expected error for a nil reduced tensor, got nil\n")
+	}
+	assertAllClose(t, "grads after failed reduce", want, append(m1.Bs.MustGrad(false).Float64Values(), vs1.Variables()["weight"].MustGrad(false).Float64Values()...))
+}
@@ -0,0 +1,280 @@
+package nn
+
+// Helpers for diagnosing training issues.
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// GradNormReport returns the L2 norm of the gradient of each variable in the
+// var-store, keyed by variable name.
+//
+// It should be called after a backward pass. Variables without gradient (e.g.
+// frozen or not yet back-propagated) have a norm of 0.
+func GradNormReport(vs *VarStore) map[string]float64 {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	report := make(map[string]float64, len(vs.Vars.NamedVariables))
+	for name, v := range vs.Vars.NamedVariables {
+		report[name] = gradNorm(v)
+	}
+
+	return report
+}
+
+// gradNorm returns the L2 norm of the gradient of a tensor or 0 if it has no
+// gradient.
+func gradNorm(x *ts.Tensor) float64 {
+	grad := x.MustGrad(false)
+	defer grad.MustDrop()
+
+	if !grad.MustDefined() {
+		return 0.0
+	}
+
+	norm := grad.MustNorm(false)
+	retVal := norm.Float64Values()[0]
+	norm.MustDrop()
+
+	return retVal
+}
+
+// ParamStats holds summary statistics of a parameter tensor.
+type ParamStats struct {
+	Mean float64
+	Std  float64 // unbiased standard deviation
+	Min  float64
+	Max  float64
+}
+
+// ParameterStats summarizes each variable of the var-store, keyed by variable
+// name. It helps detecting dead or saturated layers during training.
+func (vs *VarStore) ParameterStats() map[string]ParamStats {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	stats := make(map[string]ParamStats, len(vs.Vars.NamedVariables))
+	for name, v := range vs.Vars.NamedVariables {
+		stats[name] = paramStats(v)
+	}
+
+	return stats
+}
+
+func paramStats(x *ts.Tensor) ParamStats {
+	// NOTE. compute on a double copy detached from the graph
+	xs := x.MustDetach(false).MustTotype(gotch.Double, true)
+	defer xs.MustDrop()
+
+	scalar := func(t *ts.Tensor) float64 {
+		retVal := t.Float64Values()[0]
+		t.MustDrop()
+		return retVal
+	}
+
+	return ParamStats{
+		Mean: scalar(xs.MustMean(gotch.Double, false)),
+		Std:  scalar(xs.MustStd(true, false)),
+		Min:  scalar(xs.MustMin(false)),
+		Max:  scalar(xs.MustMax(false)),
+	}
+}
+
+// DeadReLUMonitor tracks the fraction of zero activations of ReLU layers
+// across batches to detect dead units.
+//
+// Layers to monitor are wrapped with `Wrap` and used in place of the
+// original ones. Each forward pass of a wrapped layer updates its statistics.
+type DeadReLUMonitor struct {
+	Threshold float64 // fraction of zero activations above which a layer is reported as dead
+	names     []string
+	stats     map[string]*reluStats
+}
+
+type reluStats struct {
+	zeros int64
+	total int64
+}
+
+// NewDeadReLUMonitor creates a new DeadReLUMonitor with given threshold
+// (e.g. 0.99).
+func NewDeadReLUMonitor(threshold float64) *DeadReLUMonitor {
+	return &DeadReLUMonitor{
+		Threshold: threshold,
+		names:     make([]string, 0),
+		stats:     make(map[string]*reluStats),
+	}
+}
+
+// Wrap registers a ReLU layer under a given name and returns a layer which
+// forwards to it while recording activation statistics.
+func (m *DeadReLUMonitor) Wrap(name string, relu ts.Module) *MonitoredReLU {
+	if _, ok := m.stats[name]; ok {
+		log.Fatalf("DeadReLUMonitor - layer name %q already registered\n", name)
+	}
+	m.names = append(m.names, name)
+	m.stats[name] = &reluStats{}
+
+	return &MonitoredReLU{
+		name:    name,
+		relu:    relu,
+		monitor: m,
+	}
+}
+
+// ZeroFraction returns the fraction of zero activations recorded for a layer.
+func (m *DeadReLUMonitor) ZeroFraction(name string) float64 {
+	st, ok := m.stats[name]
+	if !ok || st.total == 0 {
+		return 0.0
+	}
+
+	return float64(st.zeros) / float64(st.total)
+}
+
+// DeadLayers returns names of layers whose fraction of zero activations
+// exceeds the threshold, in registration order.
+func (m *DeadReLUMonitor) DeadLayers() []string {
+	var dead []string
+	for _, name := range m.names {
+		if m.stats[name].total > 0 && m.ZeroFraction(name) > m.Threshold {
+			dead = append(dead, name)
+		}
+	}
+
+	return dead
+}
+
+// Reset clears all recorded statistics.
+func (m *DeadReLUMonitor) Reset() {
+	for _, st := range m.stats {
+		st.zeros = 0
+		st.total = 0
+	}
+}
+
+func (m *DeadReLUMonitor) record(name string, out *ts.Tensor) {
+	zeros := out.MustEq(ts.FloatScalar(0.0), false).MustSum(gotch.Int64, true)
+	st := m.stats[name]
+	st.zeros += zeros.Int64Values()[0]
+	st.total += int64(out.Numel())
+	zeros.MustDrop()
+}
+
+// MonitoredReLU is a ReLU layer wrapped by a DeadReLUMonitor.
+type MonitoredReLU struct {
+	name    string
+	relu    ts.Module
+	monitor *DeadReLUMonitor
+}
+
+// Forward implements Module interface for MonitoredReLU.
+func (r *MonitoredReLU) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	retVal = r.relu.Forward(xs)
+	r.monitor.record(r.name, retVal)
+
+	return retVal
+}
+
+// ForwardT implements ModuleT interface for MonitoredReLU.
+//
+// NOTE: train param will not be used.
+func (r *MonitoredReLU) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return r.Forward(xs)
+}
+
+// auditStdFactor is the ratio between the standard deviation of a weight and
+// its fan-based reference value (1/sqrt(fanIn)) above or below which
+// AuditInit reports the weight as suspicious.
+const auditStdFactor = 10.0
+
+// AuditInit checks the variables of the var-store for suspicious
+// initialization and returns a warning for each problem found, sorted by
+// variable name.
+//
+// The following checks are done:
+//   - any variable containing NaN or Inf values.
+//   - a weight (variable with 2 or more dimensions) being all zeros.
+//   - a weight whose standard deviation differs from 1/sqrt(fanIn) by more
+//     than a factor of 10, where fanIn is the product of all but the first
+//     dimension.
+//
+// NOTE: weights which are not fan-based initialized by design (e.g. embeddings)
+// can be reported by the last check.
+func AuditInit(vs *VarStore) []string {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	var names []string
+	for name := range vs.Vars.NamedVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var warnings []string
+	for _, name := range names {
+		warnings = append(warnings, auditParam(name, vs.Vars.NamedVariables[name])...)
+	}
+
+	return warnings
+}
+
+func auditParam(name string, x *ts.Tensor) []string {
+	xs := x.MustDetach(false).MustTotype(gotch.Double, true)
+	dims := xs.MustSize()
+	values := xs.Float64Values()
+	xs.MustDrop()
+
+	var (
+		warnings  []string
+		nonFinite int
+		zeros     int
+		sum       float64
+	)
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v) || math.IsInf(v, 0):
+			nonFinite++
+		case v == 0:
+			zeros++
+		}
+		sum += v
+	}
+
+	if nonFinite > 0 {
+		warnings = append(warnings, fmt.Sprintf("%s: %d of %d values are NaN or Inf", name, nonFinite, len(values)))
+		return warnings
+	}
+
+	// NOTE. biases and other 1-D variables are commonly zero or constant.
+	if len(dims) < 2 || len(values) < 2 {
+		return warnings
+	}
+
+	if zeros == len(values) {
+		warnings = append(warnings, fmt.Sprintf("%s: weight is all zeros", name))
+		return warnings
+	}
+
+	mean := sum / float64(len(values))
+	var sqSum float64
+	for _, v := range values {
+		sqSum += (v - mean) * (v - mean)
+	}
+	std := math.Sqrt(sqSum / float64(len(values)-1))
+
+	fanIn := product(dims[1:])
+	refStd := 1.0 / math.Sqrt(float64(fanIn))
+	if std > refStd*auditStdFactor || std < refStd/auditStdFactor {
+		warnings = append(warnings, fmt.Sprintf("%s: std %.4g is far from expected %.4g for fan-in %d", name, std, refStd, fanIn))
+	}
+
+	return warnings
+}
@@ -0,0 +1,142 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestGradNormReport(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	linear := nn.NewLinear(vs.Root(), 4, 2, nn.DefaultLinearConfig())
+	opt, err := nn.DefaultSGDConfig().Build(vs, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xs := ts.MustRandn([]int64{8, 4}, gotch.Float, gotch.CPU)
+	loss := linear.Forward(xs).MustSum(gotch.Float, true)
+	loss.MustBackward()
+
+	report := nn.GradNormReport(vs)
+	for _, name := range []string{"weight", "bias"} {
+		norm, ok := report[name]
+		if !ok {
+			t.Errorf("Expected gradient norm reported for %q\n", name)
+			continue
+		}
+		if norm == 0 {
+			t.Errorf("Expected nonzero gradient norm for %q after backward\n", name)
+		}
+	}
+
+	opt.ZeroGrad()
+	for name, norm := range nn.GradNormReport(vs) {
+		if norm != 0 {
+			t.Errorf("Expected zero gradient norm for %q after ZeroGrad, got %v\n", name, norm)
+		}
+	}
+}
+
+func TestParameterStats(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := &nn.LinearConfig{
+		WsInit: nn.NewConstInit(0.5),
+		BsInit: nn.NewConstInit(0.1),
+		Bias:   true,
+	}
+	nn.NewLinear(vs.Root().Sub("fc"), 3, 2, cfg)
+	vs.Root().Add("w", ts.MustOfSlice([]float64{1, 2, 3, 4}), true)
+
+	stats := vs.ParameterStats()
+
+	want := map[string]nn.ParamStats{
+		"fc.weight": {Mean: 0.5, Std: 0, Min: 0.5, Max: 0.5},
+		"fc.bias":   {Mean: 0.1, Std: 0, Min: 0.1, Max: 0.1},
+		// unbiased std: sqrt(((1.5^2 + 0.5^2) * 2) / 3)
+		"w": {Mean: 2.5, Std: math.Sqrt(5.0 / 3.0), Min: 1, Max: 4},
+	}
+
+	for name, w := range want {
+		got, ok := stats[name]
+		if !ok {
+			t.Errorf("Expected stats for %q\n", name)
+			continue
+		}
+		if math.Abs(w.Mean-got.Mean) > 1e-6 || math.Abs(w.Std-got.Std) > 1e-6 ||
+			math.Abs(w.Min-got.Min) > 1e-6 || math.Abs(w.Max-got.Max) > 1e-6 {
+			t.Errorf("Expected %q stats: %+v\n", name, w)
+			t.Errorf("Got %q stats: %+v\n", name, got)
+		}
+	}
+}
+
+func TestDeadReLUMonitor(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	// fc1 maps positive inputs to negative values: relu1 is fully dead.
+	deadCfg := &nn.LinearConfig{WsInit: nn.NewConstInit(-1.0), BsInit: nn.NewConstInit(-1.0), Bias: true}
+	aliveCfg := &nn.LinearConfig{WsInit: nn.NewConstInit(1.0), BsInit: nn.NewConstInit(1.0), Bias: true}
+
+	monitor := nn.NewDeadReLUMonitor(0.99)
+	seq := nn.Seq()
+	seq.Add(nn.NewLinear(path.Sub("fc1"), 3, 4, deadCfg))
+	seq.Add(monitor.Wrap("relu1", nn.NewReLU()))
+	seq.Add(nn.NewLinear(path.Sub("fc2"), 4, 2, aliveCfg))
+	seq.Add(monitor.Wrap("relu2", nn.NewReLU()))
+
+	for i := 0; i < 3; i++ {
+		xs := ts.MustOnes([]int64{4, 3}, gotch.Float, gotch.CPU)
+		seq.Forward(xs).MustDrop()
+		xs.MustDrop()
+	}
+
+	if got := monitor.ZeroFraction("relu1"); got != 1.0 {
+		t.Errorf("Expected relu1 zero fraction: 1.0, got %v\n", got)
+	}
+	if got := monitor.ZeroFraction("relu2"); got != 0.0 {
+		t.Errorf("Expected relu2 zero fraction: 0.0, got %v\n", got)
+	}
+
+	want := []string{"relu1"}
+	got := monitor.DeadLayers()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected dead layers: %v\n", want)
+		t.Errorf("Got dead layers: %v\n", got)
+	}
+
+	monitor.Reset()
+	if len(monitor.DeadLayers()) != 0 {
+		t.Errorf("Expected no dead layers after Reset, got %v\n", monitor.DeadLayers())
+	}
+}
+
+func TestAuditInit(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+
+	// well initialized layer: no warnings expected
+	nn.NewLinear(root.Sub("good"), 16, 8, nn.DefaultLinearConfig())
+	// all-zero weight
+	nn.NewLinear(root.Sub("zero"), 4, 2, &nn.LinearConfig{WsInit: nn.NewConstInit(0.0)})
+	// constant, non-zero weight
+	nn.NewLinear(root.Sub("constant"), 4, 2, &nn.LinearConfig{WsInit: nn.NewConstInit(0.5)})
+	// non-finite values
+	root.Add("nan", ts.MustOfSlice([]float64{1, math.NaN(), 2, 3}).MustView([]int64{2, 2}, true), true)
+
+	want := []string{
+		"constant.weight: std 0 is far from expected 0.5 for fan-in 4",
+		"nan: 1 of 4 values are NaN or Inf",
+		"zero.weight: weight is all zeros",
+	}
+	got := nn.AuditInit(vs)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected warnings: %q\n", want)
+		t.Errorf("Got warnings: %q\n", got)
+	}
+}
@@ -0,0 +1,123 @@
+package nn
+
+// Exponential moving average of model weights.
+
+import (
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// EMA keeps an exponential moving average (shadow copy) of the trainable
+// variables of a var-store.
+//
+// Example:
+//
+//	ema := nn.NewEMA(vs, 0.999)
+//	for ... {
+//		opt.BackwardStep(loss)
+//		ema.Update()
+//	}
+//	// evaluate with the averaged weights
+//	ema.CopyToModel()
+//	...
+//	// back to the raw weights to resume training
+//	ema.Restore()
+type EMA struct {
+	Decay  float64
+	vars   []ts.Tensor
+	shadow []*ts.Tensor
+	backup []*ts.Tensor // raw variable values saved by CopyToModel
+}
+
+// NewEMA creates an EMA of the trainable variables of vs, initialized with
+// their current values. Shadow copies live on the var-store device.
+func NewEMA(vs *VarStore, decay float64) *EMA {
+	if decay < 0 || decay > 1 {
+		log.Fatalf("NewEMA - decay should be in range [0, 1], got %v\n", decay)
+	}
+
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	ema := &EMA{Decay: decay}
+	ts.NoGrad(func() {
+		for _, v := range vs.Vars.TrainableVariables {
+			shadow := v.MustEmptyLike(false)
+			shadow.Copy_(&v)
+			ema.vars = append(ema.vars, v)
+			ema.shadow = append(ema.shadow, shadow)
+		}
+	})
+
+	return ema
+}
+
+// Update updates the shadow copies with current variable values:
+//
+//	shadow = decay*shadow + (1-decay)*variable
+//
+// It is typically called after each optimizer step.
+func (ema *EMA) Update() {
+	ts.NoGrad(func() {
+		for i, shadow := range ema.shadow {
+			delta := ema.vars[i].MustMul1(ts.FloatScalar(1.0-ema.Decay), false)
+			shadow.MustMul1_(ts.FloatScalar(ema.Decay))
+			shadow.MustAdd_(delta)
+			delta.MustDrop()
+		}
+	})
+}
+
+// CopyToModel copies the shadow copies into the variables, e.g. to evaluate
+// the model with averaged weights. The raw variable values are backed up so
+// that `Restore` can put them back.
+//
+// NOTE: calling CopyToModel again before `Restore` keeps the first backup.
+func (ema *EMA) CopyToModel() {
+	ts.NoGrad(func() {
+		if ema.backup == nil {
+			for i := range ema.vars {
+				backup := ema.vars[i].MustEmptyLike(false)
+				backup.Copy_(&ema.vars[i])
+				ema.backup = append(ema.backup, backup)
+			}
+		}
+
+		for i, shadow := range ema.shadow {
+			ema.vars[i].Copy_(shadow)
+		}
+	})
+}
+
+// Restore copies back the raw variable values backed up by `CopyToModel`
+// and frees the backup. It does nothing if there is no backup.
+func (ema *EMA) Restore() {
+	if ema.backup == nil {
+		return
+	}
+
+	ts.NoGrad(func() {
+		for i, backup := range ema.backup {
+			ema.vars[i].Copy_(backup)
+			backup.MustDrop()
+		}
+	})
+	ema.backup = nil
+}
+
+// CopyFromModel copies the variables into the shadow copies, e.g. to restart
+// averaging from weights loaded from a checkpoint.
+func (ema *EMA) CopyFromModel() {
+	ts.NoGrad(func() {
+		for i, shadow := range ema.shadow {
+			shadow.Copy_(&ema.vars[i])
+		}
+	})
+}
+
+// Shadow returns the shadow copies in the order of the trainable variables
+// of the var-store.
+func (ema *EMA) Shadow() []*ts.Tensor {
+	return ema.shadow
+}
@@ -0,0 +1,63 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestEMA(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	x := vs.Root().MustNewVar("x", []int64{2}, nn.NewConstInit(0.0))
+	ema := nn.NewEMA(vs, 0.9)
+
+	// the variable jumps to 1 and the average follows slowly
+	ts.NoGrad(func() {
+		x.MustFill_(ts.FloatScalar(1.0))
+	})
+	for i := 0; i < 3; i++ {
+		ema.Update()
+	}
+
+	// 1 - 0.9^3
+	assertAllClose(t, "shadow", []float64{0.271, 0.271}, ema.Shadow()[0].Float64Values())
+	assertAllClose(t, "variable", []float64{1, 1}, x.Float64Values())
+
+	if want, got := gotch.CPU, ema.Shadow()[0].MustDevice(); want != got {
+		t.Errorf("Expected shadow device: %v\n", want)
+		t.Errorf("Got shadow device: %v\n", got)
+	}
+
+	// swap the averaged weights in and the raw weights back
+	raw := x.Float64Values()
+	want := ema.Shadow()[0].Float64Values()
+	ema.CopyToModel()
+	if got := x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected variable after CopyToModel: %v\n", want)
+		t.Errorf("Got variable after CopyToModel: %v\n", got)
+	}
+	// a second call must not back up the averaged weights
+	ema.CopyToModel()
+	ema.Restore()
+	if got := x.Float64Values(); !reflect.DeepEqual(raw, got) {
+		t.Errorf("Expected variable after Restore: %v\n", raw)
+		t.Errorf("Got variable after Restore: %v\n", got)
+	}
+	if got := ema.Shadow()[0].Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shadow unchanged after Restore: %v\n", want)
+		t.Errorf("Got shadow after Restore: %v\n", got)
+	}
+	if !x.MustRequiresGrad() {
+		t.Errorf("Expected variable to still require grad\n")
+	}
+
+	// re-seed the averages from the raw weights
+	ema.CopyFromModel()
+	if got := ema.Shadow()[0].Float64Values(); !reflect.DeepEqual(raw, got) {
+		t.Errorf("Expected shadow after CopyFromModel: %v\n", raw)
+		t.Errorf("Got shadow after CopyFromModel: %v\n", got)
+	}
+}
@@ -0,0 +1,107 @@
+package nn
+
+// Attribution methods for model explainability.
+
+import (
+	"log"
+	"strconv"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// GradCAM computes a Grad-CAM heatmap for class `targetClass`.
+//
+// `m` should be a `*SequentialT` and `targetLayer` the index (as string) of the
+// convolution sub-layer to inspect. The activation of that layer, of shape
+// [N, C, H, W], is captured and the gradient of the target class score w.r.t.
+// it is computed. Channels are then weighted by their spatially averaged
+// gradient and summed, followed by ReLU. The returned heatmap has shape
+// [N, H, W] and is detached from the computation graph.
+//
+// NOTE: backward pass also accumulates gradients of the layers after
+// `targetLayer`. Call `ZeroGrad` on the optimizer (or the variables) if needed.
+func GradCAM(m ts.ModuleT, input *ts.Tensor, targetClass int64, targetLayer string) *ts.Tensor {
+	seq, ok := m.(*SequentialT)
+	if !ok {
+		log.Fatalf("GradCAM - expected a '*nn.SequentialT' module, got '%T'\n", m)
+	}
+
+	layerIdx, err := strconv.Atoi(targetLayer)
+	if err != nil || layerIdx < 0 || layerIdx >= len(seq.layers) {
+		log.Fatalf("GradCAM - invalid target layer %q for a module of %v layers\n", targetLayer, len(seq.layers))
+	}
+
+	// Capture target layer activation as a leaf tensor tracking gradients.
+	act := FeatureExtractor(seq, layerIdx).ForwardT(input, false)
+	if act.Dim() != 4 {
+		log.Fatalf("GradCAM - expected target layer activation of shape [N, C, H, W], got %v\n", act.MustSize())
+	}
+	act.MustRequiresGrad_(true)
+	dtype := act.DType()
+
+	// Forward remaining layers.
+	logits := act.MustShallowClone()
+	for i := layerIdx + 1; i < len(seq.layers); i++ {
+		next := seq.layers[i].ForwardT(logits, false)
+		logits.MustDrop()
+		logits = next
+	}
+
+	score := logits.MustSelect(1, targetClass, true).MustSum(dtype, true)
+	score.MustBackward()
+	score.MustDrop()
+
+	grad := act.MustGrad(false)
+	weights := grad.MustMean1([]int64{2, 3}, true, dtype, true)
+
+	cam := act.MustDetach(false).MustMul(weights, true).MustSum1([]int64{1}, false, dtype, true).MustRelu(true)
+	weights.MustDrop()
+	act.MustDrop()
+
+	return cam
+}
+
+// IntegratedGradients computes integrated-gradients attributions of class
+// `targetClass` for `input`.
+//
+// Gradients of the target class score are accumulated at `steps` points along
+// the straight-line path from `baseline` to `input` (Riemann right sum) and
+// then scaled by `input - baseline`. The returned attributions have the same
+// shape as `input` and are detached from the computation graph.
+//
+// NOTE: each of the `steps` backward passes also accumulates gradients of the
+// model parameters. Call `ZeroGrad` on the optimizer (or the variables) before
+// the next training step.
+func IntegratedGradients(m ts.ModuleT, input, baseline *ts.Tensor, targetClass int64, steps int) *ts.Tensor {
+	if steps <= 0 {
+		log.Fatalf("IntegratedGradients - expected a positive number of steps, got %v\n", steps)
+	}
+
+	dtype := input.DType()
+	delta := input.MustSub(baseline, false)
+	totalGrad := input.MustZerosLike(false)
+
+	for k := 1; k <= steps; k++ {
+		alpha := float64(k) / float64(steps)
+		scaled := delta.MustMul1(ts.FloatScalar(alpha), false)
+		x := baseline.MustAdd(scaled, false).MustDetach(true)
+		scaled.MustDrop()
+		x.MustRequiresGrad_(true)
+
+		out := m.ForwardT(x, false)
+		score := out.MustSelect(1, targetClass, true).MustSum(dtype, true)
+		score.MustBackward()
+		score.MustDrop()
+
+		grad := x.MustGrad(false)
+		totalGrad.MustAdd_(grad)
+		grad.MustDrop()
+		x.MustDrop()
+	}
+
+	avgGrad := totalGrad.MustDiv1(ts.FloatScalar(float64(steps)), true)
+	retVal := delta.MustMul(avgGrad, true)
+	avgGrad.MustDrop()
+
+	return retVal
+}
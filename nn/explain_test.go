@@ -0,0 +1,68 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestGradCAM(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	convCfg := nn.DefaultConv2DConfig()
+	convCfg.Padding = []int64{1, 1}
+
+	seq := nn.SeqT()
+	seq.Add(nn.NewConv2D(path.Sub("conv"), 1, 4, 3, convCfg))
+	seq.Add(nn.NewReLU())
+	seq.AddFn(nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor {
+		return xs.MustFlatten(1, -1, false)
+	}))
+	seq.Add(nn.NewLinear(path.Sub("fc"), 4*8*8, 3, nn.DefaultLinearConfig()))
+
+	input := ts.MustRandn([]int64{2, 1, 8, 8}, gotch.Float, gotch.CPU)
+	cam := nn.GradCAM(seq, input, 1, "0")
+
+	want := []int64{2, 8, 8}
+	got := cam.MustSize()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected heatmap shape: %v\n", want)
+		t.Errorf("Got heatmap shape: %v\n", got)
+	}
+
+	for _, v := range cam.Float64Values() {
+		if v < 0 {
+			t.Errorf("Expected nonnegative heatmap values, got %v\n", v)
+			break
+		}
+	}
+}
+
+func TestIntegratedGradients(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	linear := nn.NewLinear(vs.Root(), 4, 3, nn.DefaultLinearConfig())
+
+	input := ts.MustRandn([]int64{2, 4}, gotch.Float, gotch.CPU)
+	baseline := ts.MustRandn([]int64{2, 4}, gotch.Float, gotch.CPU)
+
+	var targetClass int64 = 1
+	attr := nn.IntegratedGradients(linear, input, baseline, targetClass, 20)
+
+	// For a linear model, attribution = (input - baseline) * weight[targetClass]
+	weight := linear.Ws.MustSelect(0, targetClass, false)
+	want := input.MustSub(baseline, false).MustMul(weight, true).Float64Values()
+	got := attr.Float64Values()
+
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 1e-5 {
+			t.Errorf("Expected attributions: %v\n", want)
+			t.Errorf("Got attributions: %v\n", got)
+			break
+		}
+	}
+}
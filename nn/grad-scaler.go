@@ -0,0 +1,158 @@
+package nn
+
+// Dynamic loss scaling for mixed precision training.
+
+import (
+	"math"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// GradScalerConfig holds options for GradScaler.
+type GradScalerConfig struct {
+	InitScale      float64
+	GrowthFactor   float64
+	BackoffFactor  float64
+	GrowthInterval int64
+	Enabled        bool
+}
+
+func DefaultGradScalerConfig() *GradScalerConfig {
+	return &GradScalerConfig{
+		InitScale:      65536.0,
+		GrowthFactor:   2.0,
+		BackoffFactor:  0.5,
+		GrowthInterval: 2000,
+		Enabled:        true,
+	}
+}
+
+// GradScaler scales the loss to keep small half-precision gradients from
+// flushing to zero, and unscales the gradients before the optimizer step.
+//
+// Steps with non-finite (Inf or NaN) gradients are skipped and the scale is
+// reduced by BackoffFactor. After GrowthInterval consecutive finite steps the
+// scale grows by GrowthFactor.
+//
+// A typical training step is:
+//
+//	opt.ZeroGrad()
+//	ts.Autocast(true, func() { loss = ... })
+//	scaler.Scale(loss).MustBackward()
+//	scaler.Step(opt)
+//	scaler.Update()
+type GradScaler struct {
+	config        *GradScalerConfig
+	scale         float64
+	growthTracker int64
+	foundInf      bool
+	unscaled      bool
+}
+
+// NewGradScaler creates a new GradScaler.
+func NewGradScaler(config *GradScalerConfig) *GradScaler {
+	return &GradScaler{
+		config: config,
+		scale:  config.InitScale,
+	}
+}
+
+// GetScale returns the current scale factor.
+func (s *GradScaler) GetScale() float64 {
+	return s.scale
+}
+
+// Scale multiplies the loss by the current scale factor and returns a new
+// tensor. If the scaler is disabled, it returns a shallow clone of the loss so
+// that the result can always be dropped independently of the loss.
+func (s *GradScaler) Scale(loss *ts.Tensor) *ts.Tensor {
+	if !s.config.Enabled {
+		return loss.MustShallowClone()
+	}
+
+	return loss.MustMul1(ts.FloatScalar(s.scale), false)
+}
+
+// Unscale divides the gradients of the optimizer variables by the current
+// scale factor in place and records whether any of them is non-finite.
+//
+// It is called by Step if not called explicitly, e.g. to clip unscaled
+// gradients before stepping.
+func (s *GradScaler) Unscale(opt *Optimizer) {
+	if !s.config.Enabled || s.unscaled {
+		return
+	}
+
+	invScale := ts.FloatScalar(1.0 / s.scale)
+	var sums []ts.Tensor
+	opt.varstore.Vars.mutex.Lock()
+	ts.NoGrad(func() {
+		for i := range opt.varstore.Vars.TrainableVariables {
+			grad := opt.varstore.Vars.TrainableVariables[i].MustGrad(false)
+			if grad.MustDefined() {
+				grad.MustMul1_(invScale)
+				sums = append(sums, *grad.MustSum(gotch.Double, false))
+			}
+			grad.MustDrop()
+		}
+	})
+	opt.varstore.Vars.mutex.Unlock()
+
+	if len(sums) > 0 && !allFinite(sums) {
+		s.foundInf = true
+	}
+
+	s.unscaled = true
+}
+
+// Step unscales the gradients and performs an optimizer step unless
+// non-finite gradients were found, in which case the step is skipped.
+func (s *GradScaler) Step(opt *Optimizer) {
+	if !s.config.Enabled {
+		opt.Step()
+		return
+	}
+
+	s.Unscale(opt)
+	if !s.foundInf {
+		opt.Step()
+	}
+}
+
+// Update adjusts the scale factor for the next iteration. It should be called
+// once per iteration after Step.
+func (s *GradScaler) Update() {
+	if !s.config.Enabled {
+		return
+	}
+
+	if s.foundInf {
+		s.scale *= s.config.BackoffFactor
+		s.growthTracker = 0
+	} else {
+		s.growthTracker++
+		if s.growthTracker == s.config.GrowthInterval {
+			s.scale *= s.config.GrowthFactor
+			s.growthTracker = 0
+		}
+	}
+
+	s.foundInf = false
+	s.unscaled = false
+}
+
+// allFinite returns whether all the given per-gradient sums are finite. The
+// sums are reduced on their device so that only one value is copied back.
+//
+// NOTE: sums are dropped.
+func allFinite(sums []ts.Tensor) bool {
+	total := ts.MustStack(sums, 0).MustSum(gotch.Double, true)
+	for i := range sums {
+		sums[i].MustDrop()
+	}
+	v := total.Float64Values()[0]
+	total.MustDrop()
+
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
@@ -0,0 +1,112 @@
+package nn_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestGradScalerSkipsNonFinite(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	x := vs.Root().MustNewVar("x", []int64{2}, nn.NewConstInit(1.0))
+
+	opt, err := nn.DefaultSGDConfig().Build(vs, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := nn.DefaultGradScalerConfig()
+	cfg.InitScale = 8.0
+	scaler := nn.NewGradScaler(cfg)
+
+	// force an infinite gradient
+	opt.ZeroGrad()
+	loss := x.MustMul1(ts.FloatScalar(math.Inf(1)), false).MustSum(gotch.Float, true)
+	scaler.Scale(loss).MustBackward()
+	scaler.Step(opt)
+	scaler.Update()
+
+	if got := x.Float64Values(); got[0] != 1.0 || got[1] != 1.0 {
+		t.Errorf("Expected parameters unchanged after skipped step, got: %v\n", got)
+	}
+	if want, got := 4.0, scaler.GetScale(); want != got {
+		t.Errorf("Expected scale after backoff: %v\n", want)
+		t.Errorf("Got scale: %v\n", got)
+	}
+
+	// finite gradient: d(loss)/dx = 1 once unscaled
+	opt.ZeroGrad()
+	loss = x.MustSum(gotch.Float, false)
+	scaler.Scale(loss).MustBackward()
+	scaler.Step(opt)
+	scaler.Update()
+
+	want := []float64{0.9, 0.9}
+	assertAllClose(t, "parameters", want, x.Float64Values())
+}
+
+func TestGradScalerDisabled(t *testing.T) {
+	cfg := nn.DefaultGradScalerConfig()
+	cfg.Enabled = false
+	scaler := nn.NewGradScaler(cfg)
+
+	loss := ts.MustOfSlice([]float64{2.5})
+	scaled := scaler.Scale(loss)
+	if scaled == loss {
+		t.Errorf("Expected Scale to return a new tensor when disabled\n")
+	}
+	// dropping the result leaves the loss usable
+	scaled.MustDrop()
+	if got := loss.Float64Values()[0]; got != 2.5 {
+		t.Errorf("Expected loss: %v\n", 2.5)
+		t.Errorf("Got loss: %v\n", got)
+	}
+}
+
+func TestAutocast(t *testing.T) {
+	if !gotch.CudaIsAvailable() {
+		t.Skip("CUDA is not available")
+	}
+
+	device := gotch.CudaIfAvailable()
+	a := ts.MustRandn([]int64{4, 4}, gotch.Float, device)
+	b := ts.MustRandn([]int64{4, 4}, gotch.Float, device)
+
+	var got, want []float64
+	ts.Autocast(true, func() {
+		if !ts.AutocastIsEnabled() {
+			t.Errorf("Expected autocast enabled inside closure\n")
+		}
+		out := a.MustMm(b, false)
+		if dtype := out.DType(); dtype != gotch.Half {
+			t.Errorf("Expected autocast matmul dtype: %v\n", gotch.Half)
+			t.Errorf("Got dtype: %v\n", dtype)
+		}
+		got = out.MustTotype(gotch.Double, true).Float64Values()
+
+		// nested region disabling autocast runs in full precision
+		ts.Autocast(false, func() {
+			out := a.MustMm(b, false)
+			if dtype := out.DType(); dtype != gotch.Float {
+				t.Errorf("Expected matmul dtype without autocast: %v\n", gotch.Float)
+				t.Errorf("Got dtype: %v\n", dtype)
+			}
+			want = out.MustTotype(gotch.Double, true).Float64Values()
+		})
+		if !ts.AutocastIsEnabled() {
+			t.Errorf("Expected autocast re-enabled after nested closure\n")
+		}
+	})
+
+	if ts.AutocastIsEnabled() {
+		t.Errorf("Expected autocast disabled after closure\n")
+	}
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 1e-2*(1+math.Abs(want[i])) {
+			t.Errorf("Expected autocast result close to %v, got: %v\n", want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package nn
+
+// A graph convolution (message passing) layer.
+
+import (
+	"log"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// GraphConvConfig is a configuration for a graph convolution layer.
+type GraphConvConfig struct {
+	Aggr      string // neighbor aggregation. Either "mean" or "sum".
+	SelfLoops bool   // whether each node also receives its own features.
+	Bias      bool
+	WsInit    Init
+	BsInit    Init
+}
+
+// DefaultGraphConvConfig creates default GraphConvConfig with mean
+// aggregation and self-loops.
+func DefaultGraphConvConfig() *GraphConvConfig {
+	return &GraphConvConfig{
+		Aggr:      "mean",
+		SelfLoops: true,
+		Bias:      true,
+		WsInit:    NewKaimingUniformInit(),
+		BsInit:    nil,
+	}
+}
+
+// GraphConv is a graph convolution layer. Each node aggregates features of its
+// neighbors (messages) and applies a linear transform to the result.
+type GraphConv struct {
+	Linear *Linear
+	Config *GraphConvConfig
+}
+
+// NewGraphConv creates a new graph convolution layer transforming node
+// features from `inDim` to `outDim`.
+func NewGraphConv(vs *Path, inDim, outDim int64, cfg *GraphConvConfig) *GraphConv {
+	if cfg.Aggr != "mean" && cfg.Aggr != "sum" {
+		log.Fatalf("NewGraphConv - invalid aggregation %q. Expected 'mean' or 'sum'.\n", cfg.Aggr)
+	}
+
+	linearCfg := &LinearConfig{
+		WsInit: cfg.WsInit,
+		BsInit: cfg.BsInit,
+		Bias:   cfg.Bias,
+	}
+
+	return &GraphConv{
+		Linear: NewLinear(vs, inDim, outDim, linearCfg),
+		Config: cfg,
+	}
+}
+
+// Forward proceeds node features through the graph convolution layer.
+//
+// xs - node features of shape [N, inDim]
+// edgeIndex - int64 tensor of shape [2, E] where each column (src, dst) is a
+// directed edge along which the features of `src` are sent to `dst`.
+//
+// It returns node features of shape [N, outDim].
+func (gc *GraphConv) Forward(xs, edgeIndex *ts.Tensor) (retVal *ts.Tensor) {
+	numNodes := xs.MustSize()[0]
+	src := edgeIndex.MustSelect(0, 0, false)
+	dst := edgeIndex.MustSelect(0, 1, false)
+
+	if gc.Config.SelfLoops {
+		loops := ts.MustArange(ts.IntScalar(numNodes), gotch.Int64, xs.MustDevice())
+		srcLoops := ts.MustCat([]ts.Tensor{*src, *loops}, 0)
+		dstLoops := ts.MustCat([]ts.Tensor{*dst, *loops}, 0)
+		src.MustDrop()
+		dst.MustDrop()
+		loops.MustDrop()
+		src, dst = srcLoops, dstLoops
+	}
+
+	messages := xs.MustIndexSelect(0, src, false)
+	src.MustDrop()
+
+	var agg *ts.Tensor
+	switch gc.Config.Aggr {
+	case "mean":
+		agg = ts.MustScatterMean(messages, dst, 0, numNodes)
+	case "sum":
+		agg = xs.MustZerosLike(false)
+		agg.MustIndexAdd_(0, dst, messages)
+	}
+	messages.MustDrop()
+	dst.MustDrop()
+
+	retVal = gc.Linear.Forward(agg)
+	agg.MustDrop()
+
+	return retVal
+}
@@ -0,0 +1,37 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestGraphConv(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	gc := nn.NewGraphConv(vs.Root(), 3, 5, nn.DefaultGraphConvConfig())
+
+	// 4 nodes, node 3 is isolated
+	edgeIndex := ts.MustOfSlice([]int64{0, 1, 1, 1, 0, 2}).MustView([]int64{2, 3}, true)
+	xs := ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)
+
+	out := gc.Forward(xs, edgeIndex)
+	want := []int64{4, 5}
+	got := out.MustSize()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	// isolated node only sees itself: its output equals the linear
+	// transform of its own features.
+	x3 := xs.MustNarrow(0, 3, 1, false)
+	wantIsolated := gc.Linear.Forward(x3).Float64Values()
+	gotIsolated := out.MustNarrow(0, 3, 1, false).Float64Values()
+	if !reflect.DeepEqual(wantIsolated, gotIsolated) {
+		t.Errorf("Expected isolated node output: %v\n", wantIsolated)
+		t.Errorf("Got isolated node output: %v\n", gotIsolated)
+	}
+}
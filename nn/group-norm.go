@@ -0,0 +1,82 @@
+package nn
+
+// A group-normalization layer.
+
+import (
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Group-normalization config.
+type GroupNormConfig struct {
+	CudnnEnable bool
+	Eps         float64
+	Affine      bool
+	WsInit      Init
+	BsInit      Init
+}
+
+func DefaultGroupNormConfig() *GroupNormConfig {
+	return &GroupNormConfig{
+		CudnnEnable: true,
+		Eps:         1e-5,
+		Affine:      true,
+		WsInit:      NewConstInit(1.0),
+		BsInit:      NewConstInit(0.0),
+	}
+}
+
+// A group-normalization layer.
+//
+// Channels are split into NumGroups groups and each group is normalized
+// separately over its channels and spatial dimensions.
+type GroupNorm struct {
+	Config      *GroupNormConfig
+	Ws          *ts.Tensor // undefined when Affine is false
+	Bs          *ts.Tensor // undefined when Affine is false
+	NumGroups   int64
+	NumChannels int64
+}
+
+// NewGroupNorm creates a new GroupNorm layer.
+//
+// NumChannels must be divisible by NumGroups.
+func NewGroupNorm(vs *Path, numGroups, numChannels int64, config *GroupNormConfig) *GroupNorm {
+	if numGroups <= 0 {
+		log.Fatalf("NewGroupNorm method call: numGroups should be positive. Got %v\n", numGroups)
+	}
+	if numChannels%numGroups != 0 {
+		log.Fatalf("NewGroupNorm method call: numChannels (%v) should be divisible by numGroups (%v)\n", numChannels, numGroups)
+	}
+
+	var (
+		ws *ts.Tensor = ts.NewTensor()
+		bs *ts.Tensor = ts.NewTensor()
+	)
+	if config.Affine {
+		ws = vs.MustNewVar("weight", []int64{numChannels}, config.WsInit)
+		bs = vs.MustNewVar("bias", []int64{numChannels}, config.BsInit)
+	}
+
+	return &GroupNorm{config, ws, bs, numGroups, numChannels}
+}
+
+// Implement Module, ModuleT interfaces for GroupNorm:
+// ===================================================
+
+// Forward implements Module interface for GroupNorm
+func (gn *GroupNorm) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	if xs.Dim() < 2 {
+		log.Fatalf("Expected an input tensor with at least 2 dims, got %v\n", xs.MustSize())
+	}
+
+	return ts.MustGroupNorm(xs, gn.NumGroups, gn.Ws, gn.Bs, gn.Config.Eps, gn.Config.CudnnEnable)
+}
+
+// ForwardT implements ModuleT interface for GroupNorm
+//
+// GroupNorm keeps no running statistics so train has no effect.
+func (gn *GroupNorm) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return gn.Forward(xs)
+}
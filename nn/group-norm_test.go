@@ -0,0 +1,46 @@
+package nn_test
+
+import (
+	"os"
+	"os/exec"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestGroupNorm(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	gn := nn.NewGroupNorm(vs.Root(), 2, 6, nn.DefaultGroupNormConfig())
+
+	if want, got := []int64{6}, gn.Ws.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected weight shape: %v\n", want)
+		t.Errorf("Got weight shape: %v\n", got)
+	}
+
+	want := []int64{3, 6, 5, 5}
+	got := gn.Forward(ts.MustRandn(want, gotch.Float, gotch.CPU)).MustSize()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
+
+// NewGroupNorm exits the process on invalid groups so the check runs in a
+// child test process.
+func TestGroupNormDivisibility(t *testing.T) {
+	if os.Getenv("GOTCH_GROUPNORM_INVALID") == "1" {
+		vs := nn.NewVarStore(gotch.CPU)
+		nn.NewGroupNorm(vs.Root(), 4, 6, nn.DefaultGroupNormConfig())
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestGroupNormDivisibility")
+	cmd.Env = append(os.Environ(), "GOTCH_GROUPNORM_INVALID=1")
+	err := cmd.Run()
+	if e, ok := err.(*exec.ExitError); !ok || e.Success() {
+		t.Errorf("Expected NewGroupNorm to fail for 6 channels in 4 groups, got: %v\n", err)
+	}
+}
@@ -1,7 +1,7 @@
 package nn
 
 import (
-	"log"
+	"fmt"
 	"math"
 	"math/rand"
 
@@ -9,12 +9,94 @@ import (
 	ts "github.com/sugarme/gotch/tensor"
 )
 
+// Init is an initialization of tensor values.
+//
+// NOTE: initialization errors (e.g. invalid dims) are returned rather than
+// terminating the program.
 type Init interface {
 	// creates a new tensor with specified initiation
-	InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor)
+	InitTensor(dims []int64, device gotch.Device) (*ts.Tensor, error)
 
 	// re-initializes (in-place) an existing tensor with the specified initiation
-	Set(tensor *ts.Tensor)
+	Set(tensor *ts.Tensor) error
+}
+
+// InitTensorKind creates a new tensor of given dtype with specified
+// initiation, e.g. a gotch.Half tensor for mixed precision training.
+//
+// NOTE: values are generated as gotch.Float then converted.
+func InitTensorKind(init Init, dims []int64, dtype gotch.DType, device gotch.Device) (*ts.Tensor, error) {
+	x, err := init.InitTensor(dims, device)
+	if err != nil {
+		return nil, err
+	}
+	if x.DType() == dtype {
+		return x, nil
+	}
+
+	return x.Totype(dtype, true)
+}
+
+// checkDims returns an error if any of dims is negative.
+func checkDims(name string, dims []int64) error {
+	for _, d := range dims {
+		if d < 0 {
+			return fmt.Errorf("%v - invalid dims (%v): dims should be non-negative\n", name, dims)
+		}
+	}
+	return nil
+}
+
+// newTensorOn creates a tensor of given dims from data on device.
+func newTensorOn(data []float32, dims []int64, device gotch.Device) (*ts.Tensor, error) {
+	newTs, err := ts.NewTensorFromData(data, dims)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTs.To(device, true)
+}
+
+// setData copies values generated by data for the dims of tensor into tensor.
+func setData(name string, tensor *ts.Tensor, data func(dims []int64) ([]float32, error)) error {
+	dims, err := tensor.Size()
+	if err != nil {
+		return fmt.Errorf("%v - Set method call error: %v\n", name, err)
+	}
+
+	values, err := data(dims)
+	if err != nil {
+		return err
+	}
+	newTs, err := ts.NewTensorFromData(values, dims)
+	if err != nil {
+		return fmt.Errorf("%v - Set method call error: %v\n", name, err)
+	}
+	defer newTs.MustDrop()
+
+	if err := tensor.CopyFrom_(newTs); err != nil {
+		return fmt.Errorf("%v - Set method call error: %v\n", name, err)
+	}
+
+	return nil
+}
+
+// filled creates a new gotch.Float tensor of given dims filled in-place by fill.
+func filled(name string, dims []int64, device gotch.Device, fill func(*ts.Tensor) error) (*ts.Tensor, error) {
+	if err := checkDims(name, dims); err != nil {
+		return nil, err
+	}
+
+	retVal, err := ts.Zeros(dims, gotch.Float, device)
+	if err != nil {
+		return nil, fmt.Errorf("%v - InitTensor method call error: %v\n", name, err)
+	}
+	if err = fill(retVal); err != nil {
+		retVal.MustDrop()
+		return nil, err
+	}
+
+	return retVal, nil
 }
 
 // constInit:
@@ -28,36 +110,37 @@ func NewConstInit(v float64) constInit {
 	return constInit{v}
 }
 
-func (c constInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var err error
+func (c constInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	if err = checkDims("constInit", dims); err != nil {
+		return nil, err
+	}
+
 	kind := gotch.Float
 	switch {
 	case c.value == 0.0:
-		retVal = ts.MustZeros(dims, kind, device)
+		retVal, err = ts.Zeros(dims, kind, device)
 	case c.value == 1.0:
-		retVal = ts.MustOnes(dims, kind, device)
+		retVal, err = ts.Ones(dims, kind, device)
 	default:
 		data := make([]float64, ts.FlattenDim(dims))
 		for i := range data {
 			data[i] = c.value
 		}
 		retVal, err = ts.NewTensorFromData(data, dims)
-		if err != nil {
-			log.Fatalf("constInit - InitTensor method call error: %v\n", err)
-		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("constInit - InitTensor method call error: %v\n", err)
 	}
 
-	return retVal
+	return retVal, nil
 }
 
-func (c constInit) Set(tensor *ts.Tensor) {
-	var err error
-	scalarVal := ts.FloatScalar(c.value)
-	if err != nil {
-		log.Fatalf("constInit - Set method call error: %v\n", err)
+func (c constInit) Set(tensor *ts.Tensor) error {
+	if err := tensor.Fill_(ts.FloatScalar(c.value)); err != nil {
+		return fmt.Errorf("constInit - Set method call error: %v\n", err)
 	}
 
-	tensor.Fill_(scalarVal)
+	return nil
 }
 
 // randnInit :
@@ -71,49 +154,121 @@ func NewRandnInit(mean, stdev float64) randnInit {
 	return randnInit{mean, stdev}
 }
 
-func (r randnInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var err error
-	rand.Seed(86)
+func (r randnInit) data(dims []int64) ([]float32, error) {
+	if err := checkDims("randnInit", dims); err != nil {
+		return nil, err
+	}
 
 	data := make([]float32, ts.FlattenDim(dims))
 	for i := range data {
 		// NOTE. tensor will have DType = Float (float32)
-		data[i] = float32(rand.NormFloat64()*r.mean + r.stdev)
+		data[i] = float32(rand.NormFloat64()*r.stdev + r.mean)
 	}
 
-	newTs, err := ts.NewTensorFromData(data, dims)
+	return data, nil
+}
+
+func (r randnInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	data, err := r.data(dims)
 	if err != nil {
-		log.Fatalf("randInit - InitTensor method call error: %v\n", err)
+		return nil, err
 	}
 
-	retVal = newTs.MustTo(device, true)
+	retVal, err = newTensorOn(data, dims, device)
+	if err != nil {
+		return nil, fmt.Errorf("randnInit - InitTensor method call error: %v\n", err)
+	}
 
-	return retVal
+	return retVal, nil
+}
 
+func (r randnInit) Set(tensor *ts.Tensor) error {
+	return setData("randnInit", tensor, r.data)
 }
 
-func (r randnInit) Set(tensor *ts.Tensor) {
-	var (
-		randnTs *ts.Tensor
-		err     error
-	)
+// truncatedNormalInit :
+// =====================
 
-	dims, err := tensor.Size()
-	if err != nil {
-		log.Fatalf("randInit - Set method call error: %v\n", err)
+type truncatedNormalInit struct {
+	mean  float64
+	stdev float64
+	a     float64 // lower bound
+	b     float64 // upper bound
+}
+
+// NewTruncatedNormalInit creates an init which draws values from a normal
+// distribution N(mean, stdev^2) truncated to the range [a, b].
+//
+// It uses the inverse CDF method as Pytorch `torch.nn.init.trunc_normal_`,
+// hence values are unbiased and no sample is rejected.
+//
+// An error is returned if stdev is not positive or if the lower bound `a` is
+// not smaller than the upper bound `b`.
+func NewTruncatedNormalInit(mean, stdev, a, b float64) (truncatedNormalInit, error) {
+	if !(stdev > 0) {
+		err := fmt.Errorf("NewTruncatedNormalInit() failed: stdev (%v) should be positive\n", stdev)
+		return truncatedNormalInit{}, err
+	}
+	if !(a < b) {
+		err := fmt.Errorf("NewTruncatedNormalInit() failed: lower bound (%v) should be smaller than upper bound (%v)\n", a, b)
+		return truncatedNormalInit{}, err
 	}
 
-	rand.Seed(86)
-	data := make([]float64, ts.FlattenDim(dims))
+	return truncatedNormalInit{mean, stdev, a, b}, nil
+}
+
+// NewDefaultTruncatedNormalInit creates a truncated normal init with bounds
+// [mean - 2*stdev, mean + 2*stdev]. An error is returned if stdev is not
+// positive.
+func NewDefaultTruncatedNormalInit(mean, stdev float64) (truncatedNormalInit, error) {
+	return NewTruncatedNormalInit(mean, stdev, mean-2*stdev, mean+2*stdev)
+}
+
+func (tn truncatedNormalInit) data(dims []int64) ([]float32, error) {
+	if err := checkDims("truncatedNormalInit", dims); err != nil {
+		return nil, err
+	}
+	if !(tn.stdev > 0) || !(tn.a < tn.b) {
+		err := fmt.Errorf("truncatedNormalInit - invalid parameters (stdev %v, bounds [%v, %v]): use NewTruncatedNormalInit\n", tn.stdev, tn.a, tn.b)
+		return nil, err
+	}
+
+	// standard normal cumulative distribution function
+	normCdf := func(x float64) float64 {
+		return (1.0 + math.Erf(x/math.Sqrt2)) / 2.0
+	}
+
+	// uniform range in erf space of the truncated bounds
+	l := 2*normCdf((tn.a-tn.mean)/tn.stdev) - 1
+	u := 2*normCdf((tn.b-tn.mean)/tn.stdev) - 1
+
+	data := make([]float32, ts.FlattenDim(dims))
 	for i := range data {
-		data[i] = rand.NormFloat64()*r.mean + r.stdev
+		v := math.Erfinv(l+(u-l)*rand.Float64())*tn.stdev*math.Sqrt2 + tn.mean
+		// NOTE. guard against rounding errors at the bounds.
+		v = math.Max(tn.a, math.Min(tn.b, v))
+		data[i] = float32(v)
 	}
-	randnTs, err = ts.NewTensorFromData(data, dims)
+
+	return data, nil
+}
+
+func (tn truncatedNormalInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	data, err := tn.data(dims)
 	if err != nil {
-		log.Fatalf("randInit - InitTensor method call error: %v\n", err)
+		return nil, err
 	}
 
-	tensor.Copy_(randnTs)
+	retVal, err = newTensorOn(data, dims, device)
+	if err != nil {
+		return nil, fmt.Errorf("truncatedNormalInit - InitTensor method call error: %v\n", err)
+	}
+
+	return retVal, nil
+}
+
+func (tn truncatedNormalInit) Set(tensor *ts.Tensor) error {
+	return setData("truncatedNormalInit", tensor, tn.data)
 }
 
 // uniformInit :
@@ -128,46 +283,156 @@ func NewUniformInit(lo, up float64) uniformInit {
 	return uniformInit{lo, up}
 }
 
-func (u uniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var err error
-	kind := gotch.Float
-	retVal = ts.MustZeros(dims, kind, device)
-	retVal.Uniform_(u.lo, u.up)
+func (u uniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	return filled("uniformInit", dims, device, u.Set)
+}
+
+func (u uniformInit) Set(tensor *ts.Tensor) error {
+	if err := tensor.Uniform_(u.lo, u.up); err != nil {
+		return fmt.Errorf("uniformInit - Set method call error: %v\n", err)
+	}
+
+	return nil
+}
+
+// fnInit :
+// ========
+
+type fnInit struct {
+	fn func(dims []int64, device gotch.Device) *ts.Tensor
+}
+
+// NewFnInit creates an init from a function generating a tensor of given
+// dims on given device, e.g. for a custom initialization not covered by the
+// built-in ones. Set copies a newly generated tensor into the target.
+func NewFnInit(fn func(dims []int64, device gotch.Device) *ts.Tensor) fnInit {
+	return fnInit{fn}
+}
+
+func (f fnInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	if err = checkDims("fnInit", dims); err != nil {
+		return nil, err
+	}
+
+	retVal = f.fn(dims, device)
+	if retVal == nil {
+		return nil, fmt.Errorf("fnInit - InitTensor method call error: function returned a nil tensor\n")
+	}
+
+	return retVal, nil
+}
+
+func (f fnInit) Set(tensor *ts.Tensor) error {
+	dims, err := tensor.Size()
 	if err != nil {
-		log.Fatalf("uniformInit - InitTensor method call error: %v\n", err)
+		return fmt.Errorf("fnInit - Set method call error: %v\n", err)
 	}
-	return retVal
+	device, err := tensor.Device()
+	if err != nil {
+		return fmt.Errorf("fnInit - Set method call error: %v\n", err)
+	}
+
+	newTs, err := f.InitTensor(dims, device)
+	if err != nil {
+		return err
+	}
+	defer newTs.MustDrop()
+
+	if err := tensor.CopyFrom_(newTs); err != nil {
+		return fmt.Errorf("fnInit - Set method call error: %v\n", err)
+	}
+
+	return nil
 }
 
-func (u uniformInit) Set(tensor *ts.Tensor) {
-	tensor.Uniform_(u.lo, u.up)
+// CalculateGain returns the recommended gain value for a given nonlinearity
+// function. It mirrors Pytorch `torch.nn.init.calculate_gain`.
+//
+// Supported nonlinearities:
+//   - "linear", "conv1d", "conv2d", "conv3d", "conv_transpose1d",
+//     "conv_transpose2d", "conv_transpose3d", "sigmoid": 1
+//   - "tanh": 5/3
+//   - "relu": sqrt(2)
+//   - "leaky_relu": sqrt(2 / (1 + param^2)) where param is the negative slope
+//   - "selu": 3/4
+//
+// param is only used by "leaky_relu".
+func CalculateGain(nonlinearity string, param float64) (float64, error) {
+	switch nonlinearity {
+	case "linear", "conv1d", "conv2d", "conv3d", "conv_transpose1d", "conv_transpose2d", "conv_transpose3d", "sigmoid":
+		return 1.0, nil
+	case "tanh":
+		return 5.0 / 3.0, nil
+	case "relu":
+		return math.Sqrt(2.0), nil
+	case "leaky_relu":
+		return math.Sqrt(2.0 / (1.0 + param*param)), nil
+	case "selu":
+		return 3.0 / 4.0, nil
+	default:
+		err := fmt.Errorf("CalculateGain() failed: unsupported nonlinearity %q\n", nonlinearity)
+		return 0.0, err
+	}
+}
+
+// calculateFans returns fan-in and fan-out of a tensor with given dims.
+//
+// For tensors with more than 2 dimensions (e.g. conv weights of shape
+// [outC, inC, kH, kW]), the receptive field size is taken into account.
+// A 1-D tensor has fan-in and fan-out equal to its size.
+func calculateFans(dims []int64) (fanIn, fanOut int64, err error) {
+	if err = checkDims("calculateFans()", dims); err != nil {
+		return 0, 0, err
+	}
+
+	switch len(dims) {
+	case 0:
+		err = fmt.Errorf("calculateFans() failed: dims (%v) should have length >= 1\n", dims)
+		return 0, 0, err
+	case 1:
+		return dims[0], dims[0], nil
+	}
+
+	receptiveField := int64(1)
+	if len(dims) > 2 {
+		receptiveField = product(dims[2:])
+	}
+
+	fanIn = dims[1] * receptiveField
+	fanOut = dims[0] * receptiveField
+
+	return fanIn, fanOut, nil
 }
 
 // kaiminguniformInit :
 // ====================
 
-type kaimingUniformInit struct{}
+type kaimingUniformInit struct {
+	gain float64
+}
 
+// NewKaimingUniformInit creates a Kaiming uniform init with the same default
+// as Pytorch linear and conv layers (leaky_relu with negative slope sqrt(5)),
+// that is values are drawn from U(-bound, bound) with bound = 1/sqrt(fanIn).
 func NewKaimingUniformInit() kaimingUniformInit {
-	return kaimingUniformInit{}
+	gain, _ := CalculateGain("leaky_relu", math.Sqrt(5.0))
+	return kaimingUniformInit{gain: gain}
 }
 
-func (k kaimingUniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	var fanIn int64
-	if len(dims) == 0 {
-		log.Fatalf("KaimingUniformInit method call: dims (%v) should have length >= 1", dims)
-	} else if len(dims) == 1 {
-		fanIn = factorial(dims[0])
-	} else {
-		fanIn = product(dims[1:])
+// NewKaimingUniformInitFor creates a Kaiming uniform init with gain for the
+// given nonlinearity (see CalculateGain). Values are drawn from U(-bound, bound)
+// with bound = gain * sqrt(3/fanIn).
+func NewKaimingUniformInitFor(nonlinearity string, param float64) (kaimingUniformInit, error) {
+	gain, err := CalculateGain(nonlinearity, param)
+	if err != nil {
+		return kaimingUniformInit{}, err
 	}
 
-	bound := math.Sqrt(1.0 / float64(fanIn))
-	kind := gotch.Float
-	retVal = ts.MustZeros(dims, kind, device)
-	retVal.Uniform_(-bound, bound)
+	return kaimingUniformInit{gain: gain}, nil
+}
 
-	return retVal
+func (k kaimingUniformInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	return filled("kaimingUniformInit", dims, device, k.Set)
 }
 
 // product calculates product by multiplying elements
@@ -183,47 +448,363 @@ func product(dims []int64) (retVal int64) {
 	return retVal
 }
 
-func factorial(n int64) (result int64) {
-	if n > 0 {
-		result = n * factorial(n-1)
-		return result
+func (k kaimingUniformInit) Set(tensor *ts.Tensor) error {
+	dims, err := tensor.Size()
+	if err != nil {
+		return fmt.Errorf("kaimingUniformInit - Set method call error: %v\n", err)
 	}
-	return 1
+
+	fanIn, _, err := calculateFans(dims)
+	if err != nil {
+		return fmt.Errorf("kaimingUniformInit - Set method call error: %v\n", err)
+	}
+
+	bound := k.gain * math.Sqrt(3.0/float64(fanIn))
+	if err = tensor.Uniform_(-bound, bound); err != nil {
+		return fmt.Errorf("kaimingUniformInit - Set method call error: %v\n", err)
+	}
+
+	return nil
+}
+
+// kaimingNormalInit :
+// ===================
+
+type kaimingNormalInit struct {
+	gain   float64
+	fanOut bool // whether to use fan-out instead of fan-in
 }
 
-func (k kaimingUniformInit) Set(tensor *ts.Tensor) {
+// NewKaimingNormalInit creates a Kaiming (He) normal init. Values are drawn
+// from N(0, std^2) with std = gain / sqrt(fan), where fan is fan-in or
+// fan-out for mode "fan_in" or "fan_out", and gain is that of the given
+// nonlinearity (see CalculateGain, "leaky_relu" has a negative slope of 0).
+// It mirrors Pytorch `torch.nn.init.kaiming_normal_`.
+func NewKaimingNormalInit(mode string, nonlinearity string) (kaimingNormalInit, error) {
+	var fanOut bool
+	switch mode {
+	case "fan_in":
+	case "fan_out":
+		fanOut = true
+	default:
+		err := fmt.Errorf("NewKaimingNormalInit() failed: invalid mode %q. Expected 'fan_in' or 'fan_out'.\n", mode)
+		return kaimingNormalInit{}, err
+	}
+
+	gain, err := CalculateGain(nonlinearity, 0.0)
+	if err != nil {
+		return kaimingNormalInit{}, err
+	}
+
+	return kaimingNormalInit{gain: gain, fanOut: fanOut}, nil
+}
+
+func (k kaimingNormalInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	return filled("kaimingNormalInit", dims, device, k.Set)
+}
+
+func (k kaimingNormalInit) Set(tensor *ts.Tensor) error {
 	dims, err := tensor.Size()
 	if err != nil {
-		log.Fatalf("uniformInit - Set method call error: %v\n", err)
+		return fmt.Errorf("kaimingNormalInit - Set method call error: %v\n", err)
 	}
 
-	var fanIn int64
-	if len(dims) == 0 {
-		log.Fatalf("KaimingUniformInit Set method call: Tensor (%v) should have length >= 1", tensor.MustSize())
-	} else if len(dims) == 1 {
-		fanIn = factorial(dims[0])
-	} else {
-		fanIn = product(dims[1:])
+	fanIn, fanOut, err := calculateFans(dims)
+	if err != nil {
+		return fmt.Errorf("kaimingNormalInit - Set method call error: %v\n", err)
+	}
+	fan := fanIn
+	if k.fanOut {
+		fan = fanOut
+	}
+
+	if err = tensor.Normal_(0.0, k.gain/math.Sqrt(float64(fan))); err != nil {
+		return fmt.Errorf("kaimingNormalInit - Set method call error: %v\n", err)
 	}
 
-	bound := math.Sqrt(1.0 / float64(fanIn))
-	tensor.Uniform_(-bound, bound)
+	return nil
 }
 
 // glorotInit :
 // ====================
-type glorotNInit struct{}
+type glorotNInit struct {
+	gain float64
+}
 
+// NewGlorotNInit creates a Glorot (Xavier) normal init with gain 1. Values are
+// drawn from N(0, std^2) with std = gain * sqrt(2/(fanIn + fanOut)).
 func NewGlorotNInit() glorotNInit {
-	return glorotNInit{}
+	return glorotNInit{gain: 1.0}
+}
+
+// NewGlorotNInitFor creates a Glorot (Xavier) normal init with gain for the
+// given nonlinearity (see CalculateGain).
+func NewGlorotNInitFor(nonlinearity string, param float64) (glorotNInit, error) {
+	gain, err := CalculateGain(nonlinearity, param)
+	if err != nil {
+		return glorotNInit{}, err
+	}
+
+	return glorotNInit{gain: gain}, nil
+}
+
+func (gl glorotNInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	return filled("glorotNInit", dims, device, gl.Set)
+}
+
+func (gl glorotNInit) Set(tensor *ts.Tensor) error {
+	dims, err := tensor.Size()
+	if err != nil {
+		return fmt.Errorf("glorotNInit - Set method call error: %v\n", err)
+	}
+
+	fanIn, fanOut, err := calculateFans(dims)
+	if err != nil {
+		return fmt.Errorf("glorotNInit - Set method call error: %v\n", err)
+	}
+
+	std := gl.gain * math.Sqrt(2.0/float64(fanIn+fanOut))
+	if err = tensor.Normal_(0.0, std); err != nil {
+		return fmt.Errorf("glorotNInit - Set method call error: %v\n", err)
+	}
+
+	return nil
+}
+
+// lecunInit :
+// ===========
+
+type lecunInit struct {
+	uniform bool
+}
+
+// NewLecunNormalInit creates a LeCun normal init, as required by SELU
+// networks. Values are drawn from N(0, std^2) with std = 1/sqrt(fanIn).
+func NewLecunNormalInit() lecunInit {
+	return lecunInit{uniform: false}
+}
+
+// NewLecunUniformInit creates a LeCun uniform init. Values are drawn from
+// U(-bound, bound) with bound = sqrt(3/fanIn), hence a variance of 1/fanIn.
+func NewLecunUniformInit() lecunInit {
+	return lecunInit{uniform: true}
+}
+
+func (l lecunInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	return filled("lecunInit", dims, device, l.Set)
+}
+
+func (l lecunInit) Set(tensor *ts.Tensor) error {
+	dims, err := tensor.Size()
+	if err != nil {
+		return fmt.Errorf("lecunInit - Set method call error: %v\n", err)
+	}
+
+	fanIn, _, err := calculateFans(dims)
+	if err != nil {
+		return fmt.Errorf("lecunInit - Set method call error: %v\n", err)
+	}
+
+	if l.uniform {
+		bound := math.Sqrt(3.0 / float64(fanIn))
+		err = tensor.Uniform_(-bound, bound)
+	} else {
+		err = tensor.Normal_(0.0, 1.0/math.Sqrt(float64(fanIn)))
+	}
+	if err != nil {
+		return fmt.Errorf("lecunInit - Set method call error: %v\n", err)
+	}
+
+	return nil
+}
+
+// eyeInit :
+// =========
+
+type eyeInit struct{}
+
+// NewEyeInit creates an init which fills a 2-D tensor with the identity
+// matrix. For non-square tensors, ones are on the main diagonal.
+func NewEyeInit() eyeInit {
+	return eyeInit{}
+}
+
+func (e eyeInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	if len(dims) != 2 {
+		err = fmt.Errorf("eyeInit - InitTensor method call: dims (%v) should have length = 2\n", dims)
+		return nil, err
+	}
+	if err = checkDims("eyeInit", dims); err != nil {
+		return nil, err
+	}
+
+	retVal, err = ts.Eye1(dims[0], dims[1], gotch.Float, device)
+	if err != nil {
+		return nil, fmt.Errorf("eyeInit - InitTensor method call error: %v\n", err)
+	}
+
+	return retVal, nil
+}
+
+func (e eyeInit) Set(tensor *ts.Tensor) error {
+	dims, err := tensor.Size()
+	if err != nil {
+		return fmt.Errorf("eyeInit - Set method call error: %v\n", err)
+	}
+	device, err := tensor.Device()
+	if err != nil {
+		return fmt.Errorf("eyeInit - Set method call error: %v\n", err)
+	}
+
+	eye, err := e.InitTensor(dims, device)
+	if err != nil {
+		return err
+	}
+	defer eye.MustDrop()
+
+	if err := tensor.CopyFrom_(eye); err != nil {
+		return fmt.Errorf("eyeInit - Set method call error: %v\n", err)
+	}
+
+	return nil
+}
+
+// diracInit :
+// ===========
+
+type diracInit struct {
+	groups int64
+}
+
+// NewDiracInit creates an init which fills a 3-D, 4-D or 5-D conv weight with
+// the Dirac delta function, preserving the identity of the inputs in conv
+// layers. In case of grouped convolution, each group of output channels keeps
+// its own identity. It mirrors Pytorch `torch.nn.init.dirac_`.
+func NewDiracInit(groups int64) diracInit {
+	return diracInit{groups}
+}
+
+func (d diracInit) data(dims []int64) ([]float32, error) {
+	if len(dims) < 3 || len(dims) > 5 {
+		err := fmt.Errorf("diracInit - dims (%v) should have length 3, 4 or 5\n", dims)
+		return nil, err
+	}
+	if err := checkDims("diracInit", dims); err != nil {
+		return nil, err
+	}
+	if d.groups <= 0 || dims[0]%d.groups != 0 {
+		err := fmt.Errorf("diracInit - first dimension (%v) should be divisible by groups (%v)\n", dims[0], d.groups)
+		return nil, err
+	}
+
+	// strides of a contiguous tensor
+	strides := make([]int64, len(dims))
+	stride := int64(1)
+	for i := len(dims) - 1; i >= 0; i-- {
+		strides[i] = stride
+		stride *= dims[i]
+	}
+
+	// offset to the center of the kernel
+	var center int64
+	for i := 2; i < len(dims); i++ {
+		center += (dims[i] / 2) * strides[i]
+	}
+
+	outPerGroup := dims[0] / d.groups
+	minDim := outPerGroup
+	if dims[1] < minDim {
+		minDim = dims[1]
+	}
+
+	data := make([]float32, ts.FlattenDim(dims))
+	for g := int64(0); g < d.groups; g++ {
+		for i := int64(0); i < minDim; i++ {
+			data[(g*outPerGroup+i)*strides[0]+i*strides[1]+center] = 1
+		}
+	}
+
+	return data, nil
 }
 
-func (gl glorotNInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor) {
-	// TODO: implement
+func (d diracInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	data, err := d.data(dims)
+	if err != nil {
+		return nil, err
+	}
+
+	retVal, err = newTensorOn(data, dims, device)
+	if err != nil {
+		return nil, fmt.Errorf("diracInit - InitTensor method call error: %v\n", err)
+	}
+
+	return retVal, nil
+}
+
+func (d diracInit) Set(tensor *ts.Tensor) error {
+	return setData("diracInit", tensor, d.data)
+}
+
+// sparseInit :
+// ============
+
+type sparseInit struct {
+	sparsity float64
+	stdev    float64
+}
+
+// NewSparseInit creates an init which fills a 2-D tensor as a sparse matrix:
+// a `sparsity` fraction of the elements of each column is set to zero and
+// the others are drawn from N(0, stdev^2). It mirrors Pytorch
+// `torch.nn.init.sparse_`.
+func NewSparseInit(sparsity float64, stdev float64) sparseInit {
+	return sparseInit{sparsity, stdev}
+}
+
+func (s sparseInit) data(dims []int64) ([]float32, error) {
+	if len(dims) != 2 {
+		err := fmt.Errorf("sparseInit - dims (%v) should have length = 2\n", dims)
+		return nil, err
+	}
+	if err := checkDims("sparseInit", dims); err != nil {
+		return nil, err
+	}
+
+	if s.sparsity < 0 || s.sparsity > 1 {
+		err := fmt.Errorf("sparseInit - sparsity (%v) should be in range [0, 1]\n", s.sparsity)
+		return nil, err
+	}
+
+	rows, cols := dims[0], dims[1]
+	numZeros := int(math.Ceil(s.sparsity * float64(rows)))
+
+	data := make([]float32, ts.FlattenDim(dims))
+	for i := range data {
+		data[i] = float32(rand.NormFloat64() * s.stdev)
+	}
+	for c := int64(0); c < cols; c++ {
+		for _, r := range rand.Perm(int(rows))[:numZeros] {
+			data[int64(r)*cols+c] = 0
+		}
+	}
+
+	return data, nil
+}
+
+func (s sparseInit) InitTensor(dims []int64, device gotch.Device) (retVal *ts.Tensor, err error) {
+	data, err := s.data(dims)
+	if err != nil {
+		return nil, err
+	}
+
+	retVal, err = newTensorOn(data, dims, device)
+	if err != nil {
+		return nil, fmt.Errorf("sparseInit - InitTensor method call error: %v\n", err)
+	}
 
-	return
+	return retVal, nil
 }
 
-func (gl glorotNInit) Set(tensor *ts.Tensor) {
-	// TODO: implement
+func (s sparseInit) Set(tensor *ts.Tensor) error {
+	return setData("sparseInit", tensor, s.data)
 }
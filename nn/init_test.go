@@ -0,0 +1,378 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func initTensor(t *testing.T, init nn.Init, dims []int64) *ts.Tensor {
+	t.Helper()
+	x, err := init.InitTensor(dims, gotch.CPU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func setInit(t *testing.T, init nn.Init, x *ts.Tensor) {
+	t.Helper()
+	if err := init.Set(x); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCalculateGain(t *testing.T) {
+	tests := []struct {
+		nonlinearity string
+		param        float64
+		want         float64
+	}{
+		{"linear", 0, 1.0},
+		{"conv2d", 0, 1.0},
+		{"conv_transpose3d", 0, 1.0},
+		{"sigmoid", 0, 1.0},
+		{"tanh", 0, 5.0 / 3.0},
+		{"relu", 0, math.Sqrt(2.0)},
+		{"leaky_relu", 0.01, math.Sqrt(2.0 / (1.0 + 0.01*0.01))},
+		{"leaky_relu", math.Sqrt(5.0), math.Sqrt(1.0 / 3.0)},
+		{"selu", 0, 0.75},
+	}
+
+	for _, tt := range tests {
+		got, err := nn.CalculateGain(tt.nonlinearity, tt.param)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v\n", tt.nonlinearity, err)
+			continue
+		}
+		if math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("Expected gain for %q: %v\n", tt.nonlinearity, tt.want)
+			t.Errorf("Got gain for %q: %v\n", tt.nonlinearity, got)
+		}
+	}
+
+	if _, err := nn.CalculateGain("swish", 0); err == nil {
+		t.Errorf("Expected error for unknown nonlinearity, got nil\n")
+	}
+}
+
+func TestKaimingUniformInitFor(t *testing.T) {
+	init, err := nn.NewKaimingUniformInitFor("relu", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// conv weight: fanIn = 4 * 3 * 3
+	x := initTensor(t, init, []int64{8, 4, 3, 3})
+	bound := math.Sqrt(2.0) * math.Sqrt(3.0/36.0)
+	for _, v := range x.Float64Values() {
+		if math.Abs(v) > bound {
+			t.Fatalf("Expected values within [-%v, %v], got %v\n", bound, bound, v)
+		}
+	}
+
+	if _, err := nn.NewKaimingUniformInitFor("unknown", 0); err == nil {
+		t.Errorf("Expected error for unknown nonlinearity, got nil\n")
+	}
+}
+
+func TestGlorotNInitFor(t *testing.T) {
+	init, err := nn.NewGlorotNInitFor("tanh", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fanIn, fanOut := 200.0, 300.0
+	x := initTensor(t, init, []int64{300, 200})
+	want := 5.0 / 3.0 * math.Sqrt(2.0/(fanIn+fanOut))
+	got := x.MustStd(true, false).Float64Values()[0]
+	if math.Abs(got-want) > 0.05*want {
+		t.Errorf("Expected std: %v\n", want)
+		t.Errorf("Got std: %v\n", got)
+	}
+}
+
+func TestRandnInit(t *testing.T) {
+	x := initTensor(t, nn.NewRandnInit(3.0, 0.1), []int64{100, 100})
+	if got := x.MustMean(gotch.Float, false).Float64Values()[0]; math.Abs(got-3.0) > 0.01 {
+		t.Errorf("Expected mean: %v\n", 3.0)
+		t.Errorf("Got mean: %v\n", got)
+	}
+	if got := x.MustStd(true, false).Float64Values()[0]; math.Abs(got-0.1) > 0.01 {
+		t.Errorf("Expected std: %v\n", 0.1)
+		t.Errorf("Got std: %v\n", got)
+	}
+}
+
+func TestKaimingNormalInit(t *testing.T) {
+	init, err := nn.NewKaimingNormalInit("fan_in", "relu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// conv weight: fanIn = 16 * 3 * 3, fanOut = 64 * 3 * 3
+	dims := []int64{64, 16, 3, 3}
+	want := math.Sqrt(2.0) / math.Sqrt(16*3*3)
+	x := initTensor(t, init, dims)
+	got := x.MustStd(true, false).Float64Values()[0]
+	if math.Abs(got-want) > 0.05*want {
+		t.Errorf("Expected std: %v\n", want)
+		t.Errorf("Got std: %v\n", got)
+	}
+
+	init, err = nn.NewKaimingNormalInit("fan_out", "relu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	setInit(t, init, x)
+	want = math.Sqrt(2.0) / math.Sqrt(64*3*3)
+	got = x.MustStd(true, false).Float64Values()[0]
+	if math.Abs(got-want) > 0.05*want {
+		t.Errorf("Expected std after Set: %v\n", want)
+		t.Errorf("Got std after Set: %v\n", got)
+	}
+
+	if _, err := nn.NewKaimingNormalInit("fan_avg", "relu"); err == nil {
+		t.Errorf("Expected error for invalid mode, got nil\n")
+	}
+	if _, err := nn.NewKaimingNormalInit("fan_in", "unknown"); err == nil {
+		t.Errorf("Expected error for unknown nonlinearity, got nil\n")
+	}
+}
+
+func TestLecunInit(t *testing.T) {
+	// linear weight: fanIn = 250
+	dims := []int64{400, 250}
+	want := 1.0 / 250.0
+
+	for _, tc := range []struct {
+		name string
+		init nn.Init
+	}{
+		{"normal", nn.NewLecunNormalInit()},
+		{"uniform", nn.NewLecunUniformInit()},
+	} {
+		x := initTensor(t, tc.init, dims)
+		got := x.MustVar(true, false).Float64Values()[0]
+		if math.Abs(got-want) > 0.05*want {
+			t.Errorf("Expected %v variance: %v\n", tc.name, want)
+			t.Errorf("Got %v variance: %v\n", tc.name, got)
+		}
+
+		x.MustZero_()
+		setInit(t, tc.init, x)
+		got = x.MustVar(true, false).Float64Values()[0]
+		if math.Abs(got-want) > 0.05*want {
+			t.Errorf("Expected %v variance after Set: %v\n", tc.name, want)
+			t.Errorf("Got %v variance after Set: %v\n", tc.name, got)
+		}
+		x.MustDrop()
+	}
+
+	// uniform values are bounded
+	bound := math.Sqrt(3.0 / 250.0)
+	x := initTensor(t, nn.NewLecunUniformInit(), dims)
+	for _, v := range x.Float64Values() {
+		if math.Abs(v) > bound {
+			t.Fatalf("Expected values within [-%v, %v], got %v\n", bound, bound, v)
+		}
+	}
+	x.MustDrop()
+}
+
+func TestFnInit(t *testing.T) {
+	sevens := nn.NewFnInit(func(dims []int64, device gotch.Device) *ts.Tensor {
+		return ts.MustOnes(dims, gotch.Float, device).MustMul1(ts.FloatScalar(7), true)
+	})
+
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := nn.DefaultLinearConfig()
+	cfg.WsInit = sevens
+	linear := nn.NewLinear(vs.Root(), 3, 2, cfg)
+
+	want := []float64{7, 7, 7, 7, 7, 7}
+	if got := linear.Ws.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected weight values: %v\n", want)
+		t.Errorf("Got weight values: %v\n", got)
+	}
+
+	x := ts.MustZeros([]int64{2, 2}, gotch.Float, gotch.CPU)
+	setInit(t, sevens, x)
+	if want, got := []float64{7, 7, 7, 7}, x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values after Set: %v\n", want)
+		t.Errorf("Got tensor values after Set: %v\n", got)
+	}
+	x.MustDrop()
+}
+
+func TestEyeInit(t *testing.T) {
+	x := initTensor(t, nn.NewEyeInit(), []int64{2, 3})
+	want := []float64{1, 0, 0, 0, 1, 0}
+	got := x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+
+	y := ts.MustOnes([]int64{3, 3}, gotch.Float, gotch.CPU)
+	setInit(t, nn.NewEyeInit(), y)
+	want = []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}
+	got = y.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+}
+
+func TestDiracInit(t *testing.T) {
+	// conv1d weight [outC=4, inC=2, k=3] with 2 groups
+	x := initTensor(t, nn.NewDiracInit(2), []int64{4, 2, 3})
+	want := []float64{
+		0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 1, 0,
+		0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 1, 0,
+	}
+	got := x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+}
+
+func TestSparseInit(t *testing.T) {
+	rows, cols := int64(100), int64(5)
+	x := initTensor(t, nn.NewSparseInit(0.3, 0.01), []int64{rows, cols})
+	values := x.Float64Values()
+
+	for c := int64(0); c < cols; c++ {
+		var zeros int
+		for r := int64(0); r < rows; r++ {
+			if values[r*cols+c] == 0 {
+				zeros++
+			}
+		}
+		// NOTE. values drawn from normal distribution are almost surely nonzero.
+		if zeros < 30 || zeros > 32 {
+			t.Errorf("Expected about 30 zeros in column %v, got %v\n", c, zeros)
+		}
+	}
+}
+
+func TestTruncatedNormalInit(t *testing.T) {
+	mean, stdev, a, b := 0.5, 1.0, -0.5, 1.5
+	init, err := nn.NewTruncatedNormalInit(mean, stdev, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := initTensor(t, init, []int64{100, 100})
+
+	var sum float64
+	values := x.Float64Values()
+	for _, v := range values {
+		if v < a || v > b {
+			t.Fatalf("Expected values within [%v, %v], got %v\n", a, b, v)
+		}
+		sum += v
+	}
+	// bounds are symmetric around the mean, so is the truncated distribution.
+	got := sum / float64(len(values))
+	if math.Abs(got-mean) > 0.02 {
+		t.Errorf("Expected mean: %v\n", mean)
+		t.Errorf("Got mean: %v\n", got)
+	}
+
+	y := ts.MustZeros([]int64{1000}, gotch.Float, gotch.CPU)
+	defaultInit, err := nn.NewDefaultTruncatedNormalInit(0.0, 0.02)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setInit(t, defaultInit, y)
+	for _, v := range y.Float64Values() {
+		if math.Abs(v) > 0.04+1e-6 {
+			t.Fatalf("Expected values within [-0.04, 0.04], got %v\n", v)
+		}
+	}
+
+	for _, tc := range []struct {
+		name            string
+		stdev, min, max float64
+	}{
+		{"zero stdev", 0.0, -1.0, 1.0},
+		{"negative stdev", -1.0, -1.0, 1.0},
+		{"NaN stdev", math.NaN(), -1.0, 1.0},
+		{"bounds", 1.0, 1.0, -1.0},
+	} {
+		if _, err := nn.NewTruncatedNormalInit(0.0, tc.stdev, tc.min, tc.max); err == nil {
+			t.Errorf("Expected NewTruncatedNormalInit error for %v, got nil\n", tc.name)
+		}
+	}
+	if _, err := nn.NewDefaultTruncatedNormalInit(0.0, 0.0); err == nil {
+		t.Errorf("Expected NewDefaultTruncatedNormalInit error for zero stdev, got nil\n")
+	}
+}
+
+func TestInitErrors(t *testing.T) {
+	kaimingNormal, err := nn.NewKaimingNormalInit("fan_in", "relu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		init nn.Init
+		dims []int64
+	}{
+		{"const: negative dim", nn.NewConstInit(2.0), []int64{2, -1}},
+		{"randn: negative dim", nn.NewRandnInit(0.0, 1.0), []int64{-3}},
+		{"uniform: negative dim", nn.NewUniformInit(-1.0, 1.0), []int64{-1, 2}},
+		{"kaiming uniform: scalar", nn.NewKaimingUniformInit(), []int64{}},
+		{"kaiming normal: negative dim", kaimingNormal, []int64{4, -2}},
+		{"glorot: scalar", nn.NewGlorotNInit(), []int64{}},
+		{"lecun: scalar", nn.NewLecunNormalInit(), []int64{}},
+		{"eye: 3 dims", nn.NewEyeInit(), []int64{2, 2, 2}},
+		{"dirac: 2 dims", nn.NewDiracInit(1), []int64{2, 2}},
+		{"dirac: groups", nn.NewDiracInit(3), []int64{4, 2, 3}},
+		{"sparse: sparsity", nn.NewSparseInit(1.5, 0.01), []int64{4, 4}},
+	} {
+		if x, err := tc.init.InitTensor(tc.dims, gotch.CPU); err == nil {
+			t.Errorf("Expected InitTensor error for %v, got tensor of shape %v\n", tc.name, x.MustSize())
+		}
+	}
+
+	// Set on a 0-D tensor fails for inits depending on fans.
+	x := ts.MustOnes([]int64{}, gotch.Float, gotch.CPU)
+	for _, init := range []nn.Init{nn.NewKaimingUniformInit(), kaimingNormal, nn.NewGlorotNInit(), nn.NewLecunUniformInit()} {
+		if err := init.Set(x); err == nil {
+			t.Errorf("Expected Set error for %T on a 0-D tensor, got nil\n", init)
+		}
+	}
+	x.MustDrop()
+
+	// copy errors are returned, not fatal
+	x = ts.MustZeros([]int64{2, 2}, gotch.Float, gotch.CPU)
+	wrongShape := nn.NewFnInit(func(dims []int64, device gotch.Device) *ts.Tensor {
+		return ts.MustOnes([]int64{3}, gotch.Float, device)
+	})
+	if err := wrongShape.Set(x); err == nil {
+		t.Errorf("Expected Set error for a function returning a wrong shape, got nil\n")
+	}
+	x.MustRequiresGrad_(true)
+	if err := nn.NewEyeInit().Set(x); err == nil {
+		t.Errorf("Expected Set error for a leaf requiring grad outside NoGrad, got nil\n")
+	}
+	x.MustDrop()
+
+	// errors propagate to var-store paths
+	vs := nn.NewVarStore(gotch.CPU)
+	if _, err := vs.Root().NewVar("w", []int64{3, -3}, nn.NewUniformInit(0.0, 1.0)); err == nil {
+		t.Errorf("Expected NewVar error for invalid dims, got nil\n")
+	}
+	if vs.Len() != 0 {
+		t.Errorf("Expected no variable added on error, got %v\n", vs.Len())
+	}
+}
@@ -0,0 +1,100 @@
+package nn
+
+// An instance-normalization layer.
+
+import (
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Instance-normalization config.
+type InstanceNormConfig struct {
+	CudnnEnable       bool
+	Eps               float64
+	Momentum          float64
+	Affine            bool
+	TrackRunningStats bool
+	WsInit            Init
+	BsInit            Init
+}
+
+func DefaultInstanceNormConfig() *InstanceNormConfig {
+	return &InstanceNormConfig{
+		CudnnEnable:       true,
+		Eps:               1e-5,
+		Momentum:          0.1,
+		Affine:            false,
+		TrackRunningStats: false,
+		WsInit:            NewConstInit(1.0),
+		BsInit:            NewConstInit(0.0),
+	}
+}
+
+// An instance-normalization layer.
+//
+// Each channel of each sample is normalized separately over its spatial
+// dimensions.
+type InstanceNorm struct {
+	Config      *InstanceNormConfig
+	RunningMean *ts.Tensor // undefined when TrackRunningStats is false
+	RunningVar  *ts.Tensor // undefined when TrackRunningStats is false
+	Ws          *ts.Tensor // undefined when Affine is false
+	Bs          *ts.Tensor // undefined when Affine is false
+	Nd          uint
+}
+
+// NewInstanceNorm creates a new InstanceNorm layer
+func NewInstanceNorm(vs *Path, nd uint, numFeatures int64, config *InstanceNormConfig) *InstanceNorm {
+	in := &InstanceNorm{
+		Config:      config,
+		RunningMean: ts.NewTensor(),
+		RunningVar:  ts.NewTensor(),
+		Ws:          ts.NewTensor(),
+		Bs:          ts.NewTensor(),
+		Nd:          nd,
+	}
+
+	if config.Affine {
+		in.Ws = vs.MustNewVar("weight", []int64{numFeatures}, config.WsInit)
+		in.Bs = vs.MustNewVar("bias", []int64{numFeatures}, config.BsInit)
+	}
+	if config.TrackRunningStats {
+		in.RunningMean = vs.ZerosNoTrain("running_mean", []int64{numFeatures})
+		in.RunningVar = vs.OnesNoTrain("running_var", []int64{numFeatures})
+	}
+
+	return in
+}
+
+// Applies Instance Normalization over a four dimension input.
+//
+// The input shape is assumed to be (N, C, H, W). Normalization
+// is performed over H and W for each sample and channel.
+func InstanceNorm2D(vs *Path, numFeatures int64, config *InstanceNormConfig) *InstanceNorm {
+	return NewInstanceNorm(vs, 2, numFeatures, config)
+}
+
+// Implement Module, ModuleT interfaces for InstanceNorm:
+// ======================================================
+
+// Forward implements Module interface for InstanceNorm
+//
+// It normalizes with input statistics, as in training mode.
+func (in *InstanceNorm) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return in.ForwardT(xs, true)
+}
+
+// ForwardT implements ModuleT interface for InstanceNorm
+//
+// Running statistics, when tracked, are updated in training and used for
+// normalization otherwise.
+func (in *InstanceNorm) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	if int(xs.Dim()) != int(in.Nd)+2 {
+		log.Fatalf("Expected an input tensor with %v dims, got %v\n", in.Nd+2, xs.MustSize())
+	}
+
+	useInputStats := train || !in.Config.TrackRunningStats
+
+	return ts.MustInstanceNorm(xs, in.Ws, in.Bs, in.RunningMean, in.RunningVar, useInputStats, in.Config.Momentum, in.Config.Eps, in.Config.CudnnEnable)
+}
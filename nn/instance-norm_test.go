@@ -0,0 +1,38 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestInstanceNorm2D(t *testing.T) {
+	cfg := nn.DefaultInstanceNormConfig()
+	cfg.Affine = true
+
+	vs := nn.NewVarStore(gotch.CPU)
+	in := nn.InstanceNorm2D(vs.Root(), 3, cfg)
+
+	if want, got := 2, len(vs.Variables()); want != got {
+		t.Errorf("Expected number of variables: %v\n", want)
+		t.Errorf("Got number of variables: %v\n", got)
+	}
+
+	want := []int64{2, 3, 4, 5}
+	out := in.ForwardT(ts.MustRandn(want, gotch.Float, gotch.CPU), true)
+	if got := out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	means := out.MustMean1([]int64{2, 3}, false, gotch.Double, true).Float64Values()
+	for i, m := range means {
+		if math.Abs(m) > 1e-5 {
+			t.Errorf("Expected mean of instance %v near zero, got: %v\n", i, m)
+		}
+	}
+}
@@ -27,20 +27,20 @@ func DefaultLayerNormConfig() *LayerNormConfig {
 // A layer-normalization layer.
 type LayerNorm struct {
 	Config          *LayerNormConfig
-	Ws              *ts.Tensor // optional
-	Bs              *ts.Tensor // optional
+	Ws              *ts.Tensor // undefined when ElementwiseAffine is false
+	Bs              *ts.Tensor // undefined when ElementwiseAffine is false
 	NormalizedShape []int64
 }
 
 func NewLayerNorm(vs *Path, normalizedShape []int64, config *LayerNormConfig) *LayerNorm {
 
 	var (
-		ws *ts.Tensor
-		bs *ts.Tensor
+		ws *ts.Tensor = ts.NewTensor()
+		bs *ts.Tensor = ts.NewTensor()
 	)
 	if config.ElementwiseAffine {
-		ws = vs.NewVar("weight", normalizedShape, config.WsInit)
-		bs = vs.NewVar("bias", normalizedShape, config.BsInit)
+		ws = vs.MustNewVar("weight", normalizedShape, config.WsInit)
+		bs = vs.MustNewVar("bias", normalizedShape, config.BsInit)
 	}
 
 	return &LayerNorm{config, ws, bs, normalizedShape}
@@ -0,0 +1,52 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestLayerNorm(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	ln := nn.NewLayerNorm(vs.Root(), []int64{3, 4}, nn.DefaultLayerNormConfig())
+
+	want := []int64{3, 4}
+	for _, name := range []string{"weight", "bias"} {
+		got := vs.Variables()[name].MustSize()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v shape: %v\n", name, want)
+			t.Errorf("Got %v shape: %v\n", name, got)
+		}
+	}
+
+	xs := ts.MustRandn([]int64{2, 3, 4}, gotch.Float, gotch.CPU)
+	means := ln.Forward(xs).MustMean1([]int64{1, 2}, false, gotch.Double, true).Float64Values()
+	for i, m := range means {
+		if math.Abs(m) > 1e-5 {
+			t.Errorf("Expected mean of sample %v near zero, got: %v\n", i, m)
+		}
+	}
+}
+
+func TestLayerNormConstantInput(t *testing.T) {
+	cfg := nn.DefaultLayerNormConfig()
+	cfg.ElementwiseAffine = false
+
+	vs := nn.NewVarStore(gotch.CPU)
+	ln := nn.NewLayerNorm(vs.Root(), []int64{4}, cfg)
+
+	if got := len(vs.Variables()); got != 0 {
+		t.Errorf("Expected no variables without elementwise affine, got: %v\n", got)
+	}
+
+	xs := ts.MustOnes([]int64{2, 4}, gotch.Float, gotch.CPU)
+	for i, v := range ln.Forward(xs).Float64Values() {
+		if math.IsNaN(v) || math.IsInf(v, 0) || v != 0 {
+			t.Errorf("Expected zero output at %v for constant input, got: %v\n", i, v)
+		}
+	}
+}
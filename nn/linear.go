@@ -5,7 +5,6 @@ package nn
 import (
 	"math"
 
-	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
 
@@ -27,36 +26,41 @@ func DefaultLinearConfig() *LinearConfig {
 }
 
 // Linear is a linear fully-connected layer
+//
+// NOTE: `Bs` is an undefined tensor (see `Tensor.MustDefined`) when the layer
+// has no bias.
 type Linear struct {
-	Ws *ts.Tensor
-	Bs *ts.Tensor
+	Ws *ts.Tensor // weight of shape [outDim, inDim]
+	Bs *ts.Tensor // optional bias of shape [outDim]
 }
 
 // NewLinear creates a new linear layer
 // y = x*wT + b
 // inDim - input dimension (x) [input features - columns]
 // outDim - output dimension (y) [output features - columns]
-// NOTE: w will have shape{outDim, inDim}; b will have shape{outDim}
+// NOTE: w will have shape{outDim, inDim}; b will have shape{outDim}.
+// Without bias, b is an undefined tensor.
+//
+// Ws and Bs are the var-store variables themselves (not views of them), so
+// that the layer keeps working after `VarStore.ToDevice` or `ToDType`.
 func NewLinear(vs *Path, inDim, outDim int64, c *LinearConfig) *Linear {
 
-	var bs *ts.Tensor
+	var bs *ts.Tensor = ts.NewTensor()
 	// bs has size of output dimension
 	switch c.Bias {
-	case false:
-		bs = ts.MustZeros([]int64{outDim}, gotch.Float, vs.Device())
 	case true:
 		switch {
 		case c.BsInit == nil:
 			bound := 1.0 / math.Sqrt(float64(inDim))
 			bsInit := NewUniformInit(-bound, bound)
-			bs = vs.NewVar("bias", []int64{outDim}, bsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, bsInit)
 		case c.BsInit != nil:
-			bs = vs.NewVar("bias", []int64{outDim}, c.BsInit)
+			bs = vs.MustNewVar("bias", []int64{outDim}, c.BsInit)
 		}
 	}
 
 	return &Linear{
-		Ws: vs.NewVar("weight", []int64{outDim, inDim}, c.WsInit).MustT(false),
+		Ws: vs.MustNewVar("weight", []int64{outDim, inDim}, c.WsInit),
 		Bs: bs,
 	}
 }
@@ -66,11 +70,14 @@ func NewLinear(vs *Path, inDim, outDim int64, c *LinearConfig) *Linear {
 
 // Forward proceeds input node through linear layer.
 // NOTE:
-// - It assumes that node has dimensions of 2 (matrix).
-// To make it work for matrix multiplication, input node should
+// - Input node can have any number of leading (batch, sequence, ...)
+// dimensions. They are broadcast by matmul and only the last dimension
+// is transformed from `inDim` to `outDim`.
+// - To make it work for matrix multiplication, input node should
 // has same number of **column** as number of **column** in
 // `LinearLayer` `Ws` property as weights matrix will be
 // transposed before multiplied to input node. (They are all used `inDim`)
+// - Bias is skipped if `Bs` is undefined.
 // - Input node should have shape of `shape{batch size, input features}`.
 // (shape{batchSize, inDim}). The input features is `inDim` while the
 // output feature is `outDim` in `LinearConfig` struct.
@@ -90,16 +97,38 @@ func NewLinear(vs *Path, inDim, outDim int64, c *LinearConfig) *Linear {
 // 	  1 1 1
 // 		1 1 1 ]
 func (l *Linear) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	if !l.hasBias() {
+		wT := l.Ws.MustT(false)
+		retVal = xs.MustMatmul(wT, false)
+		wT.MustDrop()
 
-	mul := xs.MustMatmul(l.Ws, false)
-	return mul.MustAdd(l.Bs, true)
+		return retVal
+	}
+
+	return ts.MustLinear(xs, l.Ws, l.Bs)
 }
 
 // ForwardT implements ModuleT interface for Linear layer.
 //
 // NOTE: train param will not be used.
 func (l *Linear) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return l.Forward(xs)
+}
+
+// hasBias returns whether the layer has a bias. `Bs` may also have been set to
+// nil by the caller.
+func (l *Linear) hasBias() bool {
+	return l.Bs != nil && l.Bs.MustDefined()
+}
+
+// WeightTensor implements WeightedModule interface for Linear layer.
+//
+// NOTE: the returned weight has shape [outDim, inDim].
+func (l *Linear) WeightTensor() *ts.Tensor {
+	return l.Ws
+}
 
-	mul := xs.MustMatmul(l.Ws, false)
-	return mul.MustAdd(l.Bs, true)
+// SetWeightTensor implements WeightedModule interface for Linear layer.
+func (l *Linear) SetWeightTensor(w *ts.Tensor) {
+	l.Ws = w
 }
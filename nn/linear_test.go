@@ -0,0 +1,90 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestLinear(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	linear := nn.NewLinear(vs.Root(), 3, 2, nn.DefaultLinearConfig())
+
+	// 2D input
+	input := ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)
+	want := []int64{4, 2}
+	got := linear.Forward(input).MustSize()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	// input with leading batch and sequence dims
+	input = ts.MustRandn([]int64{5, 7, 3}, gotch.Float, gotch.CPU)
+	want = []int64{5, 7, 2}
+	got = linear.Forward(input).MustSize()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	if vs.Len() != 2 {
+		t.Errorf("Expected 2 variables (weight, bias) in var store, got %v\n", vs.Len())
+	}
+}
+
+func TestLinearNoBias(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := nn.DefaultLinearConfig()
+	cfg.Bias = false
+	linear := nn.NewLinear(vs.Root(), 3, 2, cfg)
+
+	input := ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)
+	want := []int64{4, 2}
+	got := linear.Forward(input).MustSize()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	if _, ok := vs.Variables()["bias"]; ok {
+		t.Errorf("Expected no bias variable in var store when Bias is disabled.\n")
+	}
+	if linear.Bs.MustDefined() {
+		t.Errorf("Expected undefined bias tensor when Bias is disabled.\n")
+	}
+
+	// Ws is the [outDim, inDim] var-store variable
+	if want, got := []int64{2, 3}, linear.Ws.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected weight shape: %v\n", want)
+		t.Errorf("Got weight shape: %v\n", got)
+	}
+
+	// y = x*wT with no bias added
+	ts.NoGrad(func() {
+		linear.Ws.Copy_(ts.MustOfSlice([]float32{1, 0, 0, 0, 1, 1}).MustView([]int64{2, 3}, true))
+	})
+	x := ts.MustOfSlice([]float32{1, 2, 3}).MustView([]int64{1, 3}, true)
+	if want, got := []float64{1, 5}, linear.Forward(x).Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output: %v\n", want)
+		t.Errorf("Got output: %v\n", got)
+	}
+	if want, got := []float64{1, 5}, linear.ForwardT(x, true).Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output: %v\n", want)
+		t.Errorf("Got output: %v\n", got)
+	}
+
+	// bias set to nil by the caller
+	linear.Bs = nil
+	if want, got := []float64{1, 5}, linear.Forward(x).Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output: %v\n", want)
+		t.Errorf("Got output: %v\n", got)
+	}
+
+	if vs.Len() != 1 {
+		t.Errorf("Expected 1 variable (weight) in var store, got %v\n", vs.Len())
+	}
+}
@@ -0,0 +1,301 @@
+package nn
+
+// Export of models to the ONNX format.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// NOTE: libtorch C++ has no ONNX exporter (`torch.onnx` lives in the Python
+// frontend), so models are exported by walking the module structure and
+// mapping each supported layer to ONNX operators. The protobuf messages are
+// encoded directly following onnx.proto to avoid extra dependencies.
+
+const (
+	onnxIRVersion    int64 = 7
+	onnxOpsetVersion int64 = 13
+
+	// onnx.TensorProto.DataType
+	onnxFloat int64 = 1
+
+	// onnx.AttributeProto.AttributeType
+	onnxAttrFloat int64 = 1
+	onnxAttrInt   int64 = 2
+	onnxAttrInts  int64 = 7
+)
+
+// ExportONNX exports module m to an ONNX file at path.
+//
+// The example input fixes the input shape of the exported graph and is run
+// through m to get the output shape. Supported modules are Sequential,
+// Linear, Conv1D, Conv2D, Conv3D, ReLU, LeakyReLU, Sigmoid, Tanh and Softmax.
+// An error naming the module type is returned for any other module.
+func ExportONNX(m ts.Module, exampleInput *ts.Tensor, path string) error {
+	if dtype := exampleInput.DType(); dtype != gotch.Float {
+		err := fmt.Errorf("ExportONNX() failed: expected example input of dtype %v, got %v.\n", gotch.Float, dtype)
+		return err
+	}
+
+	e := &onnxExporter{}
+	output, err := e.export(m, "input")
+	if err != nil {
+		return err
+	}
+
+	var out *ts.Tensor
+	ts.NoGrad(func() {
+		out = m.Forward(exampleInput)
+	})
+	outShape := out.MustSize()
+	out.MustDrop()
+
+	var graph []byte
+	for _, node := range e.nodes {
+		graph = pbBytes(graph, 1, node)
+	}
+	graph = pbString(graph, 2, "gotch")
+	for _, t := range e.initializers {
+		graph = pbBytes(graph, 5, t)
+	}
+	graph = pbBytes(graph, 11, onnxValueInfo("input", exampleInput.MustSize()))
+	graph = pbBytes(graph, 12, onnxValueInfo(output, outShape))
+
+	var opset []byte
+	opset = pbString(opset, 1, "")
+	opset = pbInt(opset, 2, onnxOpsetVersion)
+
+	var model []byte
+	model = pbInt(model, 1, onnxIRVersion)
+	model = pbString(model, 2, "gotch")
+	model = pbBytes(model, 7, graph)
+	model = pbBytes(model, 8, opset)
+
+	if err := ioutil.WriteFile(path, model, 0644); err != nil {
+		err = fmt.Errorf("ExportONNX() failed: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// onnxExporter accumulates encoded graph nodes and initializers.
+type onnxExporter struct {
+	nodes        [][]byte
+	initializers [][]byte
+	count        int
+}
+
+// export adds nodes computing m on the named input and returns the name of
+// the output.
+func (e *onnxExporter) export(m ts.Module, input string) (string, error) {
+	switch m := m.(type) {
+	case *Sequential:
+		var err error
+		for _, l := range m.layers {
+			if input, err = e.export(l, input); err != nil {
+				return "", err
+			}
+		}
+		return input, nil
+
+	case *Linear:
+		// Ws has shape [outDim, inDim], MatMul expects [inDim, outDim].
+		wsT := m.Ws.MustT(false)
+		ws := e.addInitializer("linear.weight", wsT)
+		wsT.MustDrop()
+		hidden := e.addNode("MatMul", []string{input, ws})
+		if !m.hasBias() {
+			return hidden, nil
+		}
+		bs := e.addInitializer("linear.bias", m.Bs)
+		return e.addNode("Add", []string{hidden, bs}), nil
+
+	case *Conv1D:
+		return e.addConv(input, m.Ws, m.Bs, m.Config.Stride, m.Config.Padding, m.Config.Dilation, m.Config.Groups), nil
+	case *Conv2D:
+		return e.addConv(input, m.Ws, m.Bs, m.Config.Stride, m.Config.Padding, m.Config.Dilation, m.Config.Groups), nil
+	case *Conv3D:
+		return e.addConv(input, m.Ws, m.Bs, m.Config.Stride, m.Config.Padding, m.Config.Dilation, m.Config.Groups), nil
+
+	case *ReLU:
+		return e.addNode("Relu", []string{input}), nil
+	case *LeakyReLU:
+		return e.addNode("LeakyRelu", []string{input}, onnxAttrF("alpha", m.NegativeSlope)), nil
+	case *Sigmoid:
+		return e.addNode("Sigmoid", []string{input}), nil
+	case *Tanh:
+		return e.addNode("Tanh", []string{input}), nil
+	case *Softmax:
+		return e.addNode("Softmax", []string{input}, onnxAttrI("axis", m.Dim)), nil
+
+	default:
+		err := fmt.Errorf("ExportONNX() failed: unsupported module %T.\n", m)
+		return "", err
+	}
+}
+
+func (e *onnxExporter) addConv(input string, ws, bs *ts.Tensor, stride, padding, dilation []int64, groups int64) string {
+	kernel := ws.MustSize()[2:]
+	nd := len(kernel)
+
+	// ONNX expects begin and end padding for each spatial dim.
+	pads := make([]int64, 2*nd)
+	for i := 0; i < nd; i++ {
+		pads[i] = expandConvParam(padding, nd)[i]
+		pads[i+nd] = pads[i]
+	}
+
+	inputs := []string{input, e.addInitializer("conv.weight", ws)}
+	if bs.MustDefined() {
+		inputs = append(inputs, e.addInitializer("conv.bias", bs))
+	}
+
+	return e.addNode("Conv", inputs,
+		onnxAttrIs("kernel_shape", kernel),
+		onnxAttrIs("strides", expandConvParam(stride, nd)),
+		onnxAttrIs("pads", pads),
+		onnxAttrIs("dilations", expandConvParam(dilation, nd)),
+		onnxAttrI("group", groups),
+	)
+}
+
+// expandConvParam repeats a single value conv parameter for nd spatial dims.
+func expandConvParam(p []int64, nd int) []int64 {
+	if len(p) == nd {
+		return p
+	}
+	retVal := make([]int64, nd)
+	for i := range retVal {
+		retVal[i] = p[0]
+	}
+	return retVal
+}
+
+func (e *onnxExporter) newName(prefix string) string {
+	e.count++
+	return fmt.Sprintf("%v_%v", prefix, e.count)
+}
+
+// addNode adds an operator node with a single output and returns its name.
+func (e *onnxExporter) addNode(opType string, inputs []string, attrs ...[]byte) string {
+	output := e.newName(opType)
+
+	var node []byte
+	for _, in := range inputs {
+		node = pbString(node, 1, in)
+	}
+	node = pbString(node, 2, output)
+	node = pbString(node, 3, output)
+	node = pbString(node, 4, opType)
+	for _, attr := range attrs {
+		node = pbBytes(node, 5, attr)
+	}
+	e.nodes = append(e.nodes, node)
+
+	return output
+}
+
+// addInitializer adds the values of x as a float graph initializer and
+// returns its name.
+func (e *onnxExporter) addInitializer(prefix string, x *ts.Tensor) string {
+	name := e.newName(prefix)
+
+	vals := x.Float64Values()
+	raw := make([]byte, 4*len(vals))
+	for i, v := range vals {
+		binary.LittleEndian.PutUint32(raw[4*i:], math.Float32bits(float32(v)))
+	}
+
+	var t []byte
+	for _, d := range x.MustSize() {
+		t = pbInt(t, 1, d)
+	}
+	t = pbInt(t, 2, onnxFloat)
+	t = pbString(t, 8, name)
+	t = pbBytes(t, 9, raw)
+	e.initializers = append(e.initializers, t)
+
+	return name
+}
+
+// onnxValueInfo encodes a float tensor ValueInfoProto.
+func onnxValueInfo(name string, shape []int64) []byte {
+	var shapeProto []byte
+	for _, d := range shape {
+		shapeProto = pbBytes(shapeProto, 1, pbInt(nil, 1, d))
+	}
+
+	var tensorType []byte
+	tensorType = pbInt(tensorType, 1, onnxFloat)
+	tensorType = pbBytes(tensorType, 2, shapeProto)
+
+	var info []byte
+	info = pbString(info, 1, name)
+	info = pbBytes(info, 2, pbBytes(nil, 1, tensorType))
+	return info
+}
+
+func onnxAttrF(name string, v float64) []byte {
+	var attr []byte
+	attr = pbString(attr, 1, name)
+	attr = pbFixed32(attr, 2, math.Float32bits(float32(v)))
+	attr = pbInt(attr, 20, onnxAttrFloat)
+	return attr
+}
+
+func onnxAttrI(name string, v int64) []byte {
+	var attr []byte
+	attr = pbString(attr, 1, name)
+	attr = pbInt(attr, 3, v)
+	attr = pbInt(attr, 20, onnxAttrInt)
+	return attr
+}
+
+func onnxAttrIs(name string, vs []int64) []byte {
+	var attr []byte
+	attr = pbString(attr, 1, name)
+	for _, v := range vs {
+		attr = pbInt(attr, 8, v)
+	}
+	attr = pbInt(attr, 20, onnxAttrInts)
+	return attr
+}
+
+// Protobuf wire format encoding:
+// ==============================
+
+func pbVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+func pbInt(b []byte, field int, v int64) []byte {
+	b = pbVarint(b, uint64(field)<<3)
+	return pbVarint(b, uint64(v))
+}
+
+func pbFixed32(b []byte, field int, v uint32) []byte {
+	b = pbVarint(b, uint64(field)<<3|5)
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func pbBytes(b []byte, field int, data []byte) []byte {
+	b = pbVarint(b, uint64(field)<<3|2)
+	b = pbVarint(b, uint64(len(data)))
+	return append(b, data...)
+}
+
+func pbString(b []byte, field int, s string) []byte {
+	return pbBytes(b, field, []byte(s))
+}
@@ -0,0 +1,165 @@
+package nn_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// pbField is a decoded protobuf field. Varint and fixed32 values are kept in
+// v, length-delimited ones in data.
+type pbField struct {
+	num  int
+	v    uint64
+	data []byte
+}
+
+func pbVarint(b []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(b) && i < 10; i++ {
+		v |= uint64(b[i]&0x7f) << (7 * uint(i))
+		if b[i] < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("invalid varint")
+}
+
+func pbDecode(b []byte) ([]pbField, error) {
+	var fields []pbField
+	for len(b) > 0 {
+		key, n, err := pbVarint(b)
+		if err != nil {
+			return nil, err
+		}
+		b = b[n:]
+
+		f := pbField{num: int(key >> 3)}
+		switch key & 7 {
+		case 0:
+			if f.v, n, err = pbVarint(b); err != nil {
+				return nil, err
+			}
+		case 2:
+			l, m, err := pbVarint(b)
+			if err != nil {
+				return nil, err
+			}
+			if uint64(len(b)-m) < l {
+				return nil, fmt.Errorf("truncated field %v", f.num)
+			}
+			f.data, n = b[m:m+int(l)], m+int(l)
+		case 5:
+			if len(b) < 4 {
+				return nil, fmt.Errorf("truncated field %v", f.num)
+			}
+			n = 4
+		default:
+			return nil, fmt.Errorf("unexpected wire type %v", key&7)
+		}
+		b = b[n:]
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// pbGet returns the decoded sub-messages of field num.
+func pbGet(t *testing.T, fields []pbField, num int) [][]pbField {
+	var retVal [][]pbField
+	for _, f := range fields {
+		if f.num == num {
+			sub, err := pbDecode(f.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			retVal = append(retVal, sub)
+		}
+	}
+	return retVal
+}
+
+func onnxShape(t *testing.T, valueInfo []pbField) []int64 {
+	tensorType := pbGet(t, pbGet(t, valueInfo, 2)[0], 1)[0]
+	var shape []int64
+	for _, dim := range pbGet(t, pbGet(t, tensorType, 2)[0], 1) {
+		shape = append(shape, int64(dim[0].v))
+	}
+	return shape
+}
+
+func TestExportONNX(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	m := nn.Seq()
+	m.Add(nn.NewLinear(vs.Root().Sub("fc"), 4, 3, nn.DefaultLinearConfig()))
+	m.Add(nn.NewReLU())
+
+	dir, err := ioutil.TempDir("", "gotch-onnx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "model.onnx")
+
+	input := ts.MustRandn([]int64{2, 4}, gotch.Float, gotch.CPU)
+	if err := nn.ExportONNX(m, input, path); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	model, err := pbDecode(data)
+	if err != nil {
+		t.Fatalf("Invalid ONNX model: %v\n", err)
+	}
+	graph := pbGet(t, model, 7)[0]
+
+	var ops []string
+	for _, node := range pbGet(t, graph, 1) {
+		for _, f := range node {
+			if f.num == 4 {
+				ops = append(ops, string(f.data))
+			}
+		}
+	}
+	if want := []string{"MatMul", "Add", "Relu"}; !reflect.DeepEqual(want, ops) {
+		t.Errorf("Expected ops: %v\n", want)
+		t.Errorf("Got ops: %v\n", ops)
+	}
+
+	if want, got := []int64{2, 4}, onnxShape(t, pbGet(t, graph, 11)[0]); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected input shape: %v\n", want)
+		t.Errorf("Got input shape: %v\n", got)
+	}
+	if want, got := []int64{2, 3}, onnxShape(t, pbGet(t, graph, 12)[0]); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+	if want, got := 2, len(pbGet(t, graph, 5)); want != got {
+		t.Errorf("Expected number of initializers: %v\n", want)
+		t.Errorf("Got number of initializers: %v\n", got)
+	}
+}
+
+func TestExportONNXUnsupported(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-onnx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := ts.MustRandn([]int64{2, 4}, gotch.Float, gotch.CPU)
+	err = nn.ExportONNX(nn.NewGELU(), input, filepath.Join(dir, "model.onnx"))
+	if err == nil || !strings.Contains(err.Error(), "*nn.GELU") {
+		t.Errorf("Expected error naming unsupported module *nn.GELU, got: %v\n", err)
+	}
+}
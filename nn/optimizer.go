@@ -3,8 +3,12 @@ package nn
 // Optimizers to be used for gradient-descent based training.
 
 import (
+	"fmt"
 	"log"
+	"math"
+	"unsafe"
 
+	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
 
@@ -14,6 +18,35 @@ type Optimizer struct {
 	// variables            Variables // having embedded sync.Mutex
 	variablesInOptimizer uint8
 	config               interface{}
+	lr                   float64
+	varstore             *VarStore
+	accumulationSteps    int          // number of micro-batches per update, no accumulation if <= 1
+	accumulated          int          // number of micro-batches since the last update
+	groups               []paramGroup // parameter groups added with AddParamGroup
+}
+
+// GroupConfig holds the hyperparameters of a parameter group.
+type GroupConfig struct {
+	LR          float64 // learning rate
+	WeightDecay float64 // weight decay (L2 penalty)
+}
+
+// paramGroup is a parameter group of the optimizer. Its learning rate is kept
+// proportional to the optimizer learning rate.
+type paramGroup struct {
+	lr     float64 // group learning rate when the group was added
+	baseLR float64 // optimizer learning rate when the group was added
+}
+
+// groupLR returns the group learning rate for optimizer learning rate lr. If
+// the optimizer learning rate was 0 when the group was added, there is no
+// ratio to keep and the group keeps its own learning rate.
+func (g paramGroup) groupLR(lr float64) float64 {
+	if g.baseLR == 0 {
+		return g.lr
+	}
+
+	return g.lr * lr / g.baseLR
 }
 
 // OptimizerConfig defines Optimizer configurations. These configs can be used to build optimizer.
@@ -59,6 +92,8 @@ func defaultBuild(config OptimizerConfig, vs *VarStore, lr float64) (retVal *Opt
 		// variables:            vs.Vars,
 		variablesInOptimizer: uint8(len(vs.Vars.TrainableVariables)),
 		config:               config,
+		lr:                   lr,
+		varstore:             vs,
 	}, nil
 }
 
@@ -201,28 +236,195 @@ func (opt *Optimizer) addMissingVariables() {
 }
 
 // ZeroGrad zeroes the gradient for the tensors tracked by this optimizer.
+//
+// NOTE: when accumulating gradients (see `SetAccumulationSteps`), gradients
+// of pending micro-batches are kept.
 func (opt *Optimizer) ZeroGrad() {
 	opt.addMissingVariables()
-	if err := opt.opt.ZeroGrad(); err != nil {
+	if err := opt.zeroGrad(); err != nil {
 		log.Fatalf("Optimizer - ZeroGrad method call error: %v\n", err)
 	}
 }
 
+// zeroGrad zeroes gradients unless micro-batches are pending.
+func (opt *Optimizer) zeroGrad() error {
+	if opt.accumulated > 0 {
+		return nil
+	}
+
+	return opt.opt.ZeroGrad()
+}
+
+// SetAccumulationSteps sets the number of micro-batches over which gradients
+// are accumulated before an update.
+//
+// `Step` then only applies the update every n calls, using the gradients
+// averaged over the n micro-batches, and zeroes the gradients afterward.
+// n = 1 disables accumulation.
+func (opt *Optimizer) SetAccumulationSteps(n int) {
+	if n < 1 {
+		log.Fatalf("Optimizer - SetAccumulationSteps method call error: expected n >= 1, got %v\n", n)
+	}
+	opt.accumulationSteps = n
+	opt.accumulated = 0
+}
+
+// accumulate counts a micro-batch and reports whether the update should be
+// applied. If so, the accumulated gradients are averaged.
+func (opt *Optimizer) accumulate() bool {
+	if opt.accumulationSteps <= 1 {
+		return true
+	}
+
+	opt.accumulated++
+	if opt.accumulated < opt.accumulationSteps {
+		return false
+	}
+	opt.accumulated = 0
+
+	opt.varstore.Vars.mutex.Lock()
+	defer opt.varstore.Vars.mutex.Unlock()
+	ts.NoGrad(func() {
+		for i := range opt.varstore.Vars.TrainableVariables {
+			grad := opt.varstore.Vars.TrainableVariables[i].MustGrad(false)
+			if grad.MustDefined() {
+				grad.MustMul1_(ts.FloatScalar(1.0 / float64(opt.accumulationSteps)))
+			}
+			grad.MustDrop()
+		}
+	})
+
+	return true
+}
+
+// update applies an optimization step if enough micro-batches have been
+// accumulated.
+func (opt *Optimizer) update(clip bool, max float64) error {
+	if !opt.accumulate() {
+		return nil
+	}
+
+	if clip {
+		opt.ClipGradValue(max)
+	}
+
+	if err := opt.opt.Step(); err != nil {
+		return err
+	}
+
+	if opt.accumulationSteps > 1 {
+		return opt.opt.ZeroGrad()
+	}
+
+	return nil
+}
+
 // Clips gradient value at some specified maximum value.
 func (opt *Optimizer) ClipGradValue(max float64) {
+	opt.varstore.Vars.mutex.Lock()
+	defer opt.varstore.Vars.mutex.Unlock()
 
-	// opt.variables.mutex.Lock()
-	// defer opt.variables.mutex.Unlock()
+	ClipGradValue(opt.varstore.Vars.TrainableVariables, max)
+}
 
-	// for _, tensor := range opt.variables.TrainableVariables {
-	// tensor.MustGrad().Clamp_(ts.FloatScalar(-max), ts.FloatScalar(max))
-	// }
+// ClipGradNorm clips the global norm of the gradients of all trainable
+// variables at maxNorm and returns the norm before clipping.
+func (opt *Optimizer) ClipGradNorm(maxNorm, normType float64) float64 {
+	opt.varstore.Vars.mutex.Lock()
+	defer opt.varstore.Vars.mutex.Unlock()
+
+	return ClipGradNorm(opt.varstore.Vars.TrainableVariables, maxNorm, normType)
+}
+
+// ClipGradValue clamps every gradient element of vars into [-clipValue, clipValue] in place.
+//
+// Variables without a gradient are skipped.
+func ClipGradValue(vars []ts.Tensor, clipValue float64) {
+	ts.NoGrad(func() {
+		for i := range vars {
+			grad := vars[i].MustGrad(false)
+			if grad.MustDefined() {
+				grad.MustClamp_(ts.FloatScalar(-clipValue), ts.FloatScalar(clipValue))
+			}
+			grad.MustDrop()
+		}
+	})
+}
+
+// ClipGradNorm rescales the gradients of vars in place so that their global
+// norm is at most maxNorm.
+//
+// The global norm is the normType-norm of all gradients taken together as if
+// concatenated into a single vector; use math.Inf(1) for the max norm. The
+// norm before clipping is returned. Variables without a gradient are skipped.
+//
+// It panics if normType is not positive.
+func ClipGradNorm(vars []ts.Tensor, maxNorm float64, normType float64) (totalNorm float64) {
+	if !(normType > 0) {
+		log.Fatalf("ClipGradNorm() failed: normType should be positive, got %v\n", normType)
+	}
+
+	var grads []*ts.Tensor
+	for i := range vars {
+		grad := vars[i].MustGrad(false)
+		if !grad.MustDefined() {
+			grad.MustDrop()
+			continue
+		}
+		grads = append(grads, grad)
+	}
+
+	if len(grads) == 0 {
+		return 0
+	}
+
+	// NOTE. per-gradient norms are reduced on the device of the first
+	// gradient so that only the total norm is copied back.
+	device := grads[0].MustDevice()
+	norms := make([]ts.Tensor, len(grads))
+	for i, grad := range grads {
+		norm := grad.MustNorm1(ts.FloatScalar(normType), gotch.Double, false)
+		if norm.MustDevice() != device {
+			norm = norm.MustTo(device, true)
+		}
+		norms[i] = *norm
+	}
+	stacked := ts.MustStack(norms, 0)
+	for i := range norms {
+		norms[i].MustDrop()
+	}
+
+	// The global norm is the norm of the per-gradient norms.
+	var total *ts.Tensor
+	if math.IsInf(normType, 1) {
+		total = stacked.MustMax(true)
+	} else {
+		total = stacked.MustNorm1(ts.FloatScalar(normType), gotch.Double, true)
+	}
+	totalNorm = total.Float64Values()[0]
+	total.MustDrop()
+
+	coef := maxNorm / (totalNorm + 1e-6)
+	ts.NoGrad(func() {
+		for _, grad := range grads {
+			if coef < 1 {
+				grad.MustMul1_(ts.FloatScalar(coef))
+			}
+			grad.MustDrop()
+		}
+	})
+
+	return totalNorm
 }
 
 // Step performs an optimization step, updating the tracked tensors based on their gradients.
+//
+// Frozen variables (see `Path.Freeze`) are skipped: their gradients are
+// reset and neither their optimizer state (e.g. Adam moments) nor weight decay
+// are updated.
 func (opt *Optimizer) Step() {
 	opt.addMissingVariables()
-	err := opt.opt.Step()
+	err := opt.update(false, 0)
 	if err != nil {
 		log.Fatalf("Optimizer - Step method call error: %v\n", err)
 	}
@@ -233,14 +435,14 @@ func (opt *Optimizer) BackwardStep(loss *ts.Tensor) {
 
 	opt.addMissingVariables()
 
-	err := opt.opt.ZeroGrad()
+	err := opt.zeroGrad()
 	if err != nil {
 		log.Fatalf("Optimizer - BackwardStep method call - ZeroGrad error: %v\n", err)
 	}
 
 	loss.MustBackward()
 
-	err = opt.opt.Step()
+	err = opt.update(false, 0)
 	if err != nil {
 		log.Fatalf("Optimizer - BackwardStep  method call - Step() error: %v\n", err)
 	}
@@ -253,27 +455,111 @@ func (opt *Optimizer) BackwardStep(loss *ts.Tensor) {
 func (opt *Optimizer) BackwardStepClip(loss *ts.Tensor, max float64) {
 	opt.addMissingVariables()
 
-	err := opt.opt.ZeroGrad()
+	err := opt.zeroGrad()
 	if err != nil {
 		log.Fatalf("Optimizer - BackwardStepClip method call - ZeroGrad error: %v\n", err)
 	}
 
 	loss.MustBackward()
 
-	opt.ClipGradValue(max)
-
-	err = opt.opt.Step()
+	err = opt.update(true, max)
 	if err != nil {
 		log.Fatalf("Optimizer - BackwardStepClip  method call - Step() error: %v\n", err)
 	}
 }
 
+// AddParamGroup moves trainable variables vars of the optimizer var store to
+// a new parameter group with its own hyperparameters, e.g. a lower learning
+// rate for pretrained layers. `Step` then updates each group with its own
+// hyperparameters. Variables not assigned to a group are kept in the default
+// group using the optimizer hyperparameters.
+//
+// NOTE: `SetLR` (e.g. by schedulers) rescales group learning rates, keeping
+// their ratio to the optimizer learning rate. Groups added while the optimizer
+// learning rate is 0 keep their own learning rate.
+func (opt *Optimizer) AddParamGroup(vars []ts.Tensor, cfg GroupConfig) error {
+	if len(vars) == 0 {
+		return fmt.Errorf("Optimizer - AddParamGroup method call error: expected at least one variable\n")
+	}
+	if cfg.LR <= 0 || cfg.WeightDecay < 0 {
+		err := fmt.Errorf("Optimizer - AddParamGroup method call error: expected positive learning rate and non-negative weight decay, got %+v\n", cfg)
+		return err
+	}
+
+	params, err := opt.trainableVars(vars)
+	if err != nil {
+		return fmt.Errorf("Optimizer - AddParamGroup method call error: %v", err)
+	}
+
+	// NOTE: group 0 is the default group.
+	group := uint(len(opt.groups) + 1)
+	if err := opt.opt.SetParameterGroup(params, group); err != nil {
+		return err
+	}
+	if err := opt.opt.SetLearningRateGroup(group, cfg.LR); err != nil {
+		return err
+	}
+	if err := opt.opt.SetWeightDecayGroup(group, cfg.WeightDecay); err != nil {
+		return err
+	}
+
+	opt.groups = append(opt.groups, paramGroup{lr: cfg.LR, baseLR: opt.lr})
+
+	return nil
+}
+
+// trainableVars returns the trainable variables of the optimizer var store
+// sharing data with vars, e.g. the variables themselves, shallow clones or
+// views of them.
+func (opt *Optimizer) trainableVars(vars []ts.Tensor) ([]ts.Tensor, error) {
+	opt.varstore.Vars.mutex.Lock()
+	defer opt.varstore.Vars.mutex.Unlock()
+
+	byDataPtr := make(map[unsafe.Pointer]ts.Tensor, len(opt.varstore.Vars.TrainableVariables))
+	for _, v := range opt.varstore.Vars.TrainableVariables {
+		ptr, err := v.DataPtr()
+		if err != nil {
+			return nil, err
+		}
+		byDataPtr[ptr] = v
+	}
+
+	params := make([]ts.Tensor, len(vars))
+	for i := range vars {
+		ptr, err := vars[i].DataPtr()
+		if err != nil {
+			return nil, err
+		}
+		v, ok := byDataPtr[ptr]
+		if !ok {
+			return nil, fmt.Errorf("variable %v is not a trainable variable of the optimizer var store\n", i)
+		}
+		params[i] = v
+	}
+
+	return params, nil
+}
+
 // SetLR sets the optimizer learning rate.
+//
+// Learning rates of parameter groups are set proportionally (see
+// `AddParamGroup`).
 func (opt *Optimizer) SetLR(lr float64) {
 	err := opt.opt.SetLearningRate(lr)
 	if err != nil {
 		log.Fatalf("Optimizer - SetLR  method call error: %v\n", err)
 	}
+	for i, g := range opt.groups {
+		if err := opt.opt.SetLearningRateGroup(uint(i+1), g.groupLR(lr)); err != nil {
+			log.Fatalf("Optimizer - SetLR  method call error: %v\n", err)
+		}
+	}
+	opt.lr = lr
+}
+
+// GetLR returns the current optimizer learning rate.
+func (opt *Optimizer) GetLR() float64 {
+	return opt.lr
 }
 
 // SetMomentum sets the optimizer momentum.
@@ -283,3 +569,29 @@ func (opt *Optimizer) SetMomentum(m float64) {
 		log.Fatalf("Optimizer - SetMomentum  method call error: %v\n", err)
 	}
 }
+
+// Save saves the optimizer state, e.g. Adam moment buffers and step counts, to
+// a file so that training can be resumed together with the var store saved
+// with `VarStore.Save`.
+func (opt *Optimizer) Save(filepath string) error {
+	if err := opt.opt.Save(filepath); err != nil {
+		return fmt.Errorf("Optimizer - Save method call error: %v", err)
+	}
+
+	return nil
+}
+
+// Load loads the optimizer state saved with `Save`. The optimizer should be
+// built from a var store with the same variables and parameter groups as the
+// saved one.
+//
+// NOTE: learning rates are kept as currently set on the optimizer (see
+// `SetLR`), not restored from the file.
+func (opt *Optimizer) Load(filepath string) error {
+	if err := opt.opt.Load(filepath); err != nil {
+		return fmt.Errorf("Optimizer - Load method call error: %v", err)
+	}
+	opt.SetLR(opt.lr)
+
+	return nil
+}
@@ -1,5 +1,17 @@
 package nn_test
 
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
 /*
  * import (
  *   // "reflect"
@@ -67,3 +79,333 @@ package nn_test
  *     t.Errorf("Expect initial loss < 0.25, got %v", finalLoss)
  *   }
  * } */
+
+func TestSGD(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	x := vs.Root().MustNewVar("x", []int64{1}, nn.NewConstInit(5.0))
+
+	opt, err := nn.NewSGDConfig(0.9, 0.0, 0.0, false).Build(vs, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// minimize quadratic f(x) = (x - 2)^2
+	for i := 0; i < 200; i++ {
+		loss := x.MustSub1(ts.FloatScalar(2.0), false).MustPow(ts.FloatScalar(2.0), true).MustSum(gotch.Float, true)
+		opt.BackwardStep(loss)
+		loss.MustDrop()
+	}
+
+	want := 2.0
+	got := x.Float64Values()[0]
+	if math.Abs(got-want) > 1e-2 {
+		t.Errorf("Expected parameter to converge to: %v\n", want)
+		t.Errorf("Got parameter: %v\n", got)
+	}
+}
+
+func TestAdam(t *testing.T) {
+	// linear regression: y = 0.42 * x + 1.337
+	var data []float32
+	for i := 0; i < 15; i++ {
+		data = append(data, float32(i)/15)
+	}
+	xs := ts.MustOfSlice(data).MustView([]int64{15, 1}, true)
+	ys := xs.MustMul1(ts.FloatScalar(0.42), false).MustAdd1(ts.FloatScalar(1.337), true)
+
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := &nn.LinearConfig{
+		WsInit: nn.NewConstInit(0.0),
+		BsInit: nn.NewConstInit(0.0),
+		Bias:   true,
+	}
+	linear := nn.NewLinear(vs.Root(), 1, 1, cfg)
+
+	opt, err := nn.DefaultAdamConfig().Build(vs, 0.05)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 500; i++ {
+		loss := linear.Forward(xs).MustMseLoss(ys, int64(ts.ReductionMean.ToInt()), true)
+		opt.BackwardStep(loss)
+		loss.MustDrop()
+	}
+
+	loss := linear.Forward(xs).MustMseLoss(ys, int64(ts.ReductionMean.ToInt()), true)
+	finalLoss := loss.Float64Values()[0]
+	if finalLoss > 1e-3 {
+		t.Errorf("Expected final loss < 1e-3, got %v\n", finalLoss)
+	}
+}
+
+func TestAdamBiasCorrection(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	x := vs.Root().MustNewVar("x", []int64{1}, nn.NewConstInit(0.0))
+
+	lr := 0.01
+	opt, err := nn.DefaultAdamConfig().Build(vs, lr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With bias correction, the first step has magnitude of `lr` whatever the
+	// gradient scale is. Without it, the step would be scaled by
+	// (1 - beta1)/sqrt(1 - beta2).
+	loss := x.MustMul1(ts.FloatScalar(1000.0), false).MustSum(gotch.Float, true)
+	opt.BackwardStep(loss)
+
+	got := math.Abs(x.Float64Values()[0])
+	if math.Abs(got-lr) > 1e-5 {
+		t.Errorf("Expected first step magnitude: %v\n", lr)
+		t.Errorf("Got first step magnitude: %v\n", got)
+	}
+}
+
+func TestAdamSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-adam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	vsPath := filepath.Join(dir, "vs.ot")
+	optPath := filepath.Join(dir, "opt.ot")
+
+	build := func() (*nn.VarStore, *ts.Tensor, *nn.Optimizer) {
+		vs := nn.NewVarStore(gotch.CPU)
+		x := vs.Root().MustNewVar("x", []int64{2}, nn.NewConstInit(0.0))
+		opt, err := nn.DefaultAdamConfig().Build(vs, 0.1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return vs, x, opt
+	}
+	step := func(x *ts.Tensor, opt *nn.Optimizer) {
+		loss := x.MustSub1(ts.FloatScalar(3.0), false).MustSquare(true).MustSum(gotch.Float, true)
+		opt.BackwardStep(loss)
+		loss.MustDrop()
+	}
+
+	vs, x, opt := build()
+	for i := 0; i < 3; i++ {
+		step(x, opt)
+	}
+	if err := vs.Save(vsPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := opt.Save(optPath); err != nil {
+		t.Fatal(err)
+	}
+
+	resumedVs, resumedX, resumedOpt := build()
+	if err := resumedVs.Load(vsPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := resumedOpt.Load(optPath); err != nil {
+		t.Fatal(err)
+	}
+
+	// Resumed training continues with the saved moments and step counts.
+	step(x, opt)
+	step(resumedX, resumedOpt)
+
+	want := x.Float64Values()
+	got := resumedX.Float64Values()
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 1e-6 {
+			t.Errorf("Expected parameter: %v\n", want)
+			t.Errorf("Got parameter: %v\n", got)
+			break
+		}
+	}
+}
+
+func clipTestVars() []ts.Tensor {
+	vs := nn.NewVarStore(gotch.CPU)
+	a := vs.Root().MustNewVar("a", []int64{3}, nn.NewConstInit(1.0))
+	b := vs.Root().MustNewVar("b", []int64{4}, nn.NewConstInit(1.0))
+
+	// d(loss)/da = 3, d(loss)/db = 4
+	lossA := a.MustMul1(ts.FloatScalar(3.0), false).MustSum(gotch.Float, true)
+	lossB := b.MustMul1(ts.FloatScalar(4.0), false).MustSum(gotch.Float, true)
+	lossA.MustAdd(lossB, true).MustBackward()
+	lossB.MustDrop()
+
+	return vs.TrainableVariables()
+}
+
+func TestClipGradNorm(t *testing.T) {
+	vars := clipTestVars()
+
+	want := math.Sqrt(3*9 + 4*16)
+	got := nn.ClipGradNorm(vars, 1.0, 2.0)
+	if math.Abs(got-want) > 1e-5 {
+		t.Errorf("Expected total norm: %v\n", want)
+		t.Errorf("Got total norm: %v\n", got)
+	}
+
+	// Norm is below maxNorm now so nothing changes.
+	got = nn.ClipGradNorm(vars, 1.0, 2.0)
+	if math.Abs(got-1.0) > 1e-5 {
+		t.Errorf("Expected clipped total norm: %v\n", 1.0)
+		t.Errorf("Got clipped total norm: %v\n", got)
+	}
+
+	vars = clipTestVars()
+	want = 4.0
+	got = nn.ClipGradNorm(vars, 1.0, math.Inf(1))
+	if math.Abs(got-want) > 1e-5 {
+		t.Errorf("Expected total inf-norm: %v\n", want)
+		t.Errorf("Got total inf-norm: %v\n", got)
+	}
+}
+
+func TestClipGradValue(t *testing.T) {
+	vars := clipTestVars()
+	nn.ClipGradValue(vars, 3.5)
+
+	for _, v := range vars {
+		for _, g := range v.MustGrad(false).Float64Values() {
+			if g > 3.5 || g < -3.5 {
+				t.Errorf("Expected gradient within [-3.5, 3.5], got: %v\n", g)
+			}
+		}
+	}
+	if got := vars[0].MustGrad(false).Float64Values()[0]; got != 3.0 {
+		t.Errorf("Expected unclipped gradient: %v\n", 3.0)
+		t.Errorf("Got unclipped gradient: %v\n", got)
+	}
+}
+
+func TestGradAccumulation(t *testing.T) {
+	xs := ts.MustRandn([]int64{8, 3}, gotch.Float, gotch.CPU)
+	ys := ts.MustRandn([]int64{8, 2}, gotch.Float, gotch.CPU)
+
+	newModel := func() (*nn.VarStore, *nn.Linear) {
+		vs := nn.NewVarStore(gotch.CPU)
+		cfg := &nn.LinearConfig{
+			WsInit: nn.NewConstInit(0.5),
+			BsInit: nn.NewConstInit(0.1),
+			Bias:   true,
+		}
+		return vs, nn.NewLinear(vs.Root(), 3, 2, cfg)
+	}
+
+	// one large batch
+	vs1, l1 := newModel()
+	opt1, err := nn.DefaultSGDConfig().Build(vs1, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt1.BackwardStep(l1.Forward(xs).MustMseLoss(ys, int64(ts.ReductionMean.ToInt()), true))
+
+	// 4 micro-batches of 2 samples
+	vs2, l2 := newModel()
+	opt2, err := nn.DefaultSGDConfig().Build(vs2, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt2.SetAccumulationSteps(4)
+	for i := int64(0); i < 4; i++ {
+		x := xs.MustNarrow(0, 2*i, 2, false)
+		y := ys.MustNarrow(0, 2*i, 2, false)
+		opt2.ZeroGrad()
+		l2.Forward(x).MustMseLoss(y, int64(ts.ReductionMean.ToInt()), true).MustBackward()
+		opt2.Step()
+
+		// no update before the last micro-batch
+		if i < 3 {
+			assertAllClose(t, "pending weight", []float64{0.5, 0.5, 0.5, 0.5, 0.5, 0.5}, l2.Ws.Float64Values())
+		}
+	}
+
+	assertAllClose(t, "weight", l1.Ws.Float64Values(), l2.Ws.Float64Values())
+	assertAllClose(t, "bias", l1.Bs.Float64Values(), l2.Bs.Float64Values())
+
+	// gradients are zeroed after the update
+	grad := l2.Bs.MustGrad(false)
+	assertAllClose(t, "grad after update", []float64{0, 0}, grad.Float64Values())
+}
+
+func TestParamGroups(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	backbone := nn.NewLinear(root.Sub("backbone"), 2, 1, &nn.LinearConfig{WsInit: nn.NewConstInit(0.0), Bias: false})
+	head := root.MustNewVar("head", []int64{2}, nn.NewConstInit(0.0))
+	decayed := root.MustNewVar("decayed", []int64{2}, nn.NewConstInit(1.0))
+
+	opt, err := nn.NewSGDConfig(0.0, 0.0, 0.0, false).Build(vs, 1.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// pretrained layer with a 10x lower learning rate
+	if err := opt.AddParamGroup([]ts.Tensor{*backbone.Ws}, nn.GroupConfig{LR: 0.1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := opt.AddParamGroup([]ts.Tensor{*decayed}, nn.GroupConfig{LR: 1.0, WeightDecay: 0.5}); err != nil {
+		t.Fatal(err)
+	}
+
+	// d(loss)/dw = 1 for backbone and head, 0 for decayed
+	step := func() {
+		loss := backbone.Ws.MustSum(gotch.Float, false).
+			MustAdd(head.MustSum(gotch.Float, false), true).
+			MustAdd(decayed.MustMul1(ts.FloatScalar(0.0), false).MustSum(gotch.Float, true), true)
+		opt.BackwardStep(loss)
+		loss.MustDrop()
+	}
+
+	step()
+	assertAllClose(t, "backbone after step 1", []float64{-0.1, -0.1}, backbone.Ws.Float64Values())
+	assertAllClose(t, "head after step 1", []float64{-1.0, -1.0}, head.Float64Values())
+	assertAllClose(t, "decayed after step 1", []float64{0.5, 0.5}, decayed.Float64Values())
+
+	// group learning rates follow the optimizer learning rate
+	opt.SetLR(0.5)
+	step()
+	assertAllClose(t, "backbone after step 2", []float64{-0.15, -0.15}, backbone.Ws.Float64Values())
+	assertAllClose(t, "head after step 2", []float64{-1.5, -1.5}, head.Float64Values())
+	assertAllClose(t, "decayed after step 2", []float64{0.375, 0.375}, decayed.Float64Values())
+
+	other := ts.MustZeros([]int64{2}, gotch.Float, gotch.CPU)
+	if err := opt.AddParamGroup([]ts.Tensor{*other}, nn.GroupConfig{LR: 0.1}); err == nil {
+		t.Errorf("Expected error for a variable not in the var store, got nil\n")
+	}
+	if err := opt.AddParamGroup([]ts.Tensor{*head}, nn.GroupConfig{LR: -1}); err == nil {
+		t.Errorf("Expected error for a negative learning rate, got nil\n")
+	}
+}
+
+func TestParamGroupsZeroLR(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	frozen := root.MustNewVar("frozen", []int64{2}, nn.NewConstInit(0.0))
+	head := root.MustNewVar("head", []int64{2}, nn.NewConstInit(0.0))
+
+	// default group is not trained
+	opt, err := nn.NewSGDConfig(0.0, 0.0, 0.0, false).Build(vs, 0.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := opt.AddParamGroup([]ts.Tensor{*head}, nn.GroupConfig{LR: 0.1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// d(loss)/dw = 1 for frozen and head
+	step := func() {
+		loss := frozen.MustSum(gotch.Float, false).MustAdd(head.MustSum(gotch.Float, false), true)
+		opt.BackwardStep(loss)
+		loss.MustDrop()
+	}
+
+	step()
+	assertAllClose(t, "frozen after step 1", []float64{0.0, 0.0}, frozen.Float64Values())
+	assertAllClose(t, "head after step 1", []float64{-0.1, -0.1}, head.Float64Values())
+
+	// group keeps its own learning rate
+	opt.SetLR(0.5)
+	step()
+	assertAllClose(t, "frozen after step 2", []float64{-0.5, -0.5}, frozen.Float64Values())
+	assertAllClose(t, "head after step 2", []float64{-0.2, -0.2}, head.Float64Values())
+}
@@ -0,0 +1,73 @@
+package nn
+
+// A pipeline layer placing sub-layers on different devices.
+
+import (
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// PipelineModule chains sub-layers which can live on different devices. During
+// forward pass, activations are moved automatically to the device of the next
+// sub-layer and freed on the source device.
+//
+// It enables running models which are too large for a single device. Each
+// sub-layer should be built from a VarStore on its own device.
+type PipelineModule struct {
+	stages  []ts.ModuleT
+	devices []gotch.Device
+}
+
+// NewPipelineModule creates a new empty pipeline.
+func NewPipelineModule() *PipelineModule {
+	return &PipelineModule{
+		stages:  make([]ts.ModuleT, 0),
+		devices: make([]gotch.Device, 0),
+	}
+}
+
+// Add appends a sub-layer placed on a given device after all the current
+// sub-layers.
+func (p *PipelineModule) Add(m ts.ModuleT, device gotch.Device) {
+	p.stages = append(p.stages, m)
+	p.devices = append(p.devices, device)
+}
+
+// Len returns number of sub-layers embedded in this pipeline.
+func (p *PipelineModule) Len() int64 {
+	return int64(len(p.stages))
+}
+
+// Forward implements Module interface for PipelineModule.
+func (p *PipelineModule) Forward(xs *ts.Tensor) *ts.Tensor {
+	return p.ForwardT(xs, false)
+}
+
+// ForwardT implements ModuleT interface for PipelineModule.
+//
+// The output lives on the device of the last sub-layer.
+func (p *PipelineModule) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	if len(p.stages) == 0 {
+		return xs.MustShallowClone()
+	}
+
+	currTs := xs
+	for i, stage := range p.stages {
+		input := currTs
+		if currTs.MustDevice() != p.devices[i] {
+			input = currTs.MustTo(p.devices[i], false)
+			// free activation on source device
+			if currTs != xs {
+				currTs.MustDrop()
+			}
+		}
+
+		nextTs := stage.ForwardT(input, train)
+		if input != xs {
+			input.MustDrop()
+		}
+		currTs = nextTs
+	}
+
+	return currTs
+}
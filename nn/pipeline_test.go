@@ -0,0 +1,38 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestPipelineModule(t *testing.T) {
+	// simulate 2 devices with 2 var-stores on CPU
+	vs0 := nn.NewVarStore(gotch.CPU)
+	vs1 := nn.NewVarStore(gotch.CPU)
+
+	fc1 := nn.NewLinear(vs0.Root(), 4, 8, nn.DefaultLinearConfig())
+	relu := nn.NewReLU()
+	fc2 := nn.NewLinear(vs1.Root(), 8, 2, nn.DefaultLinearConfig())
+
+	pipeline := nn.NewPipelineModule()
+	pipeline.Add(fc1, vs0.Device())
+	pipeline.Add(relu, vs0.Device())
+	pipeline.Add(fc2, vs1.Device())
+
+	seq := nn.SeqT()
+	seq.Add(fc1)
+	seq.Add(relu)
+	seq.Add(fc2)
+
+	xs := ts.MustRandn([]int64{3, 4}, gotch.Float, gotch.CPU)
+	want := seq.ForwardT(xs, false).Float64Values()
+	got := pipeline.ForwardT(xs, false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected pipeline output: %v\n", want)
+		t.Errorf("Got pipeline output: %v\n", got)
+	}
+}
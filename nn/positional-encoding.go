@@ -0,0 +1,80 @@
+package nn
+
+// Positional encodings for sequence models.
+
+import (
+	"log"
+	"math"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// SinusoidalPositionalEncoding returns the fixed sinusoidal positional
+// encoding table of shape [seqLen, dim] on CPU where:
+//
+//	PE(pos, 2i)   = sin(pos / 10000^(2i/dim))
+//	PE(pos, 2i+1) = cos(pos / 10000^(2i/dim))
+//
+// Ref. https://arxiv.org/abs/1706.03762
+func SinusoidalPositionalEncoding(seqLen, dim int64) *ts.Tensor {
+	data := make([]float32, seqLen*dim)
+	for pos := int64(0); pos < seqLen; pos++ {
+		for i := int64(0); i < dim; i += 2 {
+			angle := float64(pos) / math.Pow(10000, float64(i)/float64(dim))
+			data[pos*dim+i] = float32(math.Sin(angle))
+			if i+1 < dim {
+				data[pos*dim+i+1] = float32(math.Cos(angle))
+			}
+		}
+	}
+
+	return ts.MustOfSlice(data).MustView([]int64{seqLen, dim}, true)
+}
+
+// PositionalEmbedding is a learnable positional encoding, i.e. an embedding
+// table looked up by position index.
+type PositionalEmbedding struct {
+	Embedding *Embedding
+	MaxLen    int64
+}
+
+// NewPositionalEmbedding creates a PositionalEmbedding for sequences of up
+// to maxLen positions.
+func NewPositionalEmbedding(vs *Path, maxLen, dim int64) *PositionalEmbedding {
+	return &PositionalEmbedding{
+		Embedding: NewEmbedding(vs, maxLen, dim, DefaultEmbeddingConfig()),
+		MaxLen:    maxLen,
+	}
+}
+
+// Forward implements Module interface for PositionalEmbedding.
+//
+// Input has shape [batch, seqLen, dim]. The embedding of each position is
+// added to the input.
+func (pe *PositionalEmbedding) Forward(xs *ts.Tensor) *ts.Tensor {
+	size := xs.MustSize()
+	if len(size) != 3 {
+		log.Fatalf("PositionalEmbedding Forward: expected input of shape [batch, seqLen, dim], got %v\n", size)
+	}
+	seqLen := size[1]
+	if seqLen > pe.MaxLen {
+		log.Fatalf("PositionalEmbedding Forward: sequence length %v exceeds max length %v\n", seqLen, pe.MaxLen)
+	}
+
+	positions := ts.MustArange(ts.IntScalar(seqLen), gotch.Int64, xs.MustDevice())
+	emb := pe.Embedding.Forward(positions)
+	positions.MustDrop()
+
+	retVal := xs.MustAdd(emb, false)
+	emb.MustDrop()
+
+	return retVal
+}
+
+// ForwardT implements ModuleT interface for PositionalEmbedding.
+//
+// NOTE: train param will not be used.
+func (pe *PositionalEmbedding) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	return pe.Forward(xs)
+}
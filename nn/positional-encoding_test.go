@@ -0,0 +1,54 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestSinusoidalPositionalEncoding(t *testing.T) {
+	pe := nn.SinusoidalPositionalEncoding(50, 6)
+
+	if want, got := []int64{50, 6}, pe.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected encoding shape: %v\n", want)
+		t.Errorf("Got encoding shape: %v\n", got)
+	}
+
+	// position 0: sin(0) = 0, cos(0) = 1
+	assertAllClose(t, "position 0", []float64{0, 1, 0, 1, 0, 1}, pe.MustSelect(0, 0, false).Float64Values())
+
+	// position 1, dim 6: frequencies 1, 1/10000^(2/6), 1/10000^(4/6)
+	want := []float64{
+		math.Sin(1), math.Cos(1),
+		math.Sin(1 / math.Pow(10000, 2.0/6)), math.Cos(1 / math.Pow(10000, 2.0/6)),
+		math.Sin(1 / math.Pow(10000, 4.0/6)), math.Cos(1 / math.Pow(10000, 4.0/6)),
+	}
+	assertAllClose(t, "position 1", want, pe.MustSelect(0, 1, false).Float64Values())
+
+	// position 42, frequency index 1
+	got := pe.MustSelect(0, 42, false).Float64Values()
+	angle := 42 / math.Pow(10000, 2.0/6)
+	assertAllClose(t, "position 42", []float64{math.Sin(angle), math.Cos(angle)}, got[2:4])
+}
+
+func TestPositionalEmbedding(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	pe := nn.NewPositionalEmbedding(vs.Root(), 10, 4)
+
+	xs := ts.MustZeros([]int64{2, 3, 4}, gotch.Float, gotch.CPU)
+	out := pe.Forward(xs)
+	if want, got := []int64{2, 3, 4}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	// zero input gives the first 3 rows of the table for each batch item
+	rows := pe.Embedding.Ws.MustNarrow(0, 0, 3, false).Float64Values()
+	vals := out.Float64Values()
+	assertAllClose(t, "batch 0", rows, vals[:12])
+	assertAllClose(t, "batch 1", rows, vals[12:])
+}
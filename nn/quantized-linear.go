@@ -0,0 +1,89 @@
+package nn
+
+// A linear layer with int8 quantized weights.
+
+import (
+	"log"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// QuantizedLinear is a linear fully-connected layer for inference with its
+// weight quantized to QInt8 per tensor.
+//
+// Forward runs libtorch's FBGEMM int8 linear kernel: inputs are dynamically
+// quantized to uint8 on every forward pass and multiplied with the prepacked
+// int8 weight, accumulating in int32.
+//
+// NOTE: FBGEMM kernels run on x86 CPUs with AVX2 support only and require
+// libtorch to be built with FBGEMM (the default for x86 builds).
+type QuantizedLinear struct {
+	Ws *ts.Tensor // QInt8 weight of shape [outDim, inDim]
+	Bs *ts.Tensor // float bias of shape [outDim]
+
+	wInt8      *ts.Tensor // int8 representation of Ws
+	packed     *ts.Tensor // Ws prepacked for FBGEMM
+	colOffsets *ts.Tensor // per output feature sums of wInt8 minus zero point
+	scale      *ts.Scalar
+	zeroPoint  *ts.Scalar
+}
+
+// NewQuantizedLinear creates a QuantizedLinear layer from a trained Linear
+// layer, quantizing its weight with per-tensor affine parameters chosen from
+// the weight range.
+func NewQuantizedLinear(l *Linear) *QuantizedLinear {
+	ql := new(QuantizedLinear)
+	ts.NoGrad(func() {
+		w := l.Ws.MustDetach(false).MustTotype(gotch.Float, true).MustContiguous(true)
+		scale, zeroPoint, err := ts.ChooseQParams(w, gotch.QInt8)
+		if err != nil {
+			log.Fatalf("NewQuantizedLinear() failed: %v\n", err)
+		}
+		ql.Ws = ts.MustQuantize(w, scale, zeroPoint, gotch.QInt8)
+		w.MustDrop()
+		ql.scale = ts.FloatScalar(scale)
+		ql.zeroPoint = ts.IntScalar(zeroPoint)
+
+		ql.wInt8 = ql.Ws.MustIntRepr(false)
+		ql.packed = ts.MustFbgemmPackQuantizedMatrix(ql.wInt8)
+
+		// colOffsets[n] = sum_k(wInt8[n, k] - zeroPoint)
+		inDim := ql.Ws.MustSize()[1]
+		ql.colOffsets = ql.wInt8.MustSum1([]int64{1}, false, gotch.Int, false).MustSub1(ts.IntScalar(zeroPoint*inDim), true)
+
+		// FBGEMM kernel expects a float bias.
+		outDim := ql.Ws.MustSize()[0]
+		if l.hasBias() {
+			ql.Bs = l.Bs.MustDetach(false).MustTotype(gotch.Float, true)
+		} else {
+			ql.Bs = ts.MustZeros([]int64{outDim}, gotch.Float, gotch.CPU)
+		}
+	})
+
+	return ql
+}
+
+// Forward implements Module interface for QuantizedLinear.
+//
+// Input can have any number of leading dimensions, the last one being inDim.
+// The output has the dtype of the input.
+func (ql *QuantizedLinear) Forward(xs *ts.Tensor) *ts.Tensor {
+	dtype := xs.DType()
+	x := xs.MustTotype(gotch.Float, false)
+	retVal := ts.MustFbgemmLinearInt8WeightFp32Activation(x, ql.wInt8, ql.packed, ql.colOffsets, ql.scale, ql.zeroPoint, ql.Bs)
+	x.MustDrop()
+
+	if dtype == gotch.Float {
+		return retVal
+	}
+
+	return retVal.MustTotype(dtype, true)
+}
+
+// ForwardT implements ModuleT interface for QuantizedLinear.
+//
+// NOTE: train param will not be used.
+func (ql *QuantizedLinear) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	return ql.Forward(xs)
+}
@@ -0,0 +1,73 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestQuantizedLinear(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	linear := nn.NewLinear(vs.Root(), 16, 8, nn.DefaultLinearConfig())
+	qlinear := nn.NewQuantizedLinear(linear)
+
+	if want, got := gotch.QInt8, qlinear.Ws.DType(); want != got {
+		t.Errorf("Expected weight dtype: %v\n", want)
+		t.Errorf("Got weight dtype: %v\n", got)
+	}
+	if want, got := []int64{8, 16}, qlinear.Ws.MustSize(); got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Expected weight shape: %v\n", want)
+		t.Errorf("Got weight shape: %v\n", got)
+	}
+
+	xs := ts.MustRandn([]int64{4, 16}, gotch.Float, gotch.CPU)
+	var want, got []float64
+	ts.NoGrad(func() {
+		want = linear.Forward(xs).Float64Values()
+		got = qlinear.Forward(xs).Float64Values()
+	})
+
+	if len(want) != len(got) {
+		t.Fatalf("Expected %v outputs, got %v\n", len(want), len(got))
+	}
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 0.05 {
+			t.Errorf("Expected quantized output close to: %v\n", want)
+			t.Errorf("Got quantized output: %v\n", got)
+			break
+		}
+	}
+
+	// leading dims are kept
+	out := qlinear.Forward(ts.MustRandn([]int64{2, 3, 16}, gotch.Float, gotch.CPU))
+	if want, got := []int64{2, 3, 8}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
+
+func TestQuantizedLinearNoBias(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	cfg := nn.DefaultLinearConfig()
+	cfg.Bias = false
+	linear := nn.NewLinear(vs.Root(), 16, 8, cfg)
+	qlinear := nn.NewQuantizedLinear(linear)
+
+	xs := ts.MustRandn([]int64{4, 16}, gotch.Float, gotch.CPU)
+	var want, got []float64
+	ts.NoGrad(func() {
+		want = linear.Forward(xs).Float64Values()
+		got = qlinear.Forward(xs).Float64Values()
+	})
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 0.05 {
+			t.Errorf("Expected quantized output close to: %v\n", want)
+			t.Errorf("Got quantized output: %v\n", got)
+			break
+		}
+	}
+}
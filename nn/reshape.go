@@ -0,0 +1,58 @@
+package nn
+
+// Stateless reshaping layers.
+
+import (
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Flatten flattens a contiguous range of dims, from `StartDim` to `EndDim`
+// (inclusive), into a single dim.
+type Flatten struct {
+	StartDim int64
+	EndDim   int64
+}
+
+// NewFlatten creates a Flatten layer. Negative dims count from the last one.
+//
+// NOTE: `NewFlatten(1, -1)` flattens all dims after the batch dim, e.g. to
+// feed conv features of shape [N, C, H, W] to a Linear layer as [N, C*H*W].
+func NewFlatten(startDim, endDim int64) *Flatten {
+	return &Flatten{StartDim: startDim, EndDim: endDim}
+}
+
+// Forward implements Module interface for Flatten.
+func (f *Flatten) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustFlatten(f.StartDim, f.EndDim, false)
+}
+
+// ForwardT implements ModuleT interface for Flatten.
+//
+// NOTE: train param will not be used.
+func (f *Flatten) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return f.Forward(xs)
+}
+
+// Reshape reshapes its input to `Shape`. One dim can be -1 to be inferred
+// from the number of elements, e.g. [-1, 28, 28] keeps a batch dim of any
+// size.
+type Reshape struct {
+	Shape []int64
+}
+
+// NewReshape creates a Reshape layer.
+func NewReshape(shape []int64) *Reshape {
+	return &Reshape{Shape: shape}
+}
+
+// Forward implements Module interface for Reshape.
+func (r *Reshape) Forward(xs *ts.Tensor) (retVal *ts.Tensor) {
+	return xs.MustReshape(r.Shape, false)
+}
+
+// ForwardT implements ModuleT interface for Reshape.
+//
+// NOTE: train param will not be used.
+func (r *Reshape) ForwardT(xs *ts.Tensor, train bool) (retVal *ts.Tensor) {
+	return r.Forward(xs)
+}
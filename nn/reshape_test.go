@@ -0,0 +1,65 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestFlattenSequential(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	// [N, 1, 8, 8] -> conv [N, 4, 6, 6] -> pool [N, 4, 3, 3] -> flatten [N, 36]
+	seq := nn.Seq()
+	seq.Add(nn.NewConv2D(path.Sub("conv"), 1, 4, 3, nn.DefaultConv2DConfig()))
+	seq.Add(nn.NewReLU())
+	seq.Add(nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor {
+		return xs.MustMaxPool2d([]int64{2, 2}, []int64{2, 2}, []int64{0, 0}, []int64{1, 1}, false, false)
+	}))
+	seq.Add(nn.NewFlatten(1, -1))
+	seq.Add(nn.NewLinear(path.Sub("fc"), 36, 10, nn.DefaultLinearConfig()))
+
+	input := ts.MustRandn([]int64{5, 1, 8, 8}, gotch.Float, gotch.CPU)
+	output := seq.Forward(input)
+	if want, got := []int64{5, 10}, output.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	input.MustDrop()
+	output.MustDrop()
+}
+
+func TestFlattenReshape(t *testing.T) {
+	xs := ts.MustArange(ts.IntScalar(24), gotch.Float, gotch.CPU).MustView([]int64{2, 3, 2, 2}, true)
+
+	for _, tc := range []struct {
+		name string
+		m    ts.Module
+		want []int64
+	}{
+		{"flatten(1, -1)", nn.NewFlatten(1, -1), []int64{2, 12}},
+		{"flatten(0, 1)", nn.NewFlatten(0, 1), []int64{6, 2, 2}},
+		{"flatten(2, 3)", nn.NewFlatten(2, 3), []int64{2, 3, 4}},
+		{"reshape([-1, 4])", nn.NewReshape([]int64{-1, 4}), []int64{6, 4}},
+		{"reshape([4, 3, 2])", nn.NewReshape([]int64{4, 3, 2}), []int64{4, 3, 2}},
+	} {
+		out := tc.m.Forward(xs)
+		if got := out.MustSize(); !reflect.DeepEqual(tc.want, got) {
+			t.Errorf("Expected %v shape: %v\n", tc.name, tc.want)
+			t.Errorf("Got %v shape: %v\n", tc.name, got)
+		}
+		// values are kept in order
+		if want, got := xs.Float64Values(), out.Float64Values(); !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected %v values: %v\n", tc.name, want)
+			t.Errorf("Got %v values: %v\n", tc.name, got)
+		}
+		out.MustDrop()
+	}
+
+	xs.MustDrop()
+}
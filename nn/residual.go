@@ -0,0 +1,65 @@
+package nn
+
+// A residual (skip) connection around a layer.
+
+import (
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// Residual wraps an inner layer with a skip connection:
+//
+//	y = inner(x) + x
+//
+// If Projection is not nil, it is applied to the skip path, i.e.
+// `y = inner(x) + projection(x)`, e.g. a 1x1 convolution when the inner layer
+// changes the number of channels.
+type Residual struct {
+	Inner      ts.ModuleT
+	Projection ts.ModuleT
+}
+
+// NewResidual creates a Residual layer around inner. projection can be nil for
+// an identity skip path.
+func NewResidual(inner, projection ts.ModuleT) *Residual {
+	return &Residual{Inner: inner, Projection: projection}
+}
+
+// addable returns true if tensors of shape a and b can be added with
+// broadcasting.
+func addable(a, b []int64) bool {
+	for i, j := len(a)-1, len(b)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if a[i] != b[j] && a[i] != 1 && b[j] != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// ForwardT implements ModuleT interface for Residual.
+func (r *Residual) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	out := r.Inner.ForwardT(xs, train)
+
+	skip := xs
+	if r.Projection != nil {
+		skip = r.Projection.ForwardT(xs, train)
+		defer skip.MustDrop()
+	}
+
+	if !addable(out.MustSize(), skip.MustSize()) {
+		if r.Projection == nil {
+			log.Fatalf("Residual ForwardT: inner output of shape %v cannot be added to input of shape %v. A projection is needed.\n", out.MustSize(), skip.MustSize())
+		}
+		log.Fatalf("Residual ForwardT: inner output of shape %v cannot be added to projected input of shape %v\n", out.MustSize(), skip.MustSize())
+	}
+
+	return out.MustAdd(skip, true)
+}
+
+// Forward implements Module interface for Residual.
+//
+// NOTE: layers are run in evaluation mode (train = false).
+func (r *Residual) Forward(xs *ts.Tensor) *ts.Tensor {
+	return r.ForwardT(xs, false)
+}
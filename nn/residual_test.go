@@ -0,0 +1,67 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestResidualIdentity(t *testing.T) {
+	identity := nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor {
+		return xs.MustShallowClone()
+	})
+	res := nn.NewResidual(identity, nil)
+
+	xs := ts.MustRandn([]int64{2, 3, 4}, gotch.Float, gotch.CPU)
+	out := res.ForwardT(xs, true)
+
+	if want, got := xs.MustSize(), out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+	want := xs.MustMul1(ts.FloatScalar(2), false)
+	assertAllClose(t, "residual", want.Float64Values(), out.Float64Values())
+
+	xs.MustDrop()
+	out.MustDrop()
+	want.MustDrop()
+}
+
+func TestResidualProjection(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	// 4 -> 8 channels
+	cfg := nn.DefaultConv2DConfig()
+	cfg.Padding = []int64{1, 1}
+	inner := nn.SeqT()
+	inner.Add(nn.NewConv2D(path.Sub("conv1"), 4, 8, 3, cfg))
+	inner.AddFn(nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor { return xs.MustRelu(false) }))
+	inner.Add(nn.NewConv2D(path.Sub("conv2"), 8, 8, 3, cfg))
+	proj := nn.NewConv2D(path.Sub("proj"), 4, 8, 1, nn.DefaultConv2DConfig())
+
+	net := nn.SeqT()
+	net.Add(nn.NewResidual(inner, proj))
+	net.AddFn(nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor { return xs.MustRelu(false) }))
+
+	xs := ts.MustRandn([]int64{2, 4, 5, 5}, gotch.Float, gotch.CPU)
+	out := net.ForwardT(xs, true)
+	if want, got := []int64{2, 8, 5, 5}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	// residual output is the sum of both paths
+	innerOut := inner.ForwardT(xs, true)
+	projOut := proj.ForwardT(xs, true)
+	want := innerOut.MustAdd(projOut, true).MustRelu(true)
+	assertAllClose(t, "residual", want.Float64Values(), out.Float64Values())
+
+	xs.MustDrop()
+	out.MustDrop()
+	projOut.MustDrop()
+	want.MustDrop()
+}
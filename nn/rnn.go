@@ -1,6 +1,8 @@
 package nn
 
 import (
+	"log"
+
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
@@ -45,8 +47,8 @@ func (ls *LSTMState) C() *ts.Tensor {
 	return ls.Tensor2.MustShallowClone()
 }
 
-// The GRU and LSTM layers share the same config.
-// Configuration for the GRU and LSTM layers.
+// The GRU, LSTM and VanillaRNN layers share the same config.
+// Configuration for the GRU, LSTM and VanillaRNN layers.
 type RNNConfig struct {
 	HasBiases     bool
 	NumLayers     int64
@@ -54,6 +56,7 @@ type RNNConfig struct {
 	Train         bool
 	Bidirectional bool
 	BatchFirst    bool
+	Nonlinearity  string // "tanh" or "relu". Only used by VanillaRNN.
 }
 
 // Default creates default RNN configuration
@@ -65,6 +68,7 @@ func DefaultRNNConfig() *RNNConfig {
 		Train:         true,
 		Bidirectional: false,
 		BatchFirst:    true,
+		Nonlinearity:  "tanh",
 	}
 }
 
@@ -287,3 +291,141 @@ func (g *GRU) SeqInit(input *ts.Tensor, inState State) (*ts.Tensor, State) {
 
 	return output, &GRUState{Tensor: h}
 }
+
+// RNNState is a VanillaRNN state. It contains a single tensor.
+type RNNState struct {
+	Tensor *ts.Tensor
+}
+
+func (rs *RNNState) Value() *ts.Tensor {
+	return rs.Tensor
+}
+
+// A vanilla (Elman) recurrent neural network layer with tanh or ReLU
+// nonlinearity: h_t = f(W_ih x_t + b_ih + W_hh h_(t-1) + b_hh).
+//
+// https://en.wikipedia.org/wiki/Recurrent_neural_network#Elman_networks_and_Jordan_networks
+type VanillaRNN struct {
+	flatWeights []ts.Tensor
+	hiddenDim   int64
+	config      *RNNConfig
+	device      gotch.Device
+}
+
+// NewVanillaRNN creates a new vanilla RNN layer.
+func NewVanillaRNN(vs *Path, inDim, hiddenDim int64, cfg *RNNConfig) *VanillaRNN {
+	switch cfg.Nonlinearity {
+	case "", "tanh", "relu":
+	default:
+		log.Fatalf("NewVanillaRNN method call: unsupported nonlinearity %q, expected \"tanh\" or \"relu\"\n", cfg.Nonlinearity)
+	}
+
+	var numDirections int64 = 1
+	if cfg.Bidirectional {
+		numDirections = 2
+	}
+
+	flatWeights := make([]ts.Tensor, 0)
+	for i := 0; i < int(cfg.NumLayers); i++ {
+		for n := 0; n < int(numDirections); n++ {
+			var inputDim int64
+			if i == 0 {
+				inputDim = inDim
+			} else {
+				inputDim = hiddenDim * numDirections
+			}
+
+			wIh := vs.KaimingUniform("w_ih", []int64{hiddenDim, inputDim})
+			wHh := vs.KaimingUniform("w_hh", []int64{hiddenDim, hiddenDim})
+			flatWeights = append(flatWeights, *wIh, *wHh)
+
+			// NOTE. the fused op expects biases only if `HasBiases`.
+			if cfg.HasBiases {
+				bIh := vs.Zeros("b_ih", []int64{hiddenDim})
+				bHh := vs.Zeros("b_hh", []int64{hiddenDim})
+				flatWeights = append(flatWeights, *bIh, *bHh)
+			}
+		}
+	}
+
+	return &VanillaRNN{
+		flatWeights: flatWeights,
+		hiddenDim:   hiddenDim,
+		config:      cfg,
+		device:      vs.Device(),
+	}
+}
+
+// Implement RNN interface for VanillaRNN:
+// =======================================
+
+func (r *VanillaRNN) numDirections() int64 {
+	if r.config.Bidirectional {
+		return 2
+	}
+	return 1
+}
+
+func (r *VanillaRNN) ZeroState(batchDim int64) State {
+	layerDim := r.config.NumLayers * r.numDirections()
+	shape := []int64{layerDim, batchDim, r.hiddenDim}
+
+	tensor := ts.MustZeros(shape, gotch.Float, r.device)
+
+	return &RNNState{Tensor: tensor}
+}
+
+func (r *VanillaRNN) Step(input *ts.Tensor, inState State) State {
+	unsqueezedInput := input.MustUnsqueeze(1, false)
+	output, state := r.SeqInit(unsqueezedInput, inState)
+
+	// NOTE: though we won't use `output`, it is a Ctensor created in C land, so
+	// it should be cleaned up here to prevent memory hold-up.
+	output.MustDrop()
+	unsqueezedInput.MustDrop()
+
+	return state
+}
+
+func (r *VanillaRNN) Seq(input *ts.Tensor) (*ts.Tensor, State) {
+	batchDim := input.MustSize()[0]
+	if !r.config.BatchFirst {
+		batchDim = input.MustSize()[1]
+	}
+	inState := r.ZeroState(batchDim)
+
+	output, state := r.SeqInit(input, inState)
+
+	// Delete intermediate tensors in inState
+	inState.(*RNNState).Tensor.MustDrop()
+
+	return output, state
+}
+
+func (r *VanillaRNN) SeqInit(input *ts.Tensor, inState State) (*ts.Tensor, State) {
+	h0 := inState.(*RNNState).Tensor
+
+	timeDim := 0
+	if r.config.BatchFirst {
+		timeDim = 1
+	}
+	shape := input.MustSize()
+	if shape[timeDim] == 0 {
+		// NOTE. empty sequence: the output is empty and the state is a copy
+		// of the initial state so that both can be dropped independently of
+		// the inputs.
+		shape[len(shape)-1] = r.hiddenDim * r.numDirections()
+		output := ts.MustZeros(shape, input.DType(), input.MustDevice())
+
+		return output, &RNNState{Tensor: h0.MustClone()}
+	}
+
+	var output, h *ts.Tensor
+	if r.config.Nonlinearity == "relu" {
+		output, h = input.MustRnnRelu(h0, r.flatWeights, r.config.HasBiases, r.config.NumLayers, r.config.Dropout, r.config.Train, r.config.Bidirectional, r.config.BatchFirst)
+	} else {
+		output, h = input.MustRnnTanh(h0, r.flatWeights, r.config.HasBiases, r.config.NumLayers, r.config.Dropout, r.config.Train, r.config.Bidirectional, r.config.BatchFirst)
+	}
+
+	return output, &RNNState{Tensor: h}
+}
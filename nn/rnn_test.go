@@ -147,3 +147,133 @@ func TestLSTM(t *testing.T) {
 	cfg.Bidirectional = true
 	lstmTest(cfg, t)
 }
+
+func TestVanillaRNN(t *testing.T) {
+	var (
+		batchDim  int64 = 5
+		seqLen    int64 = 3
+		inputDim  int64 = 2
+		hiddenDim int64 = 4
+	)
+
+	for _, nonlinearity := range []string{"tanh", "relu"} {
+		cfg := nn.DefaultRNNConfig()
+		cfg.Nonlinearity = nonlinearity
+		cfg.NumLayers = 2
+		cfg.Bidirectional = true
+
+		vs := nn.NewVarStore(gotch.CPU)
+		rnn := nn.NewVanillaRNN(vs.Root(), inputDim, hiddenDim, cfg)
+		if got := len(vs.TrainableVariables()); got != 16 {
+			t.Errorf("Expected 16 trainable variables, got %v\n", got)
+		}
+
+		input := ts.MustRandn([]int64{batchDim, seqLen, inputDim}, gotch.Float, gotch.CPU)
+		output, state := rnn.Seq(input)
+
+		wantSeq := []int64{batchDim, seqLen, hiddenDim * 2}
+		if gotSeq := output.MustSize(); !reflect.DeepEqual(wantSeq, gotSeq) {
+			t.Errorf("Expected %v output shape: %v\n", nonlinearity, wantSeq)
+			t.Errorf("Got %v output shape: %v\n", nonlinearity, gotSeq)
+		}
+		wantState := []int64{4, batchDim, hiddenDim}
+		if gotState := state.(*nn.RNNState).Tensor.MustSize(); !reflect.DeepEqual(wantState, gotState) {
+			t.Errorf("Expected %v state shape: %v\n", nonlinearity, wantState)
+			t.Errorf("Got %v state shape: %v\n", nonlinearity, gotState)
+		}
+	}
+}
+
+func TestVanillaRNNStep(t *testing.T) {
+	var (
+		batchDim  int64 = 3
+		inputDim  int64 = 2
+		hiddenDim int64 = 4
+	)
+
+	vs := nn.NewVarStore(gotch.CPU)
+	rnn := nn.NewVanillaRNN(vs.Root(), inputDim, hiddenDim, nn.DefaultRNNConfig())
+
+	x0 := ts.MustRandn([]int64{batchDim, inputDim}, gotch.Float, gotch.CPU)
+	x1 := ts.MustRandn([]int64{batchDim, inputDim}, gotch.Float, gotch.CPU)
+
+	s1 := rnn.Step(x0, rnn.ZeroState(batchDim))
+	s2 := rnn.Step(x1, s1)
+
+	// state carries across steps: same as running the whole sequence
+	_, seqState := rnn.Seq(ts.MustStack([]ts.Tensor{*x0, *x1}, 1))
+	assertAllClose(t, "state after 2 steps", seqState.(*nn.RNNState).Tensor.Float64Values(), s2.(*nn.RNNState).Tensor.Float64Values())
+
+	fresh := rnn.Step(x1, rnn.ZeroState(batchDim))
+	if reflect.DeepEqual(fresh.(*nn.RNNState).Tensor.Float64Values(), s2.(*nn.RNNState).Tensor.Float64Values()) {
+		t.Errorf("Expected state after 2 steps to depend on the first step\n")
+	}
+}
+
+func TestVanillaRNNEmptySeq(t *testing.T) {
+	var (
+		batchDim  int64 = 3
+		inputDim  int64 = 2
+		hiddenDim int64 = 4
+	)
+
+	vs := nn.NewVarStore(gotch.CPU)
+	rnn := nn.NewVanillaRNN(vs.Root(), inputDim, hiddenDim, nn.DefaultRNNConfig())
+
+	input := ts.MustZeros([]int64{batchDim, 0, inputDim}, gotch.Float, gotch.CPU)
+	inState := rnn.ZeroState(batchDim)
+	output, state := rnn.SeqInit(input, inState)
+
+	wantSeq := []int64{batchDim, 0, hiddenDim}
+	if gotSeq := output.MustSize(); !reflect.DeepEqual(wantSeq, gotSeq) {
+		t.Errorf("Expected output shape: %v\n", wantSeq)
+		t.Errorf("Got output shape: %v\n", gotSeq)
+	}
+
+	// the returned state does not alias the initial state
+	inState.(*nn.RNNState).Tensor.MustFill_(ts.FloatScalar(1.0))
+	want := make([]float64, batchDim*hiddenDim)
+	if got := state.(*nn.RNNState).Tensor.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected state: %v\n", want)
+		t.Errorf("Got state: %v\n", got)
+	}
+}
+
+func TestVanillaRNNNoBias(t *testing.T) {
+	cfg := nn.DefaultRNNConfig()
+	cfg.HasBiases = false
+
+	vs := nn.NewVarStore(gotch.CPU)
+	rnn := nn.NewVanillaRNN(vs.Root(), 2, 4, cfg)
+	if got := len(vs.TrainableVariables()); got != 2 {
+		t.Errorf("Expected 2 trainable variables, got %v\n", got)
+	}
+
+	output, _ := rnn.Seq(ts.MustRandn([]int64{3, 5, 2}, gotch.Float, gotch.CPU))
+	want := []int64{3, 5, 4}
+	if got := output.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
+
+func TestLSTMStepState(t *testing.T) {
+	var (
+		batchDim  int64 = 3
+		inputDim  int64 = 2
+		hiddenDim int64 = 4
+	)
+
+	vs := nn.NewVarStore(gotch.CPU)
+	lstm := nn.NewLSTM(vs.Root(), inputDim, hiddenDim, nn.DefaultRNNConfig())
+
+	x0 := ts.MustRandn([]int64{batchDim, inputDim}, gotch.Float, gotch.CPU)
+	x1 := ts.MustRandn([]int64{batchDim, inputDim}, gotch.Float, gotch.CPU)
+
+	s1 := lstm.Step(x0, lstm.ZeroState(batchDim))
+	s2 := lstm.Step(x1, s1).(*nn.LSTMState)
+
+	_, seqState := lstm.Seq(ts.MustStack([]ts.Tensor{*x0, *x1}, 1))
+	assertAllClose(t, "hidden state after 2 steps", seqState.(*nn.LSTMState).H().Float64Values(), s2.H().Float64Values())
+	assertAllClose(t, "cell state after 2 steps", seqState.(*nn.LSTMState).C().Float64Values(), s2.C().Float64Values())
+}
@@ -0,0 +1,147 @@
+package nn
+
+// Learning rate schedulers.
+
+import (
+	"log"
+	"math"
+)
+
+// Scheduler adjusts the learning rate of an optimizer. `Step` should be called
+// once per epoch (or per iteration, depending on the schedule).
+type Scheduler interface {
+	Step()
+}
+
+// StepLR decays the learning rate by `Gamma` every `StepSize` steps.
+type StepLR struct {
+	opt      *Optimizer
+	baseLR   float64
+	stepIdx  int
+	StepSize int
+	Gamma    float64
+}
+
+// NewStepLR creates a StepLR scheduler starting from the current optimizer
+// learning rate.
+//
+// stepSize must be positive.
+func NewStepLR(opt *Optimizer, stepSize int, gamma float64) *StepLR {
+	if stepSize <= 0 {
+		log.Fatalf("NewStepLR method call: stepSize should be positive. Got %v\n", stepSize)
+	}
+
+	return &StepLR{
+		opt:      opt,
+		baseLR:   opt.GetLR(),
+		stepIdx:  0,
+		StepSize: stepSize,
+		Gamma:    gamma,
+	}
+}
+
+// Step implements Scheduler interface for StepLR.
+func (s *StepLR) Step() {
+	s.stepIdx += 1
+	lr := s.baseLR * math.Pow(s.Gamma, float64(s.stepIdx/s.StepSize))
+	s.opt.SetLR(lr)
+}
+
+// ExponentialLR decays the learning rate by `Gamma` every step.
+type ExponentialLR struct {
+	opt     *Optimizer
+	baseLR  float64
+	stepIdx int
+	Gamma   float64
+}
+
+// NewExponentialLR creates an ExponentialLR scheduler starting from the current
+// optimizer learning rate.
+func NewExponentialLR(opt *Optimizer, gamma float64) *ExponentialLR {
+	return &ExponentialLR{
+		opt:     opt,
+		baseLR:  opt.GetLR(),
+		stepIdx: 0,
+		Gamma:   gamma,
+	}
+}
+
+// Step implements Scheduler interface for ExponentialLR.
+func (s *ExponentialLR) Step() {
+	s.stepIdx += 1
+	lr := s.baseLR * math.Pow(s.Gamma, float64(s.stepIdx))
+	s.opt.SetLR(lr)
+}
+
+// CosineAnnealingLR anneals the learning rate from the initial value down to
+// `EtaMin` following a cosine curve over `TMax` steps.
+type CosineAnnealingLR struct {
+	opt     *Optimizer
+	baseLR  float64
+	stepIdx int
+	TMax    int
+	EtaMin  float64
+}
+
+// NewCosineAnnealingLR creates a CosineAnnealingLR scheduler starting from the
+// current optimizer learning rate.
+//
+// tMax must be positive.
+func NewCosineAnnealingLR(opt *Optimizer, tMax int, etaMin float64) *CosineAnnealingLR {
+	if tMax <= 0 {
+		log.Fatalf("NewCosineAnnealingLR method call: tMax should be positive. Got %v\n", tMax)
+	}
+
+	return &CosineAnnealingLR{
+		opt:     opt,
+		baseLR:  opt.GetLR(),
+		stepIdx: 0,
+		TMax:    tMax,
+		EtaMin:  etaMin,
+	}
+}
+
+// Step implements Scheduler interface for CosineAnnealingLR.
+func (s *CosineAnnealingLR) Step() {
+	s.stepIdx += 1
+	cos := math.Cos(math.Pi * float64(s.stepIdx) / float64(s.TMax))
+	lr := s.EtaMin + (s.baseLR-s.EtaMin)*(1+cos)/2
+	s.opt.SetLR(lr)
+}
+
+// LinearWarmup linearly increases the learning rate up to `BaseLR` during the
+// first `Warmup` steps and keeps it constant afterward.
+type LinearWarmup struct {
+	opt     *Optimizer
+	stepIdx int
+	Warmup  int
+	BaseLR  float64
+}
+
+// NewLinearWarmup creates a LinearWarmup scheduler. It sets the optimizer
+// learning rate to `baseLR/warmup` for the first step.
+func NewLinearWarmup(opt *Optimizer, warmup int, baseLR float64) *LinearWarmup {
+	s := &LinearWarmup{
+		opt:     opt,
+		stepIdx: 0,
+		Warmup:  warmup,
+		BaseLR:  baseLR,
+	}
+	s.opt.SetLR(s.lr())
+
+	return s
+}
+
+func (s *LinearWarmup) lr() float64 {
+	if s.stepIdx+1 >= s.Warmup {
+		return s.BaseLR
+	}
+
+	return s.BaseLR * float64(s.stepIdx+1) / float64(s.Warmup)
+}
+
+// Step implements Scheduler interface for LinearWarmup.
+func (s *LinearWarmup) Step() {
+	s.stepIdx += 1
+	s.opt.SetLR(s.lr())
+}
@@ -0,0 +1,76 @@
+package nn_test
+
+import (
+	"math"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+)
+
+func newTestOptimizer(t *testing.T, lr float64) *nn.Optimizer {
+	vs := nn.NewVarStore(gotch.CPU)
+	vs.Root().MustNewVar("x", []int64{1}, nn.NewConstInit(0.0))
+	opt, err := nn.DefaultSGDConfig().Build(vs, lr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return opt
+}
+
+func TestStepLR(t *testing.T) {
+	opt := newTestOptimizer(t, 1.0)
+	s := nn.NewStepLR(opt, 10, 0.1)
+	for i := 0; i < 25; i++ {
+		s.Step()
+	}
+
+	want := 1.0 * math.Pow(0.1, 2)
+	got := opt.GetLR()
+	if math.Abs(want-got) > 1e-9 {
+		t.Errorf("Expected LR: %v\n", want)
+		t.Errorf("Got LR: %v\n", got)
+	}
+}
+
+func TestCosineAnnealingLR(t *testing.T) {
+	opt := newTestOptimizer(t, 0.1)
+	s := nn.NewCosineAnnealingLR(opt, 10, 0.001)
+	for i := 0; i < 3; i++ {
+		s.Step()
+	}
+
+	want := 0.001 + (0.1-0.001)*(1+math.Cos(math.Pi*3/10))/2
+	got := opt.GetLR()
+	if math.Abs(want-got) > 1e-9 {
+		t.Errorf("Expected LR: %v\n", want)
+		t.Errorf("Got LR: %v\n", got)
+	}
+
+	for i := 3; i < 10; i++ {
+		s.Step()
+	}
+	if math.Abs(opt.GetLR()-0.001) > 1e-9 {
+		t.Errorf("Expected LR at TMax: %v, got %v\n", 0.001, opt.GetLR())
+	}
+}
+
+// NewCosineAnnealingLR exits the process on invalid tMax so the check runs in
+// a child test process.
+func TestCosineAnnealingLRInvalidTMax(t *testing.T) {
+	if os.Getenv("GOTCH_COSINE_TMAX_INVALID") == "1" {
+		opt := newTestOptimizer(t, 0.1)
+		nn.NewCosineAnnealingLR(opt, 0, 0.001)
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestCosineAnnealingLRInvalidTMax")
+	cmd.Env = append(os.Environ(), "GOTCH_COSINE_TMAX_INVALID=1")
+	err := cmd.Run()
+	if e, ok := err.(*exec.ExitError); !ok || e.Success() {
+		t.Errorf("Expected NewCosineAnnealingLR to fail for tMax 0, got: %v\n", err)
+	}
+}
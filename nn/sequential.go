@@ -3,6 +3,8 @@ package nn
 // A sequential layer used to chain multiple layers and closures.
 
 import (
+	"log"
+
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 	// "reflect"
@@ -197,6 +199,47 @@ func (s *SequentialT) ForwardAllT(xs *ts.Tensor, train bool, opts ...uint8) (ret
 	return retVal
 }
 
+// featureExtractor runs a SequentialT up to a given sub-layer.
+type featureExtractor struct {
+	seq       *SequentialT
+	upToLayer int
+}
+
+// FeatureExtractor creates a ModuleT that runs the forward pass of a
+// SequentialT only up to (and including) the sub-layer at index `upToLayer`
+// and returns that intermediate activation.
+//
+// NOTE: the returned activation is detached from the computation graph so
+// that the extracted features can be used (e.g. for transfer learning or
+// perceptual losses) without back-propagating into the extractor layers.
+func FeatureExtractor(m ts.ModuleT, upToLayer int) ts.ModuleT {
+	seq, ok := m.(*SequentialT)
+	if !ok {
+		log.Fatalf("FeatureExtractor - expected a '*nn.SequentialT' module, got '%T'\n", m)
+	}
+
+	if upToLayer < 0 || upToLayer >= len(seq.layers) {
+		log.Fatalf("FeatureExtractor - layer index %v out of range [0, %v)\n", upToLayer, len(seq.layers))
+	}
+
+	return &featureExtractor{
+		seq:       seq,
+		upToLayer: upToLayer,
+	}
+}
+
+// ForwardT implements ModuleT interface for featureExtractor.
+func (fe *featureExtractor) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	currTs := fe.seq.layers[0].ForwardT(xs, train)
+	for i := 1; i <= fe.upToLayer; i++ {
+		nextTs := fe.seq.layers[i].ForwardT(currTs, train)
+		currTs.MustDrop()
+		currTs = nextTs
+	}
+
+	return currTs.MustDetach(true)
+}
+
 // ForwardWith is a handler function to implement Module interface for
 // any (anonymous) function it wraps.
 //
@@ -231,10 +274,13 @@ func BatchAccuracyForLogits(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d got
 		sampleCount float64 = 0.0
 	)
 
-	vs.Freeze()
-	defer vs.Unfreeze()
+	// NOTE. gradients are disabled rather than freezing `vs` which would
+	// unfreeze afterward variables frozen by the caller.
+	prev := ts.MustGradSetEnabled(false)
+	defer ts.MustGradSetEnabled(prev)
 
-	iter2 := ts.MustNewIter2(xs, ys, int64(batchSize))
+	// NOTE. return the last small batch so that all samples are counted.
+	iter2 := ts.MustNewIter2(xs, ys, int64(batchSize)).ReturnSmallLastBatch()
 	for {
 		item, ok := iter2.Next()
 		if !ok {
@@ -247,7 +293,7 @@ func BatchAccuracyForLogits(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d got
 
 		logits := m.ForwardT(bImages, false)
 		acc := logits.AccuracyForLogits(bLabels)
-		sumAccuracy += acc.Float64Values()[0] * size
+		sumAccuracy += acc.MustFloat64Item() * size
 		sampleCount += size
 
 		bImages.MustDrop()
@@ -265,8 +311,10 @@ func BatchAccuracyForLogitsOld(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d
 		sampleCount float64 = 0.0
 	)
 
-	vs.Freeze()
-	defer vs.Unfreeze()
+	// NOTE. gradients are disabled rather than freezing `vs` which would
+	// unfreeze afterward variables frozen by the caller.
+	prev := ts.MustGradSetEnabled(false)
+	defer ts.MustGradSetEnabled(prev)
 
 	iter2 := ts.MustNewIter2(xs, ys, int64(batchSize))
 	for {
@@ -281,7 +329,7 @@ func BatchAccuracyForLogitsOld(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d
 
 		logits := m.ForwardT(bImages, false)
 		acc := logits.AccuracyForLogits(bLabels)
-		sumAccuracy += acc.Float64Values()[0] * size
+		sumAccuracy += acc.MustFloat64Item() * size
 		sampleCount += size
 
 		bImages.MustDrop()
@@ -311,8 +359,10 @@ func BatchAccuracyForLogitsIdx(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d
 	batches := samples / batchSize
 	batchIndex := 0
 
-	vs.Freeze()
-	defer vs.Unfreeze()
+	// NOTE. gradients are disabled rather than freezing `vs` which would
+	// unfreeze afterward variables frozen by the caller.
+	prev := ts.MustGradSetEnabled(false)
+	defer ts.MustGradSetEnabled(prev)
 
 	for i := 0; i < batches; i++ {
 		start := batchIndex * batchSize
@@ -333,7 +383,7 @@ func BatchAccuracyForLogitsIdx(vs *VarStore, m ts.ModuleT, xs, ys *ts.Tensor, d
 		logits := m.ForwardT(bImages, true)
 		bAccuracy := logits.AccuracyForLogits(bLabels)
 
-		accuVal := bAccuracy.Float64Values()[0]
+		accuVal := bAccuracy.MustFloat64Item()
 		bSamples := float64(xs.MustSize()[0])
 		sumAccuracy += accuVal * bSamples
 		sampleCount += bSamples
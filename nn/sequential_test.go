@@ -0,0 +1,79 @@
+package nn_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestFeatureExtractor(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	seq := nn.SeqT()
+	seq.Add(nn.NewLinear(path.Sub("fc1"), 4, 8, nn.DefaultLinearConfig()))
+	seq.AddFn(nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor {
+		return xs.MustRelu(false)
+	}))
+	seq.Add(nn.NewLinear(path.Sub("fc2"), 8, 3, nn.DefaultLinearConfig()))
+
+	input := ts.MustRandn([]int64{2, 4}, gotch.Float, gotch.CPU)
+
+	// intermediate activation
+	fe := nn.FeatureExtractor(seq, 1)
+	wantShape := []int64{2, 8}
+	gotShape := fe.ForwardT(input, false).MustSize()
+	if !reflect.DeepEqual(wantShape, gotShape) {
+		t.Errorf("Expected feature shape: %v\n", wantShape)
+		t.Errorf("Got feature shape: %v\n", gotShape)
+	}
+
+	// extracting at the last layer equals the full forward pass
+	fe = nn.FeatureExtractor(seq, 2)
+	want := seq.ForwardT(input, false).Float64Values()
+	got := fe.ForwardT(input, false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected features: %v\n", want)
+		t.Errorf("Got features: %v\n", got)
+	}
+}
+
+func TestBatchAccuracyForLogits(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	// model returning inputs as logits
+	model := nn.NewFunc(func(xs *ts.Tensor) *ts.Tensor {
+		return xs.MustMul1(ts.FloatScalar(1.0), false)
+	})
+
+	// 10 samples of 3 classes, last sample is misclassified.
+	var data []float32
+	var labels []int64
+	for i := 0; i < 10; i++ {
+		row := []float32{0, 0, 0}
+		row[i%3] = 1
+		data = append(data, row...)
+		if i == 9 {
+			labels = append(labels, int64((i+1)%3))
+		} else {
+			labels = append(labels, int64(i%3))
+		}
+	}
+	xs := ts.MustOfSlice(data).MustView([]int64{10, 3}, true)
+	ys := ts.MustOfSlice(labels)
+
+	got := nn.BatchAccuracyForLogits(vs, model, xs, ys, gotch.CPU, 3)
+	// single-batch accuracy. NOTE. AccuracyForLogits deletes its receiver.
+	want := xs.MustShallowClone().AccuracyForLogits(ys).Float64Values()[0]
+	if math.Abs(want-got) > 1e-6 {
+		t.Errorf("Expected accuracy: %v\n", want)
+		t.Errorf("Got accuracy: %v\n", got)
+	}
+
+	if math.Abs(got-0.9) > 1e-6 {
+		t.Errorf("Expected all 10 samples counted (accuracy 0.9), got %v\n", got)
+	}
+}
@@ -3,10 +3,15 @@ package nn
 // Sparse layers
 
 import (
+	"log"
+
 	ts "github.com/sugarme/gotch/tensor"
 )
 
 // Configuration option for an embedding layer.
+//
+// PaddingIdx, when non-negative, marks a row of the table that is initialized
+// to zeros and receives no gradient. A negative value disables padding.
 type EmbeddingConfig struct {
 	Sparse          bool
 	ScaleGradByFreq bool
@@ -34,8 +39,21 @@ type Embedding struct {
 
 // NewEmbedding creates a new Embedding
 func NewEmbedding(vs *Path, numEmbeddings int64, embeddingDim int64, config *EmbeddingConfig) *Embedding {
+	if config.PaddingIdx >= numEmbeddings {
+		log.Fatalf("NewEmbedding - padding index %v out of range for %v embeddings\n", config.PaddingIdx, numEmbeddings)
+	}
+
+	ws := vs.MustNewVar("weight", []int64{numEmbeddings, embeddingDim}, config.WsInit)
+	if config.PaddingIdx >= 0 {
+		ts.NoGrad(func() {
+			row := ws.MustSelect(0, config.PaddingIdx, false)
+			row.MustZero_()
+			row.MustDrop()
+		})
+	}
+
 	return &Embedding{
-		Ws:     vs.NewVar("weight", []int64{numEmbeddings, embeddingDim}, config.WsInit),
+		Ws:     ws,
 		config: config,
 	}
 }
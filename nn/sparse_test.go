@@ -62,3 +62,27 @@ func TestEmbedding(t *testing.T) {
 	cfg.PaddingIdx = 0
 	embeddingTest(cfg, t)
 }
+
+func TestEmbeddingPadding(t *testing.T) {
+	cfg := nn.DefaultEmbeddingConfig()
+	cfg.PaddingIdx = 2
+
+	vs := nn.NewVarStore(gotch.CPU)
+	embeddings := nn.NewEmbedding(vs.Root(), 5, 3, cfg)
+
+	want := []float64{0, 0, 0}
+	got := embeddings.Ws.MustSelect(0, 2, false).Float64Values()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Init - Expected padding row: %v\n", want)
+		t.Errorf("Init - Got padding row: %v\n", got)
+	}
+
+	input := ts.MustOfSlice([]int64{2, 0, 2, 4})
+	embeddings.Forward(input).MustSum(gotch.Float, true).MustBackward()
+
+	got = embeddings.Ws.MustGrad(false).MustSelect(0, 2, true).Float64Values()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Backward - Expected padding row gradient: %v\n", want)
+		t.Errorf("Backward - Got padding row gradient: %v\n", got)
+	}
+}
@@ -0,0 +1,115 @@
+package nn
+
+// A spectral normalization wrapper.
+
+import (
+	"log"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// SpectralNorm rescales the weight of a layer by its spectral norm (largest
+// singular value) estimated with power iteration.
+//
+// The weight is viewed as a matrix of shape [size(0), -1]. Vectors `U` and
+// `V` persist across forward passes as non-trainable variables so that one
+// power iteration per step is usually enough.
+//
+// Ref. https://arxiv.org/abs/1802.05957
+type SpectralNorm struct {
+	Layer            WeightedModule
+	Ws               *ts.Tensor // original weight
+	U                *ts.Tensor
+	V                *ts.Tensor
+	NPowerIterations int
+	Eps              float64
+
+	weight *ts.Tensor // last computed normalized weight
+}
+
+// NewSpectralNorm wraps layer with spectral normalization of its weight,
+// registering `weight_u` and `weight_v` vectors in vs.
+func NewSpectralNorm(vs *Path, layer WeightedModule, nPowerIterations int) *SpectralNorm {
+	if nPowerIterations < 1 {
+		log.Fatalf("NewSpectralNorm() failed: expected a positive number of power iterations, got %v.\n", nPowerIterations)
+	}
+
+	ws := layer.WeightTensor()
+	size := ws.MustSize()
+	cols := int64(1)
+	for _, d := range size[1:] {
+		cols *= d
+	}
+
+	u := l2Normalize(ts.MustRandn([]int64{size[0]}, gotch.Float, vs.Device()), 1e-12)
+	v := l2Normalize(ts.MustRandn([]int64{cols}, gotch.Float, vs.Device()), 1e-12)
+
+	sn := &SpectralNorm{
+		Layer:            layer,
+		Ws:               ws,
+		U:                vs.Add("weight_u", u, false),
+		V:                vs.Add("weight_v", v, false),
+		NPowerIterations: nPowerIterations,
+		Eps:              1e-12,
+	}
+	u.MustDrop()
+	v.MustDrop()
+
+	return sn
+}
+
+// l2Normalize divides x by its L2 norm, clamped to eps. It deletes x.
+func l2Normalize(x *ts.Tensor, eps float64) *ts.Tensor {
+	norm := x.MustNorm(false).MustClampMin(ts.FloatScalar(eps), true)
+	retVal := x.MustDiv(norm, true)
+	norm.MustDrop()
+
+	return retVal
+}
+
+// Weight returns the weight divided by its estimated spectral norm. If
+// update is true, `U` and `V` are refined with power iteration first.
+func (sn *SpectralNorm) Weight(update bool) *ts.Tensor {
+	mat := sn.Ws.MustReshape([]int64{sn.Ws.MustSize()[0], -1}, false)
+
+	if update {
+		ts.NoGrad(func() {
+			for i := 0; i < sn.NPowerIterations; i++ {
+				// v = normalize(W^T u), u = normalize(W v)
+				v := l2Normalize(mat.MustT(false).MustMv(sn.U, true), sn.Eps)
+				sn.V.Copy_(v)
+				v.MustDrop()
+				u := l2Normalize(mat.MustMv(sn.V, false), sn.Eps)
+				sn.U.Copy_(u)
+				u.MustDrop()
+			}
+		})
+	}
+
+	// sigma = u^T W v
+	sigma := mat.MustMv(sn.V, true).MustDot(sn.U, true)
+	retVal := sn.Ws.MustDiv(sigma, false)
+	sigma.MustDrop()
+
+	return retVal
+}
+
+// Forward implements Module interface for SpectralNorm. It runs in evaluation
+// mode, without power iteration.
+func (sn *SpectralNorm) Forward(xs *ts.Tensor) *ts.Tensor {
+	return sn.ForwardT(xs, false)
+}
+
+// ForwardT implements ModuleT interface for SpectralNorm. Power iteration is
+// only run in training mode.
+func (sn *SpectralNorm) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	w := sn.Weight(train)
+	sn.Layer.SetWeightTensor(w)
+	if sn.weight != nil {
+		sn.weight.MustDrop()
+	}
+	sn.weight = w
+
+	return sn.Layer.ForwardT(xs, train)
+}
@@ -0,0 +1,93 @@
+package nn_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// largestSingularValue estimates the largest singular value of a weight
+// viewed as a matrix of shape [size(0), -1] with power iteration on W^T W.
+func largestSingularValue(w *ts.Tensor) float64 {
+	rows := int(w.MustSize()[0])
+	vals := w.Float64Values()
+	cols := len(vals) / rows
+
+	v := make([]float64, cols)
+	for i := range v {
+		v[i] = 1.0
+	}
+	var sigma float64
+	for iter := 0; iter < 500; iter++ {
+		// u = W v, v = W^T u
+		u := make([]float64, rows)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				u[i] += vals[i*cols+j] * v[j]
+			}
+		}
+		next := make([]float64, cols)
+		for j := 0; j < cols; j++ {
+			for i := 0; i < rows; i++ {
+				next[j] += vals[i*cols+j] * u[i]
+			}
+		}
+		var norm float64
+		for _, x := range next {
+			norm += x * x
+		}
+		norm = math.Sqrt(norm)
+		for j := range next {
+			next[j] /= norm
+		}
+		v = next
+		sigma = math.Sqrt(norm)
+	}
+
+	return sigma
+}
+
+func TestSpectralNorm(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+
+	layers := map[string]nn.WeightedModule{
+		"linear": nn.NewLinear(path.Sub("linear"), 8, 4, nn.DefaultLinearConfig()),
+		"conv2d": nn.NewConv2D(path.Sub("conv2d"), 3, 6, 3, nn.DefaultConv2DConfig()),
+	}
+	inputs := map[string]*ts.Tensor{
+		"linear": ts.MustRandn([]int64{2, 8}, gotch.Float, gotch.CPU),
+		"conv2d": ts.MustRandn([]int64{2, 3, 5, 5}, gotch.Float, gotch.CPU),
+	}
+
+	for name, layer := range layers {
+		sn := nn.NewSpectralNorm(path.Sub(name+"_sn"), layer, 1)
+		for i := 0; i < 20; i++ {
+			ts.NoGrad(func() {
+				sn.ForwardT(inputs[name], true).MustDrop()
+			})
+		}
+
+		sigma := largestSingularValue(layer.WeightTensor())
+		if math.Abs(sigma-1.0) > 1e-3 {
+			t.Errorf("Expected %v normalized weight spectral norm: %v\n", name, 1.0)
+			t.Errorf("Got %v normalized weight spectral norm: %v\n", name, sigma)
+		}
+
+		// u and v are buffers, not trainable variables.
+		if sn.U.MustRequiresGrad() || sn.V.MustRequiresGrad() {
+			t.Errorf("Expected %v u and v not to require grad\n", name)
+		}
+
+		// Forward uses the normalized weight without updating u.
+		var m ts.Module = sn
+		u := sn.U.Float64Values()
+		want := sn.ForwardT(inputs[name], false).Float64Values()
+		got := m.Forward(inputs[name]).Float64Values()
+		assertAllClose(t, name+" Forward output", want, got)
+		assertAllClose(t, name+" u after Forward", u, sn.U.Float64Values())
+	}
+}
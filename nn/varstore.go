@@ -1,11 +1,15 @@
 package nn
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"math"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
+	"text/tabwriter"
 
 	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
@@ -88,7 +92,7 @@ func (vs *VarStore) TrainableVariables() []ts.Tensor {
 	vs.Vars.mutex.Lock()
 	defer vs.Vars.mutex.Unlock()
 
-	retVal := vs.Vars.TrainableVariables
+	retVal := make([]ts.Tensor, 0, len(vs.Vars.TrainableVariables))
 	for _, t := range vs.Vars.TrainableVariables {
 		retVal = append(retVal, *t.MustShallowClone())
 	}
@@ -110,6 +114,101 @@ func (vs *VarStore) Variables() map[string]*ts.Tensor {
 	return namedTensors
 }
 
+// Summary returns a table of all variables of the var-store, sorted by name,
+// with their shape, dtype and number of elements, followed by total and
+// trainable parameter counts.
+//
+// As for `NumParameters`, variables are trainable if they require gradients,
+// i.e. excluding frozen variables (see `Freeze`) and buffers.
+func (vs *VarStore) Summary() string {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	var names []string
+	for name := range vs.Vars.NamedVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		buf       bytes.Buffer
+		total     int64
+		trainable int64
+	)
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tShape\tDType\tParams\tTrainable")
+	for _, name := range names {
+		v := vs.Vars.NamedVariables[name]
+		numel := int64(v.Numel())
+		isTrainable := v.MustRequiresGrad()
+
+		total += numel
+		if isTrainable {
+			trainable += numel
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", name, v.MustSize(), v.DType(), numel, isTrainable)
+	}
+	w.Flush()
+
+	fmt.Fprintf(&buf, "Total params: %v\n", total)
+	fmt.Fprintf(&buf, "Trainable params: %v\n", trainable)
+	fmt.Fprintf(&buf, "Non-trainable params: %v\n", total-trainable)
+
+	return buf.String()
+}
+
+// NumParameters returns the total number of elements of all variables of vs
+// and the number of those which require gradients, i.e. excluding frozen
+// variables (see `Freeze`) and buffers such as batch-norm running stats.
+func NumParameters(vs *VarStore) (total, trainable int64) {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	for _, v := range vs.Vars.NamedVariables {
+		numel := int64(v.Numel())
+		total += numel
+		if v.MustRequiresGrad() {
+			trainable += numel
+		}
+	}
+
+	return total, trainable
+}
+
+// FormatParamCount formats a number of parameters in a human-readable form
+// with one decimal and a K (thousand), M (million) or B (billion) suffix,
+// e.g. "11.2M".
+func FormatParamCount(n int64) string {
+	if n < 1000 && n > -1000 {
+		return fmt.Sprintf("%v", n)
+	}
+
+	v := float64(n)
+	var unit string
+	for _, unit = range []string{"K", "M", "B"} {
+		v /= 1000
+		// NOTE. go to the next unit if rounding reaches 1000, e.g. 999.95K.
+		if math.Abs(math.Round(v*10)/10) < 1000 || unit == "B" {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%.1f%v", v, unit)
+}
+
+// isTrainable returns whether a variable of the var-store is trainable.
+//
+// NOTE: the caller must hold the variables lock.
+func (vs *VarStore) isTrainable(x *ts.Tensor) bool {
+	for _, t := range vs.Vars.TrainableVariables {
+		if t == *x {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Root gets the root path for this var-store
 //
 // NOTE: Variables are named and organized using paths. This function returns
@@ -143,6 +242,54 @@ func (vs *VarStore) Save(filepath string) error {
 	return ts.SaveMultiNew(namedTensors, filepath)
 }
 
+// LoadOptions holds optional parameters for loading var-store variables.
+type LoadOptions struct {
+	// NameMapper maps names of tensors in the file to names of variables in
+	// the var-store. Nil means names are used as is.
+	NameMapper func(string) string
+}
+
+type LoadOption func(*LoadOptions)
+
+// NewLoadOptions creates LoadOptions with default values: no name mapping.
+func NewLoadOptions(options ...LoadOption) LoadOptions {
+	var opts LoadOptions
+	for _, o := range options {
+		o(&opts)
+	}
+
+	return opts
+}
+
+// WithNameMapper sets a function remapping names of tensors in the file, e.g.
+// stripping the "module." prefix of checkpoints saved from a data-parallel
+// model.
+func WithNameMapper(mapper func(string) string) LoadOption {
+	return func(o *LoadOptions) {
+		o.NameMapper = mapper
+	}
+}
+
+// loadNamedTensors loads tensors from a file on the var-store device, keyed
+// by their (mapped) names.
+func (vs *VarStore) loadNamedTensors(filepath string, opts LoadOptions) (map[string]*ts.Tensor, error) {
+	namedTensors, err := ts.LoadMultiWithDevice(filepath, vs.device)
+	if err != nil {
+		return nil, err
+	}
+
+	var namedTensorsMap map[string]*ts.Tensor = make(map[string]*ts.Tensor, 0)
+	for _, namedTensor := range namedTensors {
+		name := namedTensor.Name
+		if opts.NameMapper != nil {
+			name = opts.NameMapper(name)
+		}
+		namedTensorsMap[name] = namedTensor.Tensor
+	}
+
+	return namedTensorsMap, nil
+}
+
 // Load loads the var-store variable values from a file.
 //
 // NOTE: Weight values for all the tensors currently stored in the
@@ -151,17 +298,13 @@ func (vs *VarStore) Save(filepath string) error {
 // for these tensors are modified.
 // It will throw error if name of the loaded tensors can not find
 // in the current var-store named tensors set.
-func (vs *VarStore) Load(filepath string) error {
-	namedTensors, err := ts.LoadMultiWithDevice(filepath, vs.device)
+// Names of tensors in the file can be remapped with `WithNameMapper` option.
+func (vs *VarStore) Load(filepath string, options ...LoadOption) error {
+	namedTensorsMap, err := vs.loadNamedTensors(filepath, NewLoadOptions(options...))
 	if err != nil {
 		return err
 	}
 
-	var namedTensorsMap map[string]*ts.Tensor = make(map[string]*ts.Tensor, 0)
-	for _, namedTensor := range namedTensors {
-		namedTensorsMap[namedTensor.Name] = namedTensor.Tensor
-	}
-
 	// Match and in-place copy value (update) from newly loaded tensors
 	// to existing named tensors if name is matched. Throw error otherwise.
 	vs.Vars.mutex.Lock()
@@ -196,28 +339,23 @@ func (vs *VarStore) Load(filepath string) error {
 //
 // Weight values for the tensors currently stored in the var-store and the given file get
 // loaded from the given file. If a variable in the var store is not present in the given file,
-// it is skipped and its values are not updated. This method should be used if pre-trained
-// weight for only parts of the model are available.
+// or has a different shape, it is skipped and its values are not updated. This method should
+// be used if pre-trained weight for only parts of the model are available, e.g. fine-tuning
+// from a checkpoint of a slightly different architecture.
 // Note that the set of variables stored in the var-store is not changed, only the values
 // for these tensors are modified.
+// Names of tensors in the file can be remapped with `WithNameMapper` option.
 //
-// Returns a String Vector containing the names of missing variables.
-func (vs *VarStore) LoadPartial(filepath string) ([]string, error) {
-
-	namedTensors, err := ts.LoadMultiWithDevice(filepath, vs.device)
+// Returns sorted names of missing variables (in the var-store but not loaded from the file)
+// and of unexpected tensors (in the file but not in the var-store).
+func (vs *VarStore) LoadPartial(filepath string, options ...LoadOption) (missing, unexpected []string, err error) {
+	namedTensorsMap, err := vs.loadNamedTensors(filepath, NewLoadOptions(options...))
 	if err != nil {
-		return nil, err
-	}
-
-	var namedTensorsMap map[string]*ts.Tensor = make(map[string]*ts.Tensor, 0)
-	for _, namedTensor := range namedTensors {
-		namedTensorsMap[namedTensor.Name] = namedTensor.Tensor
+		return nil, nil, err
 	}
 
-	var missingVariables []string
-
 	// Match and in-place copy value (update) from newly loaded tensors
-	// to existing named tensors if name is matched. Throw error otherwise.
+	// to existing named tensors if name is matched. Skip otherwise.
 	vs.Vars.mutex.Lock()
 	defer vs.Vars.mutex.Unlock()
 
@@ -227,7 +365,7 @@ func (vs *VarStore) LoadPartial(filepath string) ([]string, error) {
 
 		// missing variable
 		if currTs, ok = namedTensorsMap[tsName]; !ok {
-			missingVariables = append(missingVariables, tsName)
+			missing = append(missing, tsName)
 			continue
 		}
 
@@ -235,8 +373,8 @@ func (vs *VarStore) LoadPartial(filepath string) ([]string, error) {
 		destShape := currTs.MustSize()
 		sourceShape := vs.Vars.NamedVariables[tsName].MustSize()
 		if !reflect.DeepEqual(destShape, sourceShape) {
-			fmt.Printf("Mismatched shape error for variable name: %v - At store: %v - At source %v\n", tsName, destShape, sourceShape)
-			missingVariables = append(missingVariables, tsName)
+			log.Printf("LoadPartial - skipping variable %q of mismatched shape - At store: %v - At source %v\n", tsName, sourceShape, destShape)
+			missing = append(missing, tsName)
 			continue
 		}
 
@@ -245,7 +383,16 @@ func (vs *VarStore) LoadPartial(filepath string) ([]string, error) {
 		})
 	}
 
-	return missingVariables, nil
+	for name := range namedTensorsMap {
+		if _, ok := vs.Vars.NamedVariables[name]; !ok {
+			unexpected = append(unexpected, name)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	return missing, unexpected, nil
 }
 
 // Freeze freezes a var store.
@@ -279,6 +426,44 @@ func (vs *VarStore) Unfreeze() {
 	}
 }
 
+// Freeze freezes all trainable variables under this path (including
+// variables of sub-paths).
+//
+// Gradients for these variables are not tracked anymore and optimizers skip
+// them. It is useful for fine-tuning, e.g. freezing a pretrained "backbone".
+func (p *Path) Freeze() {
+	p.setRequiresGrad(false)
+}
+
+// Unfreeze unfreezes all trainable variables under this path (including
+// variables of sub-paths).
+//
+// Gradients for these variables are tracked again.
+func (p *Path) Unfreeze() {
+	p.setRequiresGrad(true)
+}
+
+func (p *Path) setRequiresGrad(b bool) {
+	vs := p.varstore
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	prefix := strings.Join(p.path, SEP)
+	for name, v := range vs.Vars.NamedVariables {
+		if prefix != "" && name != prefix && !strings.HasPrefix(name, prefix+SEP) {
+			continue
+		}
+
+		// NOTE. non-trainable variables are left as is.
+		if !vs.isTrainable(v) {
+			continue
+		}
+		if _, err := v.SetRequiresGrad(b, false); err != nil {
+			log.Fatalf("Path - setRequiresGrad method call error: %v\n", err)
+		}
+	}
+}
+
 // Copy copies variable values from a source var store to this var store.
 //
 // All the variables in this var store have to exist with the same
@@ -313,6 +498,104 @@ func (vs *VarStore) Copy(src VarStore) error {
 	return nil
 }
 
+// ToDevice moves all variables of the var store (including non-trainable
+// ones such as batch-norm running stats) and their gradients to device, in
+// place. Layers holding the variables themselves, as all layers of this
+// package do, keep working with the moved variables, and new variables are
+// created on device.
+//
+// NOTE: tensors derived from variables before the call, e.g. views or
+// transposes, still refer to the old data and have to be recreated.
+//
+// NOTE: optimizer states (e.g. momentum buffers) are not moved. Optimizers
+// should be built after calling ToDevice.
+func (vs *VarStore) ToDevice(device gotch.Device) error {
+	err := vs.apply(func(x *ts.Tensor) (*ts.Tensor, error) {
+		return x.To(device, false)
+	})
+	if err != nil {
+		return fmt.Errorf("ToDevice() failed: %v", err)
+	}
+
+	vs.device = device
+
+	return nil
+}
+
+// ToDType converts all floating point variables of the var store and their
+// gradients to dtype, in place, e.g. gotch.Half for half-precision inference.
+// Other variables (e.g. integer counters) are left as is.
+//
+// NOTE: optimizer states (e.g. momentum buffers) are not converted. Optimizers
+// should be built after calling ToDType.
+func (vs *VarStore) ToDType(dtype gotch.DType) error {
+	if !isFloatDType(dtype) {
+		return fmt.Errorf("ToDType() failed: expected a floating point dtype, got %v.\n", dtype)
+	}
+
+	err := vs.apply(func(x *ts.Tensor) (*ts.Tensor, error) {
+		if !isFloatDType(x.DType()) {
+			return nil, nil
+		}
+		return x.Totype(dtype, false)
+	})
+	if err != nil {
+		return fmt.Errorf("ToDType() failed: %v", err)
+	}
+
+	return nil
+}
+
+func isFloatDType(dtype gotch.DType) bool {
+	switch dtype {
+	case gotch.Float, gotch.Double, gotch.Half, gotch.BFloat16:
+		return true
+	}
+	return false
+}
+
+// apply replaces in place the data of every variable and its gradient with
+// the result of f. Variables for which f returns nil are left as is.
+func (vs *VarStore) apply(f func(*ts.Tensor) (*ts.Tensor, error)) (err error) {
+	vs.Vars.mutex.Lock()
+	defer vs.Vars.mutex.Unlock()
+
+	replace := func(x *ts.Tensor) error {
+		newTs, err := f(x)
+		if err != nil || newTs == nil {
+			return err
+		}
+		defer newTs.MustDrop()
+
+		return x.ReplaceData_(newTs)
+	}
+
+	ts.NoGrad(func() {
+		for name, v := range vs.Vars.NamedVariables {
+			if err = replace(v); err != nil {
+				err = fmt.Errorf("variable %q: %v", name, err)
+				return
+			}
+
+			grad, gerr := v.Grad(false)
+			if gerr != nil {
+				err = fmt.Errorf("variable %q: %v", name, gerr)
+				return
+			}
+			if grad.MustDefined() {
+				err = replace(grad)
+			}
+			grad.MustDrop()
+			if err != nil {
+				err = fmt.Errorf("gradient of variable %q: %v", name, err)
+				return
+			}
+		}
+	})
+
+	return err
+}
+
 // Path methods:
 // =============
 
@@ -457,12 +740,25 @@ func (p *Path) OnesNoTrain(name string, dims []int64) *ts.Tensor {
 // has the specified shape. The variable is trainable, its gradient
 // will be tracked.
 // The variable uses a float tensor initialized as per the
-// related argument.
-func (p *Path) NewVar(name string, dims []int64, ini Init) *ts.Tensor {
+// related argument. An error is returned if the initialization fails.
+func (p *Path) NewVar(name string, dims []int64, ini Init) (*ts.Tensor, error) {
 
-	v := ini.InitTensor(dims, p.varstore.device)
+	v, err := ini.InitTensor(dims, p.varstore.device)
+	if err != nil {
+		return nil, fmt.Errorf("Path - NewVar method call error for %q: %v", p.getpath(name), err)
+	}
 
-	return p.add(name, v, true)
+	return p.add(name, v, true), nil
+}
+
+// MustNewVar creates a new variable. It panics if error.
+func (p *Path) MustNewVar(name string, dims []int64, ini Init) *ts.Tensor {
+	v, err := p.NewVar(name, dims, ini)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return v
 }
 
 // Zeros creates a new variable initialized with zeros.
@@ -473,7 +769,7 @@ func (p *Path) NewVar(name string, dims []int64, ini Init) *ts.Tensor {
 // The variable uses a float tensor initialized with zeros.
 func (p *Path) Zeros(name string, dims []int64) *ts.Tensor {
 
-	return p.NewVar(name, dims, NewConstInit(0.0))
+	return p.MustNewVar(name, dims, NewConstInit(0.0))
 }
 
 // Ones creates a new variable initialized with ones.
@@ -484,7 +780,7 @@ func (p *Path) Zeros(name string, dims []int64) *ts.Tensor {
 // The variable uses a float tensor initialized with ones.
 func (p *Path) Ones(name string, dims []int64) *ts.Tensor {
 
-	return p.NewVar(name, dims, NewConstInit(1.0))
+	return p.MustNewVar(name, dims, NewConstInit(1.0))
 }
 
 // RandnStandard creates a new variable initialized randomly with normal distribution.
@@ -496,7 +792,7 @@ func (p *Path) Ones(name string, dims []int64) *ts.Tensor {
 // standard normal distribution.
 func (p *Path) RandnStandard(name string, dims []int64) *ts.Tensor {
 
-	return p.NewVar(name, dims, NewRandnInit(0.0, 1.0))
+	return p.MustNewVar(name, dims, NewRandnInit(0.0, 1.0))
 }
 
 // Randn creates a new variable initialized randomly with normal distribution.
@@ -508,7 +804,7 @@ func (p *Path) RandnStandard(name string, dims []int64) *ts.Tensor {
 // normal distribution with the specified mean and standard deviation.
 func (p *Path) Randn(name string, dims []int64, mean float64, stdev float64) *ts.Tensor {
 
-	return p.NewVar(name, dims, NewRandnInit(mean, stdev))
+	return p.MustNewVar(name, dims, NewRandnInit(mean, stdev))
 }
 
 // Uniform creates a new variable initialized randomly with uniform distribution.
@@ -520,7 +816,7 @@ func (p *Path) Randn(name string, dims []int64, mean float64, stdev float64) *ts
 // uniform distribution between the specified bounds.
 func (p *Path) Uniform(name string, dims []int64, lo, up float64) *ts.Tensor {
 
-	return p.NewVar(name, dims, NewUniformInit(lo, up))
+	return p.MustNewVar(name, dims, NewUniformInit(lo, up))
 }
 
 // KaimingUniform creates a new variable initialized randomly with kaiming uniform.
@@ -532,7 +828,7 @@ func (p *Path) Uniform(name string, dims []int64, lo, up float64) *ts.Tensor {
 // uniform distribution which bounds follow Kaiming initialization.
 func (p *Path) KaimingUniform(name string, dims []int64) *ts.Tensor {
 
-	return p.NewVar(name, dims, NewKaimingUniformInit())
+	return p.MustNewVar(name, dims, NewKaimingUniformInit())
 }
 
 // VarCopy creates a new variable initialized by copying an existing tensor.
@@ -595,7 +891,10 @@ func (p *Path) Entry(name string) *Entry {
 // initialized according to the init parameter.
 func (e *Entry) OrVar(dims []int64, init Init) *ts.Tensor {
 
-	v := init.InitTensor(dims, e.path.varstore.device)
+	v, err := init.InitTensor(dims, e.path.varstore.device)
+	if err != nil {
+		log.Fatalf("Entry - OrVar method call error: %v\n", err)
+	}
 	return e.path.getOrAddWithLock(e.name, v, true, *e.variables)
 }
 
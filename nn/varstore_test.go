@@ -1,9 +1,12 @@
 package nn_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/sugarme/gotch"
@@ -133,3 +136,345 @@ func TestSaveLoad(t *testing.T) {
 		t.Errorf("Failed deleting varstore saved file: %v\n", filenameAbs)
 	}
 }
+
+func TestPathFreeze(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	backbone := nn.NewLinear(root.Sub("backbone"), 3, 4, nn.DefaultLinearConfig())
+	head := nn.NewLinear(root.Sub("head"), 4, 1, nn.DefaultLinearConfig())
+
+	opt, err := nn.DefaultAdamConfig().Build(vs, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xs := ts.MustRandn([]int64{8, 3}, gotch.Float, gotch.CPU)
+	trainStep := func() {
+		loss := head.Forward(backbone.Forward(xs)).MustSum(gotch.Float, true)
+		opt.BackwardStep(loss)
+		loss.MustDrop()
+	}
+
+	// one step with all variables trainable so that gradients and optimizer
+	// states are populated.
+	trainStep()
+
+	root.Sub("backbone").Freeze()
+	vars := vs.Variables()
+	for _, name := range []string{"backbone.weight", "backbone.bias"} {
+		if vars[name].MustRequiresGrad() {
+			t.Errorf("Expected %q to be frozen\n", name)
+		}
+	}
+	for _, name := range []string{"head.weight", "head.bias"} {
+		if !vars[name].MustRequiresGrad() {
+			t.Errorf("Expected %q not to be frozen\n", name)
+		}
+	}
+
+	before := make(map[string][]float64)
+	for name, v := range vars {
+		before[name] = v.Float64Values()
+	}
+
+	trainStep()
+
+	for name, v := range vars {
+		changed := !reflect.DeepEqual(before[name], v.Float64Values())
+		frozen := name == "backbone.weight" || name == "backbone.bias"
+		if frozen && changed {
+			t.Errorf("Expected frozen variable %q to be unchanged after step\n", name)
+		}
+		if !frozen && !changed {
+			t.Errorf("Expected variable %q to be updated after step\n", name)
+		}
+	}
+
+	root.Sub("backbone").Unfreeze()
+	if !vars["backbone.weight"].MustRequiresGrad() {
+		t.Errorf("Expected %q to be unfrozen\n", "backbone.weight")
+	}
+}
+
+func TestBatchAccuracyKeepsFrozen(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	nn.NewLinear(root.Sub("backbone"), 3, 4, nn.DefaultLinearConfig())
+	head := nn.NewLinear(root.Sub("head"), 3, 2, nn.DefaultLinearConfig())
+
+	root.Sub("backbone").Freeze()
+
+	xs := ts.MustRandn([]int64{8, 3}, gotch.Float, gotch.CPU)
+	ys := ts.MustZeros([]int64{8}, gotch.Int64, gotch.CPU)
+	nn.BatchAccuracyForLogits(vs, head, xs, ys, gotch.CPU, 4)
+
+	vars := vs.Variables()
+	if vars["backbone.weight"].MustRequiresGrad() {
+		t.Errorf("Expected %q to stay frozen after evaluation\n", "backbone.weight")
+	}
+	if !vars["head.weight"].MustRequiresGrad() {
+		t.Errorf("Expected %q to stay trainable after evaluation\n", "head.weight")
+	}
+}
+
+func TestVarStoreSummary(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	nn.NewLinear(root.Sub("encoder").Sub("fc1"), 3, 4, nn.DefaultLinearConfig())
+	nn.NewLinear(root.Sub("encoder").Sub("fc2"), 4, 2, nn.DefaultLinearConfig())
+	root.Sub("bn").ZerosNoTrain("running_mean", []int64{4})
+
+	wantNames := []string{
+		"bn.running_mean",
+		"encoder.fc1.bias",
+		"encoder.fc1.weight",
+		"encoder.fc2.bias",
+		"encoder.fc2.weight",
+	}
+	var gotNames []string
+	for name := range vs.Variables() {
+		gotNames = append(gotNames, name)
+	}
+	sort.Strings(gotNames)
+	if !reflect.DeepEqual(wantNames, gotNames) {
+		t.Errorf("Expected variable names: %v\n", wantNames)
+		t.Errorf("Got variable names: %v\n", gotNames)
+	}
+
+	if got := len(vs.TrainableVariables()); got != 4 {
+		t.Errorf("Expected 4 trainable variables, got %v\n", got)
+	}
+
+	lines := strings.Split(strings.TrimSpace(vs.Summary()), "\n")
+	wantRows := [][]string{
+		{"Name", "Shape", "DType", "Params", "Trainable"},
+		{"bn.running_mean", "[4]", "float32", "4", "false"},
+		{"encoder.fc1.bias", "[4]", "float32", "4", "true"},
+		{"encoder.fc1.weight", "[4", "3]", "float32", "12", "true"},
+		{"encoder.fc2.bias", "[2]", "float32", "2", "true"},
+		{"encoder.fc2.weight", "[2", "4]", "float32", "8", "true"},
+		{"Total", "params:", "30"},
+		{"Trainable", "params:", "26"},
+		{"Non-trainable", "params:", "4"},
+	}
+	var gotRows [][]string
+	for _, line := range lines {
+		gotRows = append(gotRows, strings.Fields(line))
+	}
+	if !reflect.DeepEqual(wantRows, gotRows) {
+		t.Errorf("Expected summary rows: %q\n", wantRows)
+		t.Errorf("Got summary rows: %q\n", gotRows)
+	}
+}
+
+func TestNumParameters(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	nn.NewLinear(root.Sub("backbone"), 3, 4, nn.DefaultLinearConfig())
+	nn.NewLinear(root.Sub("head"), 4, 2, nn.DefaultLinearConfig())
+	root.Sub("bn").ZerosNoTrain("running_mean", []int64{4})
+	root.Sub("backbone").Freeze()
+
+	total, trainable := nn.NumParameters(vs)
+	if want := int64(3*4 + 4 + 4*2 + 2 + 4); total != want {
+		t.Errorf("Expected total params: %v\n", want)
+		t.Errorf("Got total params: %v\n", total)
+	}
+	if want := int64(4*2 + 2); trainable != want {
+		t.Errorf("Expected trainable params: %v\n", want)
+		t.Errorf("Got trainable params: %v\n", trainable)
+	}
+
+	// Summary counts trainable params the same way
+	lines := strings.Split(strings.TrimSpace(vs.Summary()), "\n")
+	wantTail := [][]string{
+		{"Total", "params:", fmt.Sprint(total)},
+		{"Trainable", "params:", fmt.Sprint(trainable)},
+		{"Non-trainable", "params:", fmt.Sprint(total - trainable)},
+	}
+	var gotTail [][]string
+	for _, line := range lines[len(lines)-3:] {
+		gotTail = append(gotTail, strings.Fields(line))
+	}
+	if !reflect.DeepEqual(wantTail, gotTail) {
+		t.Errorf("Expected summary counts: %q\n", wantTail)
+		t.Errorf("Got summary counts: %q\n", gotTail)
+	}
+	for _, line := range lines {
+		if fields := strings.Fields(line); strings.HasPrefix(fields[0], "backbone.") && fields[len(fields)-1] != "false" {
+			t.Errorf("Expected frozen variable not trainable in summary, got: %q\n", line)
+		}
+	}
+
+	for n, want := range map[int64]string{
+		0:             "0",
+		999:           "999",
+		1000:          "1.0K",
+		1500:          "1.5K",
+		999950:        "1.0M",
+		11200000:      "11.2M",
+		11689512:      "11.7M",
+		7000000000:    "7.0B",
+		1500000000000: "1500.0B",
+	} {
+		if got := nn.FormatParamCount(n); got != want {
+			t.Errorf("Expected formatted count of %v: %v\n", n, want)
+			t.Errorf("Got formatted count of %v: %v\n", n, got)
+		}
+	}
+}
+
+func TestVarStoreToDevice(t *testing.T) {
+	if !gotch.CudaIsAvailable() {
+		t.Skip("CUDA is not available")
+	}
+
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	linear := nn.NewLinear(root.Sub("fc"), 3, 2, nn.DefaultLinearConfig())
+	bn := nn.BatchNorm1D(root.Sub("bn"), 2, nn.DefaultBatchNormConfig())
+
+	// gradients are moved as well
+	xs := ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)
+	linear.Forward(xs).MustSum(gotch.Float, true).MustBackward()
+	xs.MustDrop()
+
+	device := gotch.CudaBuilder(0)
+	if err := vs.ToDevice(device); err != nil {
+		t.Fatal(err)
+	}
+
+	if vs.Device() != device {
+		t.Errorf("Expected var-store device %v, got %v\n", device, vs.Device())
+	}
+	for name, v := range vs.Variables() {
+		if got := v.MustDevice(); got != device {
+			t.Errorf("Expected variable %q on %v, got %v\n", name, device, got)
+		}
+	}
+	for _, v := range vs.TrainableVariables() {
+		if got := v.MustGrad(false).MustDevice(); got != device {
+			t.Errorf("Expected gradient on %v, got %v\n", device, got)
+		}
+	}
+
+	// layers see the moved variables
+	if got := linear.Ws.MustDevice(); got != device {
+		t.Errorf("Expected linear weight on %v, got %v\n", device, got)
+	}
+	if got := bn.RunningMean.MustDevice(); got != device {
+		t.Errorf("Expected batch-norm running mean on %v, got %v\n", device, got)
+	}
+	xs = ts.MustRandn([]int64{4, 3}, gotch.Float, device)
+	out := bn.ForwardT(linear.Forward(xs), true)
+	if got := out.MustDevice(); got != device {
+		t.Errorf("Expected output on %v, got %v\n", device, got)
+	}
+
+	// new variables are created on the new device
+	if got := root.Zeros("extra", []int64{2}).MustDevice(); got != device {
+		t.Errorf("Expected new variable on %v, got %v\n", device, got)
+	}
+}
+
+func TestVarStoreToDType(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	linear := nn.NewLinear(root.Sub("fc"), 3, 2, nn.DefaultLinearConfig())
+	noBiasCfg := nn.DefaultLinearConfig()
+	noBiasCfg.Bias = false
+	head := nn.NewLinear(root.Sub("head"), 2, 1, noBiasCfg)
+	root.Add("steps", ts.MustZeros([]int64{1}, gotch.Int64, gotch.CPU), false)
+
+	want := linear.Ws.Float64Values()
+	if err := vs.ToDType(gotch.Double); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, v := range vs.Variables() {
+		wantDType := gotch.Double
+		if name == "steps" {
+			wantDType = gotch.Int64
+		}
+		if got := v.DType(); got != wantDType {
+			t.Errorf("Expected variable %q of dtype %v, got %v\n", name, wantDType, got)
+		}
+	}
+	if !linear.Ws.MustRequiresGrad() {
+		t.Errorf("Expected converted weight to still require grad\n")
+	}
+	assertAllClose(t, "converted weight", want, linear.Ws.Float64Values())
+
+	// layers see the converted variables
+	xs := ts.MustOnes([]int64{4, 3}, gotch.Double, gotch.CPU)
+	out := head.Forward(linear.Forward(xs))
+	if got := out.DType(); got != gotch.Double {
+		t.Errorf("Expected output of dtype %v, got %v\n", gotch.Double, got)
+	}
+	if want, got := []int64{4, 1}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	if err := vs.ToDType(gotch.Int64); err == nil {
+		t.Errorf("Expected error for non floating point dtype, got nil\n")
+	}
+}
+
+func TestLoadPartial(t *testing.T) {
+	filename := filepath.Join(os.TempDir(), "vs-partial.test")
+	defer os.Remove(filename)
+
+	// checkpoint of a data-parallel model without "fc2.bias" and with an
+	// extra "head.weight" and a "fc2.weight" of different shape.
+	src := nn.NewVarStore(gotch.CPU)
+	srcRoot := src.Root().Sub("module")
+	srcFc1 := nn.NewLinear(srcRoot.Sub("fc1"), 3, 4, nn.DefaultLinearConfig())
+	srcRoot.Sub("fc2").Zeros("weight", []int64{5, 4})
+	srcRoot.Sub("head").Zeros("weight", []int64{2, 4})
+	if err := src.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := nn.NewVarStore(gotch.CPU)
+	root := vs.Root()
+	fc1 := nn.NewLinear(root.Sub("fc1"), 3, 4, nn.DefaultLinearConfig())
+	fc2 := nn.NewLinear(root.Sub("fc2"), 4, 2, nn.DefaultLinearConfig())
+	wantFc2 := fc2.Ws.Float64Values()
+
+	stripPrefix := func(name string) string {
+		return strings.TrimPrefix(name, "module.")
+	}
+	missing, unexpected, err := vs.LoadPartial(filename, nn.WithNameMapper(stripPrefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"fc2.bias", "fc2.weight"}; !reflect.DeepEqual(want, missing) {
+		t.Errorf("Expected missing: %v\n", want)
+		t.Errorf("Got missing: %v\n", missing)
+	}
+	if want := []string{"head.weight"}; !reflect.DeepEqual(want, unexpected) {
+		t.Errorf("Expected unexpected: %v\n", want)
+		t.Errorf("Got unexpected: %v\n", unexpected)
+	}
+
+	// matching variables are loaded, others are left as is
+	assertAllClose(t, "fc1 weight", srcFc1.Ws.Float64Values(), fc1.Ws.Float64Values())
+	assertAllClose(t, "fc1 bias", srcFc1.Bs.Float64Values(), fc1.Bs.Float64Values())
+	assertAllClose(t, "fc2 weight", wantFc2, fc2.Ws.Float64Values())
+
+	// without name mapping, nothing matches
+	missing, unexpected, err = vs.LoadPartial(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 4 || len(unexpected) != 4 {
+		t.Errorf("Expected 4 missing and 4 unexpected, got %v and %v\n", missing, unexpected)
+	}
+
+	// strict loading fails on missing variables
+	if err := vs.Load(filename, nn.WithNameMapper(stripPrefix)); err == nil {
+		t.Errorf("Expected error loading a checkpoint with missing variables, got nil\n")
+	}
+}
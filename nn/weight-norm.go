@@ -0,0 +1,96 @@
+package nn
+
+// A weight normalization wrapper.
+
+import (
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// WeightedModule is a layer exposing its weight so that the weight can be
+// reparameterized by wrappers such as WeightNorm.
+type WeightedModule interface {
+	ts.Module
+	ts.ModuleT
+	// WeightTensor returns the weight used by the layer in forward pass.
+	WeightTensor() *ts.Tensor
+	// SetWeightTensor replaces the weight used by the layer in forward pass.
+	SetWeightTensor(w *ts.Tensor)
+}
+
+// WeightNorm reparameterizes the weight of a layer as `g * v/||v||` where
+// the norm is computed over all dims except `Dim`. `G` and `V` are trainable
+// variables and the effective weight is recomputed on every forward pass.
+//
+// Ref. https://arxiv.org/abs/1602.07868
+type WeightNorm struct {
+	Layer WeightedModule
+	G     *ts.Tensor
+	V     *ts.Tensor
+	Dim   int64
+
+	weight *ts.Tensor // last computed effective weight
+}
+
+// NewWeightNorm wraps layer with weight normalization along dim, registering
+// `weight_g` and `weight_v` in vs initialized from the current layer weight.
+//
+// NOTE: the layer weight keeps its own variable in the var-store. It is not
+// used in forward pass anymore so it will not receive gradients.
+// Linear layer stores its weight with shape [outDim, inDim], hence dim 0
+// normalizes the weights of each output feature.
+func NewWeightNorm(vs *Path, layer WeightedModule, dim int64) *WeightNorm {
+	w := layer.WeightTensor()
+	if dim < 0 || dim >= int64(w.Dim()) {
+		log.Fatalf("NewWeightNorm() failed: invalid dim %v for weight of shape %v.\n", dim, w.MustSize())
+	}
+
+	var g *ts.Tensor
+	ts.NoGrad(func() {
+		g = ts.MustNormExceptDim(w, 2, dim)
+	})
+
+	wn := &WeightNorm{
+		Layer: layer,
+		G:     vs.VarCopy("weight_g", g),
+		V:     vs.VarCopy("weight_v", w),
+		Dim:   dim,
+	}
+	g.MustDrop()
+
+	return wn
+}
+
+// Weight computes the effective weight `g * v/||v||`.
+func (wn *WeightNorm) Weight() *ts.Tensor {
+	norm := ts.MustNormExceptDim(wn.V, 2, wn.Dim)
+	scale := wn.G.MustDiv(norm, false)
+	norm.MustDrop()
+	w := wn.V.MustMul(scale, false)
+	scale.MustDrop()
+
+	return w
+}
+
+// setWeight recomputes the effective weight and sets it to the wrapped layer.
+func (wn *WeightNorm) setWeight() {
+	w := wn.Weight()
+	wn.Layer.SetWeightTensor(w)
+	if wn.weight != nil {
+		wn.weight.MustDrop()
+	}
+	wn.weight = w
+}
+
+// Forward implements Module interface for WeightNorm.
+func (wn *WeightNorm) Forward(xs *ts.Tensor) *ts.Tensor {
+	wn.setWeight()
+	return wn.Layer.Forward(xs)
+}
+
+// ForwardT implements ModuleT interface for WeightNorm.
+func (wn *WeightNorm) ForwardT(xs *ts.Tensor, train bool) *ts.Tensor {
+	wn.setWeight()
+	return wn.Layer.ForwardT(xs, train)
+}
@@ -0,0 +1,45 @@
+package nn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestWeightNorm(t *testing.T) {
+	vs := nn.NewVarStore(gotch.CPU)
+	path := vs.Root()
+	linear := nn.NewLinear(path.Sub("linear"), 3, 2, nn.DefaultLinearConfig())
+	wn := nn.NewWeightNorm(path.Sub("wn"), linear, 0)
+
+	// g keeps one norm per output feature.
+	if want, got := []int64{2, 1}, wn.G.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected g shape: %v\n", want)
+		t.Errorf("Got g shape: %v\n", got)
+	}
+
+	ts.NoGrad(func() {
+		wn.G.MustMulScalar_(ts.FloatScalar(3.0))
+	})
+
+	input := ts.MustRandn([]int64{4, 3}, gotch.Float, gotch.CPU)
+	out := wn.Forward(input)
+	if want, got := []int64{4, 2}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+
+	norm := ts.MustNormExceptDim(linear.WeightTensor(), 2, 0)
+	assertAllClose(t, "weight norm", wn.G.Float64Values(), norm.Float64Values())
+
+	// gradients flow to g and v
+	out.MustSum(gotch.Float, true).MustBackward()
+	for name, x := range map[string]*ts.Tensor{"g": wn.G, "v": wn.V} {
+		if !x.MustGrad(false).MustDefined() {
+			t.Errorf("Expected gradient for %v, got undefined\n", name)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package gotch
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// ManualSeed seeds the libtorch global random number generator for CPU and
+// CUDA devices, and the Go `math/rand` global source used by the CPU
+// initializers, so that random tensors and parameter initialization are
+// reproducible.
+func ManualSeed(seed int64) {
+	lib.AtManualSeed(seed)
+	rand.Seed(seed)
+}
+
+// ManualSeedAll works as ManualSeed and additionally seeds the random number
+// generators of all GPUs explicitly. It is a no-op for CUDA on a CPU-only build.
+func ManualSeedAll(seed int64) error {
+	ManualSeed(seed)
+	lib.AtcManualSeedAll(seed)
+	if errStr := lib.GetAndResetLastErrString(); errStr != "" {
+		return fmt.Errorf("ManualSeedAll() failed: Libtorch API Error: %v\n", errStr)
+	}
+
+	return nil
+}
+
+// MustManualSeedAll works as ManualSeedAll. It panics if error.
+func MustManualSeedAll(seed int64) {
+	if err := ManualSeedAll(seed); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,64 @@
+package gotch_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/nn"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestManualSeed(t *testing.T) {
+	gotch.ManualSeed(42)
+	x := ts.MustRandn([]int64{4, 5}, gotch.Float, gotch.CPU)
+
+	gotch.ManualSeed(42)
+	y := ts.MustRandn([]int64{4, 5}, gotch.Float, gotch.CPU)
+
+	if !reflect.DeepEqual(x.Float64Values(), y.Float64Values()) {
+		t.Errorf("Expected identical tensors after reseeding\n")
+		t.Errorf("Got: %v and %v\n", x.Float64Values(), y.Float64Values())
+	}
+
+	z := ts.MustRandn([]int64{4, 5}, gotch.Float, gotch.CPU)
+	if reflect.DeepEqual(y.Float64Values(), z.Float64Values()) {
+		t.Errorf("Expected different tensors without reseeding\n")
+	}
+}
+
+func TestManualSeedInit(t *testing.T) {
+	init := nn.NewSparseInit(0.5, 1.0)
+
+	gotch.ManualSeed(7)
+	x, err := init.InitTensor([]int64{6, 4}, gotch.CPU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotch.ManualSeed(7)
+	y, err := init.InitTensor([]int64{6, 4}, gotch.CPU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(x.Float64Values(), y.Float64Values()) {
+		t.Errorf("Expected identical initialization after reseeding\n")
+		t.Errorf("Got: %v and %v\n", x.Float64Values(), y.Float64Values())
+	}
+}
+
+func TestManualSeedAll(t *testing.T) {
+	if err := gotch.ManualSeedAll(42); err != nil {
+		t.Fatal(err)
+	}
+	x := ts.MustRandn([]int64{4, 5}, gotch.Float, gotch.CPU)
+
+	gotch.MustManualSeedAll(42)
+	y := ts.MustRandn([]int64{4, 5}, gotch.Float, gotch.CPU)
+
+	if !reflect.DeepEqual(x.Float64Values(), y.Float64Values()) {
+		t.Errorf("Expected identical tensors after reseeding\n")
+		t.Errorf("Got: %v and %v\n", x.Float64Values(), y.Float64Values())
+	}
+}
@@ -0,0 +1,97 @@
+package tensor
+
+// Arithmetic with Go scalars.
+
+import (
+	"log"
+)
+
+// NOTE: tensor-tensor arithmetic `Add`, `Sub`, `Mul`, `Div` and `Pow1`
+// broadcasts operands following PyTorch rules, e.g. tensors of shape [3, 1]
+// and [1, 4] give a tensor of shape [3, 4]. Incompatible shapes return the
+// error from libtorch.
+// The scalar variants below follow PyTorch type promotion, i.e. a float
+// scalar with an integer tensor gives a float tensor.
+
+// scalarOp applies fn to a scalar of value s and frees the scalar.
+func scalarOp(fn func(*Scalar, bool) (*Tensor, error), s float64, del bool) (*Tensor, error) {
+	sc := FloatScalar(s)
+	defer sc.MustDrop()
+
+	return fn(sc, del)
+}
+
+// AddScalar adds s to every element of ts.
+func (ts *Tensor) AddScalar(s float64, del bool) (*Tensor, error) {
+	return scalarOp(ts.Add1, s, del)
+}
+
+// MustAddScalar adds s to every element of ts. It panics if error.
+func (ts *Tensor) MustAddScalar(s float64, del bool) *Tensor {
+	retVal, err := ts.AddScalar(s, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// SubScalar subtracts s from every element of ts.
+func (ts *Tensor) SubScalar(s float64, del bool) (*Tensor, error) {
+	return scalarOp(ts.Sub1, s, del)
+}
+
+// MustSubScalar subtracts s from every element of ts. It panics if error.
+func (ts *Tensor) MustSubScalar(s float64, del bool) *Tensor {
+	retVal, err := ts.SubScalar(s, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// MulScalar multiplies every element of ts by s.
+func (ts *Tensor) MulScalar(s float64, del bool) (*Tensor, error) {
+	return scalarOp(ts.Mul1, s, del)
+}
+
+// MustMulScalar multiplies every element of ts by s. It panics if error.
+func (ts *Tensor) MustMulScalar(s float64, del bool) *Tensor {
+	retVal, err := ts.MulScalar(s, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// DivScalar divides every element of ts by s.
+func (ts *Tensor) DivScalar(s float64, del bool) (*Tensor, error) {
+	return scalarOp(ts.Div1, s, del)
+}
+
+// MustDivScalar divides every element of ts by s. It panics if error.
+func (ts *Tensor) MustDivScalar(s float64, del bool) *Tensor {
+	retVal, err := ts.DivScalar(s, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// PowScalar raises every element of ts to the power s.
+func (ts *Tensor) PowScalar(s float64, del bool) (*Tensor, error) {
+	return scalarOp(ts.Pow, s, del)
+}
+
+// MustPowScalar raises every element of ts to the power s. It panics if error.
+func (ts *Tensor) MustPowScalar(s float64, del bool) *Tensor {
+	retVal, err := ts.PowScalar(s, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
@@ -0,0 +1,94 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestBroadcastArith(t *testing.T) {
+	col := ts.MustOfSlice([]float64{1, 2, 3}).MustView([]int64{3, 1}, true)
+	row := ts.MustOfSlice([]float64{10, 20, 30, 40}).MustView([]int64{1, 4}, true)
+
+	for _, tc := range []struct {
+		name string
+		fn   func(a, b *ts.Tensor) *ts.Tensor
+		want []float64
+	}{
+		{"add", func(a, b *ts.Tensor) *ts.Tensor { return a.MustAdd(b, false) }, []float64{
+			11, 21, 31, 41,
+			12, 22, 32, 42,
+			13, 23, 33, 43,
+		}},
+		{"sub", func(a, b *ts.Tensor) *ts.Tensor { return a.MustSub(b, false) }, []float64{
+			-9, -19, -29, -39,
+			-8, -18, -28, -38,
+			-7, -17, -27, -37,
+		}},
+		{"mul", func(a, b *ts.Tensor) *ts.Tensor { return a.MustMul(b, false) }, []float64{
+			10, 20, 30, 40,
+			20, 40, 60, 80,
+			30, 60, 90, 120,
+		}},
+		{"div", func(a, b *ts.Tensor) *ts.Tensor { return b.MustDiv(a, false) }, []float64{
+			10, 20, 30, 40,
+			5, 10, 15, 20,
+			10.0 / 3, 20.0 / 3, 10, 40.0 / 3,
+		}},
+	} {
+		res := tc.fn(col, row)
+		if want, got := []int64{3, 4}, res.MustSize(); !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected broadcast %v shape: %v\n", tc.name, want)
+			t.Errorf("Got broadcast %v shape: %v\n", tc.name, got)
+		}
+		assertClose(t, "broadcast "+tc.name, tc.want, res.Float64Values())
+		res.MustDrop()
+	}
+
+	// incompatible shapes
+	other := ts.MustOnes([]int64{2, 4}, gotch.Double, gotch.CPU)
+	if _, err := col.Add(other, false); err == nil {
+		t.Errorf("Expected error adding tensors of shape [3, 1] and [2, 4], got nil\n")
+	}
+
+	col.MustDrop()
+	row.MustDrop()
+	other.MustDrop()
+}
+
+func TestScalarArith(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3, 4}).MustView([]int64{2, 2}, true)
+
+	for _, tc := range []struct {
+		name string
+		res  *ts.Tensor
+		want []float64
+	}{
+		{"add", x.MustAddScalar(1.5, false), []float64{2.5, 3.5, 4.5, 5.5}},
+		{"sub", x.MustSubScalar(1, false), []float64{0, 1, 2, 3}},
+		{"mul", x.MustMulScalar(-2, false), []float64{-2, -4, -6, -8}},
+		{"div", x.MustDivScalar(4, false), []float64{0.25, 0.5, 0.75, 1}},
+		{"pow", x.MustPowScalar(2, false), []float64{1, 4, 9, 16}},
+	} {
+		if want, got := []int64{2, 2}, tc.res.MustSize(); !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected scalar %v shape: %v\n", tc.name, want)
+			t.Errorf("Got scalar %v shape: %v\n", tc.name, got)
+		}
+		assertClose(t, "scalar "+tc.name, tc.want, tc.res.Float64Values())
+		tc.res.MustDrop()
+	}
+
+	// type promotion
+	ints := ts.MustOfSlice([]int64{1, 2})
+	res := ints.MustAddScalar(0.5, true)
+	if want, got := gotch.Float, res.DType(); want != got {
+		t.Errorf("Expected promoted dtype: %v\n", want)
+		t.Errorf("Got promoted dtype: %v\n", got)
+	}
+	assertClose(t, "promoted add", []float64{1.5, 2.5}, res.Float64Values())
+
+	res.MustDrop()
+	x.MustDrop()
+}
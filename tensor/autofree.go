@@ -0,0 +1,58 @@
+package tensor
+
+// Opt-in freeing of C tensors by the Go garbage collector.
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+var autoFreeEnabled int32
+
+// EnableAutoFree makes tensors created from now on free their C memory when
+// they are garbage collected, so that forgetting `MustDrop` does not leak.
+//
+// Tensors can still be dropped manually, which is recommended in hot loops:
+// the Go garbage collector does not see the C memory held by a tensor and may
+// run too late to keep memory (especially GPU memory) bounded. Finalizers also
+// add overhead to every tensor allocation.
+//
+// NOTE: tensor functions keep their tensor arguments reachable with
+// `runtime.KeepAlive` until libtorch returns, so that a tensor is not freed
+// while an op still uses it.
+func EnableAutoFree() {
+	atomic.StoreInt32(&autoFreeEnabled, 1)
+}
+
+// DisableAutoFree stops attaching finalizers to tensors created from now on.
+// Tensors created while auto free was enabled keep their finalizers.
+func DisableAutoFree() {
+	atomic.StoreInt32(&autoFreeEnabled, 0)
+}
+
+// tensorGuard is shared by all copies of a Tensor created while auto free is
+// enabled. Its finalizer frees the C tensor once no copy refers to it and the
+// freed flag guards against double free with a manual Drop.
+type tensorGuard struct {
+	ctensor lib.Ctensor
+	freed   int32
+}
+
+func newTensorGuard(ctensor lib.Ctensor) *tensorGuard {
+	g := &tensorGuard{ctensor: ctensor}
+	runtime.SetFinalizer(g, func(g *tensorGuard) {
+		if g.markFreed() {
+			untrackTensor(g.ctensor)
+			lib.AtFree(g.ctensor)
+		}
+	})
+
+	return g
+}
+
+// markFreed returns true the first time it is called.
+func (g *tensorGuard) markFreed() bool {
+	return atomic.CompareAndSwapInt32(&g.freed, 0, 1)
+}
@@ -0,0 +1,45 @@
+package tensor_test
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestAutoFree(t *testing.T) {
+	ts.SetTrackTensors(true)
+	defer ts.SetTrackTensors(false)
+	ts.EnableAutoFree()
+	defer ts.DisableAutoFree()
+
+	baseline := ts.LiveTensorCount()
+
+	func() {
+		for i := 0; i < 10; i++ {
+			ts.MustOnes([]int64{4, 4}, gotch.Float, gotch.CPU)
+		}
+	}()
+	if got := ts.LiveTensorCount(); got < baseline+10 {
+		t.Fatalf("Expected at least %v live tensors before GC, got %v\n", baseline+10, got)
+	}
+
+	// finalizers run asynchronously after collection
+	for i := 0; i < 50 && ts.LiveTensorCount() > baseline; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	if want, got := baseline, ts.LiveTensorCount(); want != got {
+		t.Errorf("Expected live tensor count after GC: %v\n", want)
+		t.Errorf("Got live tensor count: %v\n", got)
+	}
+
+	// manual drop followed by finalizer must not double free
+	x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+	y := *x
+	x.MustDrop()
+	y.MustDrop()
+	runtime.GC()
+}
@@ -0,0 +1,81 @@
+package tensor
+
+// Elementwise clamping with tensor bounds.
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// NOTE: clamping with scalar bounds is provided by the generated `Clamp`,
+// `ClampMin` and `ClampMax` (and in-place `_` variants). libtorch 1.7 has no
+// clamp with tensor bounds, so it is composed of `Maximum` and `Minimum`.
+
+// ClampTensor clamps every element of ts to the range [min, max] given by
+// elementwise bounds broadcastable to ts. Either bound can be nil (but not
+// both) for one-sided clamping.
+func (ts *Tensor) ClampTensor(min, max *Tensor, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	if min == nil && max == nil {
+		err := fmt.Errorf("ClampTensor() failed: at least one of min and max should be given.\n")
+		return nil, err
+	}
+
+	retVal := ts
+	if min != nil {
+		clamped, err := retVal.Maximum(min, false)
+		if err != nil {
+			return nil, err
+		}
+		retVal = clamped
+	}
+	if max != nil {
+		clamped, err := retVal.Minimum(max, retVal != ts)
+		if err != nil {
+			return nil, err
+		}
+		retVal = clamped
+	}
+
+	return retVal, nil
+}
+
+// MustClampTensor clamps every element of ts to elementwise bounds. It panics
+// if error.
+func (ts *Tensor) MustClampTensor(min, max *Tensor, del bool) *Tensor {
+	retVal, err := ts.ClampTensor(min, max, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// ClampTensor_ clamps every element of ts in place to elementwise bounds.
+// Bounds should broadcast to the shape of ts.
+func (ts *Tensor) ClampTensor_(min, max *Tensor) error {
+	clamped, err := ts.ClampTensor(min, max, false)
+	if err != nil {
+		return err
+	}
+	defer clamped.MustDrop()
+
+	lib.AtCopy_(ts.ctensor, clamped.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(clamped)
+	return TorchErr()
+}
+
+// MustClampTensor_ clamps every element of ts in place to elementwise bounds.
+// It panics if error.
+func (ts *Tensor) MustClampTensor_(min, max *Tensor) {
+	if err := ts.ClampTensor_(min, max); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,97 @@
+package tensor_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestClamp(t *testing.T) {
+	x := ts.MustOfSlice([]float64{-5, -1, 0, 0.5, 2, 10})
+
+	for _, tc := range []struct {
+		name string
+		res  *ts.Tensor
+		want []float64
+	}{
+		{"clamp", x.MustClamp(ts.FloatScalar(-1), ts.FloatScalar(1), false), []float64{-1, -1, 0, 0.5, 1, 1}},
+		{"clamp min", x.MustClampMin(ts.FloatScalar(0), false), []float64{0, 0, 0, 0.5, 2, 10}},
+		{"clamp max", x.MustClampMax(ts.FloatScalar(1), false), []float64{-5, -1, 0, 0.5, 1, 1}},
+	} {
+		if got := tc.res.Float64Values(); !reflect.DeepEqual(tc.want, got) {
+			t.Errorf("Expected %v values: %v\n", tc.name, tc.want)
+			t.Errorf("Got %v values: %v\n", tc.name, got)
+		}
+		tc.res.MustDrop()
+	}
+
+	// clamping log inputs
+	probs := ts.MustOfSlice([]float64{0, 0.5, 1})
+	logs := probs.MustClampMin(ts.FloatScalar(1e-12), false).MustLog(true).Float64Values()
+	for _, v := range logs {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			t.Errorf("Expected finite log values, got: %v\n", logs)
+			break
+		}
+	}
+	probs.MustDrop()
+
+	// in place
+	y := x.MustShallowClone()
+	y.MustClamp_(ts.FloatScalar(-2), ts.FloatScalar(2))
+	if want, got := []float64{-2, -1, 0, 0.5, 2, 2}, y.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected in-place clamp values: %v\n", want)
+		t.Errorf("Got in-place clamp values: %v\n", got)
+	}
+	y.MustDrop()
+	x.MustDrop()
+}
+
+func TestClampTensor(t *testing.T) {
+	x := ts.MustOfSlice([]float64{-5, -1, 0, 0.5, 2, 10}).MustView([]int64{2, 3}, true)
+	// per column bounds broadcast over rows
+	min := ts.MustOfSlice([]float64{-2, 0, 1})
+	max := ts.MustOfSlice([]float64{-1, 1, 5})
+
+	for _, tc := range []struct {
+		name     string
+		min, max *ts.Tensor
+		want     []float64
+	}{
+		{"clamp", min, max, []float64{-2, 0, 1, -1, 1, 5}},
+		{"clamp min", min, nil, []float64{-2, 0, 1, 0.5, 2, 10}},
+		{"clamp max", nil, max, []float64{-5, -1, 0, -1, 1, 5}},
+	} {
+		res := x.MustClampTensor(tc.min, tc.max, false)
+		if got := res.Float64Values(); !reflect.DeepEqual(tc.want, got) {
+			t.Errorf("Expected tensor %v values: %v\n", tc.name, tc.want)
+			t.Errorf("Got tensor %v values: %v\n", tc.name, got)
+		}
+		res.MustDrop()
+	}
+
+	if _, err := x.ClampTensor(nil, nil, false); err == nil {
+		t.Errorf("Expected error for missing bounds, got nil\n")
+	}
+
+	// in place
+	y := x.MustShallowClone()
+	y.MustClampTensor_(min, nil)
+	if want, got := []float64{-2, 0, 1, 0.5, 2, 10}, y.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected in-place tensor clamp values: %v\n", want)
+		t.Errorf("Got in-place tensor clamp values: %v\n", got)
+	}
+	// bounds should not broadcast beyond the shape of the tensor
+	big := ts.MustOfSlice([]float64{0, 0, 0, 0, 0, 0, 0, 0, 0}).MustView([]int64{3, 3}, true)
+	if err := min.ClampTensor_(big, nil); err == nil {
+		t.Errorf("Expected error for in-place clamp with larger bounds, got nil\n")
+	}
+
+	y.MustDrop()
+	big.MustDrop()
+	min.MustDrop()
+	max.MustDrop()
+	x.MustDrop()
+}
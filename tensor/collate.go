@@ -0,0 +1,68 @@
+package tensor
+
+// Collating samples into batches.
+
+import (
+	"fmt"
+	"log"
+)
+
+// Sample is an (input, target) pair of tensors, e.g. an item of a
+// `dutil.Dataset` to be batched with `Collate`.
+type Sample struct {
+	X *Tensor
+	Y *Tensor
+}
+
+// Collate stacks a batch of samples into batched input and target tensors
+// along a new first dimension.
+//
+// batch is a `Sample` or a `[]Sample`, e.g. as returned by
+// `dutil.DataLoader.Next` for a dataset of samples without or with batching.
+// Samples are not dropped.
+func Collate(batch interface{}) (xs, ys *Tensor, err error) {
+	var samples []Sample
+	switch b := batch.(type) {
+	case Sample:
+		samples = []Sample{b}
+	case []Sample:
+		samples = b
+	default:
+		err = fmt.Errorf("Collate() failed: expected a Sample or []Sample batch, got %T.\n", batch)
+		return nil, nil, err
+	}
+	if len(samples) == 0 {
+		err = fmt.Errorf("Collate() failed: empty batch.\n")
+		return nil, nil, err
+	}
+
+	inputs := make([]Tensor, len(samples))
+	targets := make([]Tensor, len(samples))
+	for i, s := range samples {
+		inputs[i] = *s.X
+		targets[i] = *s.Y
+	}
+
+	xs, err = Stack(inputs, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Collate() failed: %v", err)
+	}
+	ys, err = Stack(targets, 0)
+	if err != nil {
+		xs.MustDrop()
+		return nil, nil, fmt.Errorf("Collate() failed: %v", err)
+	}
+
+	return xs, ys, nil
+}
+
+// MustCollate stacks a batch of samples into batched input and target
+// tensors. It panics if error.
+func MustCollate(batch interface{}) (xs, ys *Tensor) {
+	xs, ys, err := Collate(batch)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return xs, ys
+}
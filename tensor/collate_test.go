@@ -0,0 +1,65 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	"github.com/sugarme/gotch/dutil"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestCollate(t *testing.T) {
+	var samples []ts.Sample
+	for i := 0; i < 5; i++ {
+		x := ts.MustOfSlice([]float32{float32(i), float32(i)})
+		y := ts.MustOfSlice([]int64{int64(i)}).MustView([]int64{}, true)
+		samples = append(samples, ts.Sample{X: x, Y: y})
+	}
+
+	data, err := dutil.NewSliceDataset(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := dutil.NewBatchSampler(data.Len(), 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dl, err := dutil.NewDataLoader(data, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotShapes [][]int64
+	var gotYs []int64
+	for dl.HasNext() {
+		batch, err := dl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		xs, ys := ts.MustCollate(batch)
+		gotShapes = append(gotShapes, xs.MustSize())
+		gotYs = append(gotYs, ys.Int64Values()...)
+		if xs.DType() != gotch.Float {
+			t.Errorf("Expected batched input dtype: %v\n", gotch.Float)
+			t.Errorf("Got batched input dtype: %v\n", xs.DType())
+		}
+		xs.MustDrop()
+		ys.MustDrop()
+	}
+
+	// last partial batch is kept
+	wantShapes := [][]int64{{2, 2}, {2, 2}, {1, 2}}
+	if !reflect.DeepEqual(wantShapes, gotShapes) {
+		t.Errorf("Expected batch shapes: %v\n", wantShapes)
+		t.Errorf("Got batch shapes: %v\n", gotShapes)
+	}
+	if want := []int64{0, 1, 2, 3, 4}; !reflect.DeepEqual(want, gotYs) {
+		t.Errorf("Expected targets: %v\n", want)
+		t.Errorf("Got targets: %v\n", gotYs)
+	}
+
+	if _, _, err := ts.Collate([]int{1, 2}); err == nil {
+		t.Errorf("Expected error for a batch of non samples, got nil\n")
+	}
+}
@@ -0,0 +1,66 @@
+package tensor
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+)
+
+// Equal returns true if the tensor and other have the same shape, dtype and
+// values. It returns false for any mismatch, including shapes or dtypes.
+//
+// NOTE: NaN values are never equal.
+func (ts *Tensor) Equal(other *Tensor) bool {
+	if ts.DType() != other.DType() || !reflect.DeepEqual(ts.MustSize(), other.MustSize()) {
+		return false
+	}
+
+	eq, err := ts.Eq1(other, false)
+	if err != nil {
+		return false
+	}
+
+	return allTrue(eq)
+}
+
+// AllClose checks whether all elements of the tensor and other satisfy
+// `|ts - other| <= atol + rtol * |other|`.
+//
+// An error is returned if the tensors have different shapes or dtypes.
+func (ts *Tensor) AllClose(other *Tensor, rtol, atol float64) (bool, error) {
+	if dtype, otherDType := ts.DType(), other.DType(); dtype != otherDType {
+		err := fmt.Errorf("AllClose() failed: mismatched dtypes %v and %v.\n", dtype, otherDType)
+		return false, err
+	}
+	if shape, otherShape := ts.MustSize(), other.MustSize(); !reflect.DeepEqual(shape, otherShape) {
+		err := fmt.Errorf("AllClose() failed: mismatched shapes %v and %v.\n", shape, otherShape)
+		return false, err
+	}
+
+	closeTs, err := ts.Isclose(other, rtol, atol, false, false)
+	if err != nil {
+		return false, err
+	}
+
+	return allTrue(closeTs), nil
+}
+
+// MustAllClose checks whether all elements of the tensor and other are close
+// (see `AllClose`). It panics if error.
+func (ts *Tensor) MustAllClose(other *Tensor, rtol, atol float64) bool {
+	retVal, err := ts.AllClose(other, rtol, atol)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// allTrue reduces a boolean tensor and drops it.
+func allTrue(x *Tensor) bool {
+	all := x.MustAll(true)
+	retVal := all.Int64Values()[0] != 0
+	all.MustDrop()
+
+	return retVal
+}
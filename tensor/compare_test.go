@@ -0,0 +1,49 @@
+package tensor_test
+
+import (
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestEqual(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3, 4})
+	y := ts.MustOfSlice([]float64{1, 2, 3, 4})
+
+	if !x.Equal(y) {
+		t.Errorf("Expected identical tensors to be equal\n")
+	}
+	if x.Equal(ts.MustOfSlice([]float64{1, 2, 3, 4.000001})) {
+		t.Errorf("Expected tensors with different values not to be equal\n")
+	}
+	if x.Equal(y.MustView([]int64{2, 2}, false)) {
+		t.Errorf("Expected tensors with different shapes not to be equal\n")
+	}
+	if x.Equal(y.MustTotype(gotch.Float, false)) {
+		t.Errorf("Expected tensors with different dtypes not to be equal\n")
+	}
+}
+
+func TestAllClose(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3, 4})
+
+	ok, err := x.AllClose(ts.MustOfSlice([]float64{1, 2, 3, 4.00001}), 1e-5, 1e-8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Errorf("Expected tensors within tolerance to be close\n")
+	}
+
+	if x.MustAllClose(ts.MustOfSlice([]float64{1, 2, 3, 4.1}), 1e-5, 1e-8) {
+		t.Errorf("Expected tensors outside tolerance not to be close\n")
+	}
+
+	if _, err := x.AllClose(x.MustView([]int64{2, 2}, false), 1e-5, 1e-8); err == nil {
+		t.Errorf("Expected error for mismatched shapes, got nil\n")
+	}
+	if _, err := x.AllClose(x.MustTotype(gotch.Float, false), 1e-5, 1e-8); err == nil {
+		t.Errorf("Expected error for mismatched dtypes, got nil\n")
+	}
+}
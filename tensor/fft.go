@@ -0,0 +1,175 @@
+package tensor
+
+// Discrete Fourier transforms.
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/sugarme/gotch"
+)
+
+// NOTE: FFT ops take a normalization mode `norm`, one of:
+// - "backward": no normalization on forward transforms, 1/n on inverse ones (default if empty)
+// - "forward": 1/n on forward transforms, no normalization on inverse ones
+// - "ortho": 1/sqrt(n) on both
+// where n is the signal length.
+
+func isComplexDType(dtype gotch.DType) bool {
+	return dtype == gotch.ComplexFloat || dtype == gotch.ComplexDouble
+}
+
+func isFloatingDType(dtype gotch.DType) bool {
+	switch dtype {
+	case gotch.Half, gotch.BFloat16, gotch.Float, gotch.Double:
+		return true
+	}
+	return false
+}
+
+// fftNorm validates norm and returns the default mode if empty.
+func fftNorm(fn, norm string) (string, error) {
+	switch norm {
+	case "":
+		return "backward", nil
+	case "backward", "forward", "ortho":
+		return norm, nil
+	}
+
+	err := fmt.Errorf("%v() failed: invalid norm %q, expected \"backward\", \"forward\" or \"ortho\".\n", fn, norm)
+	return "", err
+}
+
+// FFT computes the one dimensional discrete Fourier transform of ts over dim.
+// Real input is treated as complex with zero imaginary part. It returns a
+// complex tensor.
+func (ts *Tensor) FFT(dim int64, norm string, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	if dtype := ts.DType(); !isFloatingDType(dtype) && !isComplexDType(dtype) {
+		err := fmt.Errorf("FFT() failed: expected a floating point or complex input, got %v.\n", dtype)
+		return nil, err
+	}
+	norm, err := fftNorm("FFT", norm)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.FftFft(nil, dim, norm, false)
+}
+
+// MustFFT computes the one dimensional discrete Fourier transform of ts over
+// dim. It panics if error.
+func (ts *Tensor) MustFFT(dim int64, norm string, del bool) *Tensor {
+	retVal, err := ts.FFT(dim, norm, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// IFFT computes the one dimensional inverse discrete Fourier transform of a
+// complex tensor ts over dim. It returns a complex tensor, see `Real` to get
+// back a real signal.
+func (ts *Tensor) IFFT(dim int64, norm string, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	if dtype := ts.DType(); !isComplexDType(dtype) {
+		err := fmt.Errorf("IFFT() failed: expected a complex input, got %v.\n", dtype)
+		return nil, err
+	}
+	norm, err := fftNorm("IFFT", norm)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.FftIfft(nil, dim, norm, false)
+}
+
+// MustIFFT computes the one dimensional inverse discrete Fourier transform of
+// ts over dim. It panics if error.
+func (ts *Tensor) MustIFFT(dim int64, norm string, del bool) *Tensor {
+	retVal, err := ts.IFFT(dim, norm, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// RFFT computes the one dimensional discrete Fourier transform of a real
+// tensor ts over dim. Only the n/2 + 1 non-negative frequencies are returned
+// as the others are redundant (Hermitian symmetry).
+func (ts *Tensor) RFFT(dim int64, norm string, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	if dtype := ts.DType(); !isFloatingDType(dtype) {
+		err := fmt.Errorf("RFFT() failed: expected a real floating point input, got %v.\n", dtype)
+		return nil, err
+	}
+	norm, err := fftNorm("RFFT", norm)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.FftRfft(nil, dim, norm, false)
+}
+
+// MustRFFT computes the one dimensional discrete Fourier transform of a real
+// tensor ts over dim. It panics if error.
+func (ts *Tensor) MustRFFT(dim int64, norm string, del bool) *Tensor {
+	retVal, err := ts.RFFT(dim, norm, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// STFT computes the short-time Fourier transform of a real signal ts of shape
+// [length] or [batch, length].
+//
+// Frames of nFft samples are taken every hopLength samples and multiplied by
+// window of size nFft. A nil window is a rectangular window. It returns a
+// complex tensor of shape [(batch,) nFft/2 + 1, nFrames].
+//
+// NOTE: the signal is not padded, i.e. nFrames = 1 + (length - nFft)/hopLength.
+func (ts *Tensor) STFT(nFft, hopLength int64, window *Tensor, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	if dtype := ts.DType(); !isFloatingDType(dtype) {
+		err := fmt.Errorf("STFT() failed: expected a real floating point input, got %v.\n", dtype)
+		return nil, err
+	}
+	if nFft <= 0 || hopLength <= 0 {
+		err := fmt.Errorf("STFT() failed: expected positive nFft and hopLength, got %v and %v.\n", nFft, hopLength)
+		return nil, err
+	}
+
+	if window == nil {
+		window = NewTensor()
+		defer window.MustDrop()
+	}
+
+	return ts.Stft(nFft, []int64{hopLength}, []int64{nFft}, window, false, true, true, false)
+}
+
+// MustSTFT computes the short-time Fourier transform of a real signal. It
+// panics if error.
+func (ts *Tensor) MustSTFT(nFft, hopLength int64, window *Tensor, del bool) *Tensor {
+	retVal, err := ts.STFT(nFft, hopLength, window, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
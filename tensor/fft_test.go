@@ -0,0 +1,118 @@
+package tensor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestRFFT(t *testing.T) {
+	// sinusoid with 5 cycles over 64 samples
+	n, k := 64, 5
+	data := make([]float32, n)
+	for i := range data {
+		data[i] = float32(math.Sin(2 * math.Pi * float64(k*i) / float64(n)))
+	}
+	x := ts.MustOfSlice(data)
+
+	spec := x.MustRFFT(0, "", false)
+	if want, got := gotch.ComplexFloat, spec.DType(); want != got {
+		t.Errorf("Expected RFFT dtype: %v\n", want)
+		t.Errorf("Got RFFT dtype: %v\n", got)
+	}
+	if want, got := int64(n/2+1), spec.MustSize()[0]; want != got {
+		t.Errorf("Expected RFFT number of bins: %v\n", want)
+		t.Errorf("Got RFFT number of bins: %v\n", got)
+	}
+
+	mag := spec.MustAbs(false)
+	if want, got := int64(k), mag.MustArgmax([]int64{0}, false, false).Int64Values()[0]; want != got {
+		t.Errorf("Expected peak frequency bin: %v\n", want)
+		t.Errorf("Got peak frequency bin: %v\n", got)
+	}
+	// peak magnitude of a unit sinusoid is n/2 without normalization
+	if want, got := float64(n)/2, mag.Float64Values()[k]; math.Abs(want-got) > 1e-3 {
+		t.Errorf("Expected peak magnitude: %v\n", want)
+		t.Errorf("Got peak magnitude: %v\n", got)
+	}
+
+	// complex input is rejected
+	if _, err := spec.RFFT(0, "", false); err == nil {
+		t.Errorf("Expected error for complex RFFT input, got nil\n")
+	}
+	if _, err := x.RFFT(0, "invalid", false); err == nil {
+		t.Errorf("Expected error for invalid norm, got nil\n")
+	}
+
+	spec.MustDrop()
+	mag.MustDrop()
+	x.MustDrop()
+}
+
+func TestFFTRoundTrip(t *testing.T) {
+	want := []float64{0.5, -1, 2, 3.25, 0, -4, 1.5, 7}
+	x := ts.MustOfSlice(want)
+
+	for _, norm := range []string{"backward", "forward", "ortho"} {
+		spec := x.MustFFT(0, norm, false)
+		if got := spec.DType(); got != gotch.ComplexDouble {
+			t.Errorf("Expected FFT dtype: %v\n", gotch.ComplexDouble)
+			t.Errorf("Got FFT dtype: %v\n", got)
+		}
+
+		y := spec.MustIFFT(0, norm, true)
+		re := y.MustReal(false)
+		im := y.MustImag(false)
+		assertClose(t, "IFFT(FFT(x)) real part with norm "+norm, want, re.Float64Values())
+		assertClose(t, "IFFT(FFT(x)) imaginary part with norm "+norm, make([]float64, len(want)), im.Float64Values())
+
+		y.MustDrop()
+		re.MustDrop()
+		im.MustDrop()
+	}
+
+	// real input is rejected
+	if _, err := x.IFFT(0, "", false); err == nil {
+		t.Errorf("Expected error for real IFFT input, got nil\n")
+	}
+	x.MustDrop()
+}
+
+func TestSTFT(t *testing.T) {
+	n, k, nFft, hop := 256, 8, int64(32), int64(16)
+	data := make([]float32, n)
+	for i := range data {
+		data[i] = float32(math.Cos(2 * math.Pi * float64(k*i) / float64(nFft)))
+	}
+	x := ts.MustOfSlice(data)
+	window := ts.MustHannWindow(nFft, gotch.Float, gotch.CPU)
+
+	spec := x.MustSTFT(nFft, hop, window, false)
+	wantShape := []int64{nFft/2 + 1, 1 + (int64(n)-nFft)/hop}
+	if got := spec.MustSize(); got[0] != wantShape[0] || got[1] != wantShape[1] {
+		t.Errorf("Expected STFT shape: %v\n", wantShape)
+		t.Errorf("Got STFT shape: %v\n", got)
+	}
+
+	// every frame peaks at bin k
+	peaks := spec.MustAbs(false).MustArgmax([]int64{0}, false, true).Int64Values()
+	for _, p := range peaks {
+		if p != int64(k) {
+			t.Errorf("Expected STFT peak bin of all frames: %v\n", k)
+			t.Errorf("Got STFT peak bins: %v\n", peaks)
+			break
+		}
+	}
+
+	intX := ts.MustOnes([]int64{int64(n)}, gotch.Int64, gotch.CPU)
+	if _, err := intX.STFT(nFft, hop, nil, false); err == nil {
+		t.Errorf("Expected error for integer STFT input, got nil\n")
+	}
+
+	spec.MustDrop()
+	window.MustDrop()
+	intX.MustDrop()
+	x.MustDrop()
+}
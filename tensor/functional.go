@@ -0,0 +1,48 @@
+package tensor
+
+// Functional forms of stateless layers.
+
+import (
+	"fmt"
+	"log"
+)
+
+// NOTE: most activations are available as generated tensor methods, which are
+// the primitives wrapped by the activation layers in package nn:
+//
+//	xs.Relu(del), xs.Gelu(del), xs.Sigmoid(del), xs.Tanh(del),
+//	xs.Softmax(dim, dtype, del), xs.LogSoftmax(dim, dtype, del)
+//
+// and their `Must` variants. Dropout is only generated as a package function
+// `Dropout(input, p, train)`, so a method form is added below.
+
+// Dropout randomly zeroes elements of ts with probability p and scales the
+// others by 1/(1-p) if train is true.
+//
+// NOTE: in evaluation mode (train = false) or with p = 0, the returned tensor
+// is a new handle sharing storage with ts rather than a copy, so in-place
+// changes to one are seen by the other. It stays valid if ts is deleted
+// (del = true).
+func (ts *Tensor) Dropout(p float64, train bool, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	if p < 0 || p > 1 {
+		err := fmt.Errorf("Dropout() failed: expected probability p in range [0, 1], got %v.\n", p)
+		return nil, err
+	}
+
+	return Dropout(ts, p, train)
+}
+
+// MustDropout randomly zeroes elements of ts with probability p if train is
+// true. It panics if error.
+func (ts *Tensor) MustDropout(p float64, train bool, del bool) *Tensor {
+	retVal, err := ts.Dropout(p, train, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
@@ -0,0 +1,80 @@
+package tensor_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestFunctionalActivations(t *testing.T) {
+	xs := ts.MustOfSlice([]float64{-2, -0.5, 0, 0.5, 2, 3}).MustView([]int64{2, 3}, true)
+
+	relu := xs.MustRelu(false)
+	if want, got := []float64{0, 0, 0, 0.5, 2, 3}, relu.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected relu: %v\n", want)
+		t.Errorf("Got relu: %v\n", got)
+	}
+	relu.MustDrop()
+
+	sigmoid := xs.MustSigmoid(false)
+	tanh := xs.MustTanh(false)
+	var wantSigmoid, wantTanh []float64
+	for _, x := range xs.Float64Values() {
+		wantSigmoid = append(wantSigmoid, 1/(1+math.Exp(-x)))
+		wantTanh = append(wantTanh, math.Tanh(x))
+	}
+	assertClose(t, "sigmoid", wantSigmoid, sigmoid.Float64Values())
+	assertClose(t, "tanh", wantTanh, tanh.Float64Values())
+	sigmoid.MustDrop()
+	tanh.MustDrop()
+
+	// softmax rows sum to 1, so log-softmax rows logsumexp to log(1) = 0.
+	softmax := xs.MustSoftmax(-1, gotch.Double, false)
+	rowSum := softmax.MustSumDim([]int64{1}, false, gotch.Double, true)
+	assertClose(t, "softmax row sums", []float64{1, 1}, rowSum.Float64Values())
+	rowSum.MustDrop()
+
+	logSoftmax := xs.MustLogSoftmax(-1, gotch.Double, false)
+	logSumExp := logSoftmax.MustLogsumexp([]int64{1}, false, true)
+	assertClose(t, "log-softmax row logsumexp", []float64{0, 0}, logSumExp.Float64Values())
+	logSumExp.MustDrop()
+
+	xs.MustDrop()
+}
+
+func TestFunctionalDropout(t *testing.T) {
+	xs := ts.MustOnes([]int64{1000}, gotch.Float, gotch.CPU)
+
+	// eval mode is identity
+	eval := xs.MustDropout(0.5, false, false)
+	if want, got := xs.Float64Values(), eval.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected eval dropout output equal to input\n")
+	}
+	eval.MustDrop()
+
+	// train mode zeroes elements and scales the others by 1/(1-p)
+	train := xs.MustDropout(0.5, true, false)
+	var zeros int
+	for _, v := range train.Float64Values() {
+		switch v {
+		case 0:
+			zeros++
+		case 2:
+		default:
+			t.Errorf("Expected train dropout values of 0 or 2, got: %v\n", v)
+		}
+	}
+	if zeros == 0 || zeros == 1000 {
+		t.Errorf("Expected some (not all) elements zeroed, got %v zeros\n", zeros)
+	}
+	train.MustDrop()
+
+	if _, err := xs.Dropout(1.5, true, false); err == nil {
+		t.Errorf("Expected error for probability out of range, got nil\n")
+	}
+
+	xs.MustDrop()
+}
@@ -4,7 +4,11 @@ import "C"
 
 import (
 	// "unsafe"
+	"fmt"
+	"log"
+	"runtime"
 
+	"github.com/sugarme/gotch"
 	lib "github.com/sugarme/gotch/libtch"
 )
 
@@ -17,7 +21,7 @@ func LoadHwc(path string) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // SaveHwc save an image from tensor. It expects a tensor of shape [height,
@@ -25,6 +29,7 @@ func LoadHwc(path string) (*Tensor, error) {
 func SaveHwc(ts *Tensor, path string) error {
 
 	lib.AtSaveImage(ts.ctensor, path)
+	runtime.KeepAlive(ts)
 	return TorchErr()
 }
 
@@ -33,10 +38,128 @@ func SaveHwc(ts *Tensor, path string) error {
 func ResizeHwc(ts *Tensor, outWidth, outHeight int64) (*Tensor, error) {
 
 	ctensor := lib.AtResizeImage(ts.ctensor, outWidth, outHeight)
+	runtime.KeepAlive(ts)
 	err := TorchErr()
 	if err != nil {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
+}
+
+// channelStats checks mean and std against the channel dimension of an image
+// tensor of shape [channel, height, width] or [batch, channel, height, width]
+// and returns them as float tensors of shape [channel, 1, 1] on the image
+// device.
+func channelStats(img *Tensor, mean, std []float64) (meanTs, stdTs *Tensor, err error) {
+	shape, err := img.Size()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var channels int64
+	switch len(shape) {
+	case 3:
+		channels = shape[0]
+	case 4:
+		channels = shape[1]
+	default:
+		err = fmt.Errorf("expected image tensor of shape [C, H, W] or [N, C, H, W], got %v\n", shape)
+		return nil, nil, err
+	}
+
+	if int64(len(mean)) != channels || int64(len(std)) != channels {
+		err = fmt.Errorf("mean (%v) and std (%v) lengths mismatched with number of channels (%v)\n", len(mean), len(std), channels)
+		return nil, nil, err
+	}
+	for _, s := range std {
+		if s == 0 {
+			err = fmt.Errorf("std must not contain zero: %v\n", std)
+			return nil, nil, err
+		}
+	}
+
+	device, err := img.Device()
+	if err != nil {
+		return nil, nil, err
+	}
+	dtype := img.DType()
+	if dtype != gotch.Double {
+		dtype = gotch.Float
+	}
+
+	meanTs = MustOfSlice(mean).MustView([]int64{channels, 1, 1}, true).MustTo4(device, dtype, false, false, true)
+	stdTs = MustOfSlice(std).MustView([]int64{channels, 1, 1}, true).MustTo4(device, dtype, false, false, true)
+
+	return meanTs, stdTs, nil
+}
+
+// Normalize normalizes an image tensor of shape [channel, height, width] or
+// [batch, channel, height, width] per channel: `(img - mean[c]) / std[c]`.
+//
+// The lengths of mean and std must match the number of channels. Non floating
+// point images are converted to float. E.g. for ImageNet style preprocessing
+// of images scaled to [0, 1], mean = {0.485, 0.456, 0.406} and
+// std = {0.229, 0.224, 0.225}.
+func Normalize(img *Tensor, mean, std []float64) (*Tensor, error) {
+	meanTs, stdTs, err := channelStats(img, mean, std)
+	if err != nil {
+		err = fmt.Errorf("Normalize() failed: %v", err)
+		return nil, err
+	}
+	defer meanTs.MustDrop()
+	defer stdTs.MustDrop()
+
+	centered, err := img.Totype(meanTs.DType(), false)
+	if err != nil {
+		return nil, err
+	}
+	centered, err = centered.Sub(meanTs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return centered.Div(stdTs, true)
+}
+
+// MustNormalize normalizes an image tensor per channel. It will panic if error.
+func MustNormalize(img *Tensor, mean, std []float64) *Tensor {
+	retVal, err := Normalize(img, mean, std)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Denormalize reverts Normalize: `img * std[c] + mean[c]`.
+func Denormalize(img *Tensor, mean, std []float64) (*Tensor, error) {
+	meanTs, stdTs, err := channelStats(img, mean, std)
+	if err != nil {
+		err = fmt.Errorf("Denormalize() failed: %v", err)
+		return nil, err
+	}
+	defer meanTs.MustDrop()
+	defer stdTs.MustDrop()
+
+	scaled, err := img.Totype(meanTs.DType(), false)
+	if err != nil {
+		return nil, err
+	}
+	scaled, err = scaled.Mul(stdTs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return scaled.Add(meanTs, true)
+}
+
+// MustDenormalize reverts Normalize. It will panic if error.
+func MustDenormalize(img *Tensor, mean, std []float64) *Tensor {
+	retVal, err := Denormalize(img, mean, std)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
 }
@@ -0,0 +1,51 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestNormalize(t *testing.T) {
+	img := ts.MustOfSlice([]uint8{0, 255, 10, 20, 100, 200}).MustView([]int64{3, 1, 2}, true)
+	mean := []float64{0, 10, 100}
+	std := []float64{255, 10, 100}
+
+	x := ts.MustNormalize(img, mean, std)
+	if x.DType() != gotch.Float {
+		t.Errorf("Expected dtype: %v\n", gotch.Float)
+		t.Errorf("Got dtype: %v\n", x.DType())
+	}
+	assertClose(t, "normalized values", []float64{0, 1, 0, 1, 0, 1}, x.Float64Values())
+
+	y := ts.MustDenormalize(x, mean, std)
+	assertClose(t, "denormalized values", []float64{0, 255, 10, 20, 100, 200}, y.Float64Values())
+}
+
+func TestNormalizeBatch(t *testing.T) {
+	imgs := ts.MustRand([]int64{2, 3, 4, 4}, gotch.Double, gotch.CPU)
+	mean := []float64{0.485, 0.456, 0.406}
+	std := []float64{0.229, 0.224, 0.225}
+
+	x := ts.MustNormalize(imgs, mean, std)
+	if want, got := imgs.MustSize(), x.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+
+	y := ts.MustDenormalize(x, mean, std)
+	assertClose(t, "round-trip values", imgs.Float64Values(), y.Float64Values())
+}
+
+func TestNormalizeChannelMismatch(t *testing.T) {
+	img := ts.MustRand([]int64{3, 4, 4}, gotch.Float, gotch.CPU)
+
+	if _, err := ts.Normalize(img, []float64{0.5}, []float64{0.5}); err == nil {
+		t.Errorf("Expected error for mean/std and channels mismatched, got nil\n")
+	}
+	if _, err := ts.Denormalize(img, []float64{0.5, 0.5}, []float64{0.5, 0.5}); err == nil {
+		t.Errorf("Expected error for mean/std and channels mismatched, got nil\n")
+	}
+}
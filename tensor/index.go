@@ -65,8 +65,10 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"runtime"
 
 	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
 )
 
 type NewAxis struct{}
@@ -81,6 +83,12 @@ type Narrow struct {
 }
 type IndexSelect struct{ Index *Tensor }
 type InsertNewAxis struct{}
+type Slice struct {
+	Start int64
+	Stop  int64
+	Step  int64
+}
+type Ellipsis struct{}
 
 // NewSelect creates an tensor indexer with given index.
 // `index` must be in range of tensor dimension. E.g. tensor shape [2,8]
@@ -101,6 +109,20 @@ func NewInsertNewAxis() InsertNewAxis {
 	return InsertNewAxis{}
 }
 
+// NewSlice creates a tensor indexer equivalent to `start:stop:step`.
+// Negative `start` and `stop` count from the end of the dimension and out of
+// range values are clamped, hence `math.MaxInt64` can be used as `stop` to
+// slice to the end. `step` must be positive.
+func NewSlice(start, stop, step int64) Slice {
+	return Slice{Start: start, Stop: stop, Step: step}
+}
+
+// NewEllipsis creates a tensor indexer equivalent to `...` which keeps all
+// dimensions not covered by the other indexers. At most one is allowed.
+func NewEllipsis() Ellipsis {
+	return Ellipsis{}
+}
+
 func NewSliceIndex(sl []int64) IndexSelect {
 	ts := MustOfSlice(sl)
 
@@ -199,19 +221,30 @@ func (ts *Tensor) Idx(index interface{}) (retVal *Tensor) {
 func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error) {
 
 	// Make sure number of non-newaxis is not exceed number of dimensions
-	var numNewAxis int = 0
+	var (
+		numNewAxis  int = 0
+		numEllipsis int = 0
+	)
 	for _, ti := range indexSpec {
-		if reflect.TypeOf(ti).Name() == "InsertNewAxis" {
+		switch reflect.TypeOf(ti).Name() {
+		case "InsertNewAxis":
 			numNewAxis += 1
+		case "Ellipsis":
+			numEllipsis += 1
 		}
 	}
+	if numEllipsis > 1 {
+		err = fmt.Errorf("An index can only have a single ellipsis, got %v\n", numEllipsis)
+		return retVal, err
+	}
 
 	tsShape, err := ts.Size()
 	if err != nil {
 		return retVal, err
 	}
 	tsLen := len(tsShape)
-	if len(indexSpec) > tsLen+numNewAxis {
+	numIndexedDims := len(indexSpec) - numNewAxis - numEllipsis
+	if numIndexedDims > tsLen {
 		err = fmt.Errorf("Too many indices for tensor of dimension %v\n", tsLen)
 		return retVal, err
 	}
@@ -282,6 +315,21 @@ func (ts *Tensor) indexer(indexSpec []TensorIndexer) (retVal *Tensor, err error)
 				return retVal, err
 			}
 			nextIdx = currIdx + 1
+		case "Slice": // 3 fields: `(Start, Stop, Step int64)`
+			sl := spec.(Slice)
+			if sl.Step <= 0 {
+				err = fmt.Errorf("Slice step should be positive, got %v\n", sl.Step)
+				return retVal, err
+			}
+			nextTensor, err = currTensor.Slice(currIdx, sl.Start, sl.Stop, sl.Step, true)
+			if err != nil {
+				return retVal, err
+			}
+			nextIdx = currIdx + 1
+		case "Ellipsis":
+			// skip all dimensions not covered by other indexers
+			nextTensor = currTensor
+			nextIdx = currIdx + int64(tsLen-numIndexedDims)
 		case "IndexSelect": // 1 field `(Index *Tensor)`
 			indexTensor := reflect.ValueOf(spec).FieldByName("Index").Interface().(*Tensor)
 			device, err := currTensor.Device()
@@ -314,3 +362,41 @@ func (ts *Tensor) mustIndexer(indexSpec []TensorIndexer) (retVal *Tensor) {
 	}
 	return retVal
 }
+
+// IdxPut assigns value to the sub-tensor selected by index. value should be
+// broadcastable to the shape of the selected sub-tensor. If accumulate is
+// true, value is added to the selected elements instead.
+//
+// NOTE: `IndexSelect` indexers are not supported as they select a copy of
+// the tensor rather than a view.
+func (ts *Tensor) IdxPut(index []TensorIndexer, value *Tensor, accumulate bool) error {
+	for _, spec := range index {
+		if reflect.TypeOf(spec).Name() == "IndexSelect" {
+			err := fmt.Errorf("IdxPut() failed: IndexSelect indexer is not supported.\n")
+			return err
+		}
+	}
+
+	view, err := ts.indexer(index)
+	if err != nil {
+		return err
+	}
+	defer view.MustDrop()
+
+	if accumulate {
+		return view.Add_(value)
+	}
+
+	lib.AtCopy_(view.ctensor, value.ctensor)
+	runtime.KeepAlive(view)
+	runtime.KeepAlive(value)
+	return TorchErr()
+}
+
+// MustIdxPut assigns value to the sub-tensor selected by index. It panics if error.
+func (ts *Tensor) MustIdxPut(index []TensorIndexer, value *Tensor, accumulate bool) {
+	err := ts.IdxPut(index, value, accumulate)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
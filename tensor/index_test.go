@@ -1,6 +1,7 @@
 package tensor_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -297,3 +298,117 @@ func TestIndex3D(t *testing.T) {
 		t.Errorf("Got tensor values: %v\n", got3)
 	}
 }
+
+func TestNarrowSelect(t *testing.T) {
+	// [ 0  1  2  3
+	//   4  5  6  7
+	//   8  9 10 11 ]
+	x := ts.MustArange(ts.IntScalar(12), gotch.Int64, gotch.CPU).MustView([]int64{3, 4}, true)
+
+	// x[1:3, :]
+	rows := x.MustNarrow(0, 1, 2, false)
+	if want, got := []int64{4, 5, 6, 7, 8, 9, 10, 11}, rows.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected narrowed values: %v\n", want)
+		t.Errorf("Got narrowed values: %v\n", got)
+	}
+	if want, got := []int64{2, 4}, rows.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected narrowed shape: %v\n", want)
+		t.Errorf("Got narrowed shape: %v\n", got)
+	}
+
+	// x[:, 2]
+	col := x.MustSelect(1, 2, false)
+	if want, got := []int64{2, 6, 10}, col.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected selected values: %v\n", want)
+		t.Errorf("Got selected values: %v\n", got)
+	}
+	if want, got := []int64{3}, col.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected selected shape: %v\n", want)
+		t.Errorf("Got selected shape: %v\n", got)
+	}
+
+	// x[:, [3, 0]]
+	index := ts.MustOfSlice([]int64{3, 0})
+	cols := x.MustIndexSelect(1, index, false)
+	if want, got := []int64{3, 0, 7, 4, 11, 8}, cols.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected index selected values: %v\n", want)
+		t.Errorf("Got index selected values: %v\n", got)
+	}
+
+	// out of bounds
+	if _, err := x.Narrow(0, 2, 2, false); err == nil {
+		t.Errorf("Expected Narrow error for out of bounds length, got nil\n")
+	}
+	if _, err := x.Select(1, 4, false); err == nil {
+		t.Errorf("Expected Select error for out of bounds index, got nil\n")
+	}
+	if _, err := x.Select(2, 0, false); err == nil {
+		t.Errorf("Expected Select error for invalid dim, got nil\n")
+	}
+	badIndex := ts.MustOfSlice([]int64{0, 4})
+	if _, err := x.IndexSelect(1, badIndex, false); err == nil {
+		t.Errorf("Expected IndexSelect error for out of bounds index, got nil\n")
+	}
+}
+
+func TestSliceEllipsisIndex(t *testing.T) {
+	// [[ 0  1  2  3]
+	//  [ 4  5  6  7]
+	//  [ 8  9 10 11]]
+	x := ts.MustArange(ts.IntScalar(12), gotch.Int64, gotch.CPU).MustView([]int64{3, 4}, true)
+
+	tests := []struct {
+		name      string
+		index     []ts.TensorIndexer
+		wantShape []int64
+		want      []int64
+	}{
+		// x[:, 2]
+		{"x[:, 2]", []ts.TensorIndexer{ts.NewSlice(0, math.MaxInt64, 1), ts.NewSelect(2)}, []int64{3}, []int64{2, 6, 10}},
+		// x[..., 2]
+		{"x[..., 2]", []ts.TensorIndexer{ts.NewEllipsis(), ts.NewSelect(2)}, []int64{3}, []int64{2, 6, 10}},
+		// x[::2, 1::2]
+		{"x[::2, 1::2]", []ts.TensorIndexer{ts.NewSlice(0, math.MaxInt64, 2), ts.NewSlice(1, math.MaxInt64, 2)}, []int64{2, 2}, []int64{1, 3, 9, 11}},
+		// x[-1, ...]
+		{"x[-1, ...]", []ts.TensorIndexer{ts.NewSelect(-1), ts.NewEllipsis()}, []int64{4}, []int64{8, 9, 10, 11}},
+		// x[..., None]
+		{"x[..., None]", []ts.TensorIndexer{ts.NewEllipsis(), ts.NewInsertNewAxis()}, []int64{3, 4, 1}, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}},
+	}
+
+	for _, tt := range tests {
+		got := x.Idx(tt.index)
+		if !reflect.DeepEqual(tt.wantShape, got.MustSize()) {
+			t.Errorf("Expected %v shape: %v\n", tt.name, tt.wantShape)
+			t.Errorf("Got %v shape: %v\n", tt.name, got.MustSize())
+		}
+		if !reflect.DeepEqual(tt.want, got.Int64Values()) {
+			t.Errorf("Expected %v values: %v\n", tt.name, tt.want)
+			t.Errorf("Got %v values: %v\n", tt.name, got.Int64Values())
+		}
+	}
+}
+
+func TestIdxPut(t *testing.T) {
+	x := ts.MustZeros([]int64{2, 3}, gotch.Int64, gotch.CPU)
+
+	// x[0, :] = [1, 2, 3]
+	row := []ts.TensorIndexer{ts.NewSelect(0), ts.NewSlice(0, math.MaxInt64, 1)}
+	x.MustIdxPut(row, ts.MustOfSlice([]int64{1, 2, 3}), false)
+	if want, got := []int64{1, 2, 3, 0, 0, 0}, x.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected values: %v\n", want)
+		t.Errorf("Got values: %v\n", got)
+	}
+
+	// x[:, 1] += 10
+	col := []ts.TensorIndexer{ts.NewEllipsis(), ts.NewSelect(1)}
+	x.MustIdxPut(col, ts.MustOfSlice([]int64{10}), true)
+	if want, got := []int64{1, 12, 3, 0, 10, 0}, x.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected accumulated values: %v\n", want)
+		t.Errorf("Got accumulated values: %v\n", got)
+	}
+
+	index := []ts.TensorIndexer{ts.NewSliceIndex([]int64{0, 1})}
+	if err := x.IdxPut(index, ts.MustOfSlice([]int64{1}), false); err == nil {
+		t.Errorf("Expected error for IndexSelect indexer, got nil\n")
+	}
+}
@@ -0,0 +1,173 @@
+package tensor
+
+// In-place arithmetic returning the receiver.
+//
+// NOTE: the generated `Add_`, `Sub_`, `Mul_` and `Div_` (tensor operand) and
+// `Add1_`, `Sub1_`, `Mul1_`, `Div1_` (scalar operand) only return an error.
+// The methods below wrap them and return the mutated receiver so that updates
+// can be chained without allocating new tensors, e.g.
+// `x.MustMulScalar_(ts.FloatScalar(0.9)).MustAddTensor_(y)`.
+
+import (
+	"log"
+)
+
+// AddScalar_ adds s to every element of the tensor in place and returns the tensor.
+func (ts *Tensor) AddScalar_(s *Scalar) (retVal *Tensor, err error) {
+	if err = ts.Add1_(s); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustAddScalar_ adds s to every element of the tensor in place. It panics if error.
+func (ts *Tensor) MustAddScalar_(s *Scalar) (retVal *Tensor) {
+	retVal, err := ts.AddScalar_(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// SubScalar_ subtracts s from every element of the tensor in place and returns the tensor.
+func (ts *Tensor) SubScalar_(s *Scalar) (retVal *Tensor, err error) {
+	if err = ts.Sub1_(s); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustSubScalar_ subtracts s from every element of the tensor in place. It panics if error.
+func (ts *Tensor) MustSubScalar_(s *Scalar) (retVal *Tensor) {
+	retVal, err := ts.SubScalar_(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// MulScalar_ multiplies every element of the tensor by s in place and returns the tensor.
+func (ts *Tensor) MulScalar_(s *Scalar) (retVal *Tensor, err error) {
+	if err = ts.Mul1_(s); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustMulScalar_ multiplies every element of the tensor by s in place. It panics if error.
+func (ts *Tensor) MustMulScalar_(s *Scalar) (retVal *Tensor) {
+	retVal, err := ts.MulScalar_(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// DivScalar_ divides every element of the tensor by s in place and returns the tensor.
+func (ts *Tensor) DivScalar_(s *Scalar) (retVal *Tensor, err error) {
+	if err = ts.Div1_(s); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustDivScalar_ divides every element of the tensor by s in place. It panics if error.
+func (ts *Tensor) MustDivScalar_(s *Scalar) (retVal *Tensor) {
+	retVal, err := ts.DivScalar_(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// AddTensor_ adds other to the tensor element-wise in place and returns the tensor.
+//
+// other must be broadcastable to the tensor shape.
+func (ts *Tensor) AddTensor_(other *Tensor) (retVal *Tensor, err error) {
+	if err = ts.Add_(other); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustAddTensor_ adds other to the tensor element-wise in place. It panics if error.
+func (ts *Tensor) MustAddTensor_(other *Tensor) (retVal *Tensor) {
+	retVal, err := ts.AddTensor_(other)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// SubTensor_ subtracts other from the tensor element-wise in place and returns the tensor.
+//
+// other must be broadcastable to the tensor shape.
+func (ts *Tensor) SubTensor_(other *Tensor) (retVal *Tensor, err error) {
+	if err = ts.Sub_(other); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustSubTensor_ subtracts other from the tensor element-wise in place. It panics if error.
+func (ts *Tensor) MustSubTensor_(other *Tensor) (retVal *Tensor) {
+	retVal, err := ts.SubTensor_(other)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// MulTensor_ multiplies the tensor by other element-wise in place and returns the tensor.
+//
+// other must be broadcastable to the tensor shape.
+func (ts *Tensor) MulTensor_(other *Tensor) (retVal *Tensor, err error) {
+	if err = ts.Mul_(other); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustMulTensor_ multiplies the tensor by other element-wise in place. It panics if error.
+func (ts *Tensor) MustMulTensor_(other *Tensor) (retVal *Tensor) {
+	retVal, err := ts.MulTensor_(other)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// DivTensor_ divides the tensor by other element-wise in place and returns the tensor.
+//
+// other must be broadcastable to the tensor shape.
+func (ts *Tensor) DivTensor_(other *Tensor) (retVal *Tensor, err error) {
+	if err = ts.Div_(other); err != nil {
+		return retVal, err
+	}
+
+	return ts, nil
+}
+
+// MustDivTensor_ divides the tensor by other element-wise in place. It panics if error.
+func (ts *Tensor) MustDivTensor_(other *Tensor) (retVal *Tensor) {
+	retVal, err := ts.DivTensor_(other)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
@@ -0,0 +1,65 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestInplaceScalar(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3, 4})
+
+	got := x.MustMulScalar_(ts.FloatScalar(4.0)).MustAddScalar_(ts.FloatScalar(2.0)).MustSubScalar_(ts.FloatScalar(1.0)).MustDivScalar_(ts.FloatScalar(0.5))
+	if got != x {
+		t.Errorf("Expected returned tensor to be the receiver\n")
+	}
+
+	want := []float64{10, 18, 26, 34}
+	if vals := x.Float64Values(); !reflect.DeepEqual(want, vals) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", vals)
+	}
+
+	// a view shares storage with its base tensor
+	y := ts.MustOfSlice([]float64{1, 2, 3, 4})
+	view := y.MustView([]int64{2, 2}, false)
+	view.MustAddScalar_(ts.FloatScalar(1.0))
+
+	want = []float64{2, 3, 4, 5}
+	if vals := y.Float64Values(); !reflect.DeepEqual(want, vals) {
+		t.Errorf("Expected base tensor values: %v\n", want)
+		t.Errorf("Got base tensor values: %v\n", vals)
+	}
+}
+
+func TestInplaceTensor(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3, 4})
+	y := ts.MustOfSlice([]float64{2, 2, 2, 2})
+
+	got := x.MustMulTensor_(y).MustAddTensor_(y).MustSubTensor_(x.MustOnesLike(false)).MustDivTensor_(y)
+	if got != x {
+		t.Errorf("Expected returned tensor to be the receiver\n")
+	}
+
+	want := []float64{1.5, 2.5, 3.5, 4.5}
+	if vals := x.Float64Values(); !reflect.DeepEqual(want, vals) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", vals)
+	}
+
+	// other is broadcast to the receiver shape and left unchanged
+	z := ts.MustOfSlice([]float64{1, 2, 3, 4}).MustView([]int64{2, 2}, true)
+	z.MustAddTensor_(ts.MustOfSlice([]float64{10, 20}))
+
+	want = []float64{11, 22, 13, 24}
+	if vals := z.Float64Values(); !reflect.DeepEqual(want, vals) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", vals)
+	}
+	want = []float64{2, 2, 2, 2}
+	if vals := y.Float64Values(); !reflect.DeepEqual(want, vals) {
+		t.Errorf("Expected operand values: %v\n", want)
+		t.Errorf("Got operand values: %v\n", vals)
+	}
+}
@@ -0,0 +1,113 @@
+package tensor
+
+import (
+	"fmt"
+	"log"
+	"math"
+)
+
+// InterpolateConfig configures `Interpolate`.
+//
+// Exactly one of Size and ScaleFactor must be set. Both hold either a single
+// value used for all spatial dimensions or one value per spatial dimension.
+type InterpolateConfig struct {
+	Size         []int64   // output spatial size
+	ScaleFactor  []float64 // multiplier for the input spatial size
+	Mode         string    // "nearest", "bilinear" or "bicubic"
+	AlignCorners bool      // only for "bilinear" and "bicubic"
+}
+
+// DefaultInterpolateConfig creates an InterpolateConfig for nearest
+// upsampling to the given output spatial size.
+func DefaultInterpolateConfig(size ...int64) *InterpolateConfig {
+	return &InterpolateConfig{
+		Size: size,
+		Mode: "nearest",
+	}
+}
+
+// Interpolate resizes the spatial dimensions of input, following
+// `torch.nn.functional.interpolate`.
+//
+// Input is of shape [N, C, W] (nearest), [N, C, H, W] or [N, C, D, H, W]
+// (nearest). Modes "bilinear" and "bicubic" require a 4D input.
+func Interpolate(input *Tensor, cfg *InterpolateConfig) (*Tensor, error) {
+	shape := input.MustSize()
+	nd := len(shape) - 2
+	if nd < 1 || nd > 3 {
+		err := fmt.Errorf("Interpolate() failed: expected a 3D, 4D or 5D input, got shape %v.\n", shape)
+		return nil, err
+	}
+
+	if (len(cfg.Size) == 0) == (len(cfg.ScaleFactor) == 0) {
+		err := fmt.Errorf("Interpolate() failed: exactly one of Size (%v) and ScaleFactor (%v) should be set.\n", cfg.Size, cfg.ScaleFactor)
+		return nil, err
+	}
+
+	size := make([]int64, nd)
+	scales := make([][]float64, nd) // per spatial dim, empty if not given
+	switch {
+	case len(cfg.Size) > 0:
+		if len(cfg.Size) != 1 && len(cfg.Size) != nd {
+			err := fmt.Errorf("Interpolate() failed: Size %v should have 1 or %v values for input of shape %v.\n", cfg.Size, nd, shape)
+			return nil, err
+		}
+		for i := range size {
+			size[i] = cfg.Size[0]
+			if len(cfg.Size) == nd {
+				size[i] = cfg.Size[i]
+			}
+		}
+	default:
+		if len(cfg.ScaleFactor) != 1 && len(cfg.ScaleFactor) != nd {
+			err := fmt.Errorf("Interpolate() failed: ScaleFactor %v should have 1 or %v values for input of shape %v.\n", cfg.ScaleFactor, nd, shape)
+			return nil, err
+		}
+		for i := range size {
+			scale := cfg.ScaleFactor[0]
+			if len(cfg.ScaleFactor) == nd {
+				scale = cfg.ScaleFactor[i]
+			}
+			size[i] = int64(math.Floor(float64(shape[i+2]) * scale))
+			scales[i] = []float64{scale}
+		}
+	}
+
+	switch cfg.Mode {
+	case "nearest":
+		if cfg.AlignCorners {
+			err := fmt.Errorf("Interpolate() failed: AlignCorners can only be set with bilinear or bicubic mode.\n")
+			return nil, err
+		}
+		switch nd {
+		case 1:
+			return input.UpsampleNearest1d(size, scales[0], false)
+		case 2:
+			return input.UpsampleNearest2d(size, scales[0], scales[1], false)
+		default:
+			return input.UpsampleNearest3d(size, scales[0], scales[1], scales[2], false)
+		}
+	case "bilinear", "bicubic":
+		if nd != 2 {
+			err := fmt.Errorf("Interpolate() failed: %v mode expects a 4D input, got shape %v.\n", cfg.Mode, shape)
+			return nil, err
+		}
+		if cfg.Mode == "bilinear" {
+			return input.UpsampleBilinear2d(size, cfg.AlignCorners, scales[0], scales[1], false)
+		}
+		return input.UpsampleBicubic2d(size, cfg.AlignCorners, scales[0], scales[1], false)
+	default:
+		err := fmt.Errorf("Interpolate() failed: unsupported mode %q.\n", cfg.Mode)
+		return nil, err
+	}
+}
+
+// MustInterpolate resizes the spatial dimensions of input. It panics if error.
+func MustInterpolate(input *Tensor, cfg *InterpolateConfig) *Tensor {
+	retVal, err := Interpolate(input, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
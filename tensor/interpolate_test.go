@@ -0,0 +1,71 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestInterpolateNearest(t *testing.T) {
+	x := ts.MustOfSlice([]float32{1, 2, 3, 4}).MustView([]int64{1, 1, 2, 2}, true)
+
+	cfg := &ts.InterpolateConfig{ScaleFactor: []float64{2}, Mode: "nearest"}
+	out := ts.MustInterpolate(x, cfg)
+	if want, got := []int64{1, 1, 4, 4}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+	want := []float64{
+		1, 1, 2, 2,
+		1, 1, 2, 2,
+		3, 3, 4, 4,
+		3, 3, 4, 4,
+	}
+	if got := out.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output: %v\n", want)
+		t.Errorf("Got output: %v\n", got)
+	}
+
+	out = ts.MustInterpolate(x, ts.DefaultInterpolateConfig(3, 5))
+	if want, got := []int64{1, 1, 3, 5}, out.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected output shape: %v\n", want)
+		t.Errorf("Got output shape: %v\n", got)
+	}
+}
+
+func TestInterpolateBilinear(t *testing.T) {
+	x := ts.MustOfSlice([]float32{1, 2, 3, 4}).MustView([]int64{1, 1, 2, 2}, true)
+
+	cfg := &ts.InterpolateConfig{Size: []int64{3, 3}, Mode: "bilinear", AlignCorners: true}
+	want := []float64{
+		1, 1.5, 2,
+		2, 2.5, 3,
+		3, 3.5, 4,
+	}
+	assertClose(t, "bilinear output", want, ts.MustInterpolate(x, cfg).Float64Values())
+
+	cfg.Mode = "bicubic"
+	if want, got := []int64{1, 1, 3, 3}, ts.MustInterpolate(x, cfg).MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected bicubic output shape: %v\n", want)
+		t.Errorf("Got bicubic output shape: %v\n", got)
+	}
+}
+
+func TestInterpolateInvalid(t *testing.T) {
+	x := ts.MustZeros([]int64{1, 1, 2, 2}, gotch.Float, gotch.CPU)
+
+	invalid := []*ts.InterpolateConfig{
+		{Mode: "nearest"}, // neither Size nor ScaleFactor
+		{Size: []int64{4, 4}, ScaleFactor: []float64{2}, Mode: "nearest"},
+		{Size: []int64{4, 4}, Mode: "trilinear"},
+		{Size: []int64{4, 4}, Mode: "nearest", AlignCorners: true},
+		{Size: []int64{4, 4, 4}, Mode: "bilinear"},
+	}
+	for _, cfg := range invalid {
+		if _, err := ts.Interpolate(x, cfg); err == nil {
+			t.Errorf("Expected error for config %+v, got nil\n", *cfg)
+		}
+	}
+}
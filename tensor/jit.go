@@ -12,6 +12,7 @@ import (
 	"io"
 	"log"
 	"reflect"
+	"runtime"
 	"unsafe"
 
 	"github.com/sugarme/gotch"
@@ -180,6 +181,7 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 
 	case "Tensor":
 		cval := lib.AtiTensor(iv.value.(Tensor).ctensor)
+		runtime.KeepAlive(iv)
 		if err := TorchErr(); err != nil {
 			return nil, err
 		}
@@ -341,6 +343,7 @@ func (iv *IValue) ToCIValue() (*CIValue, error) {
 			cvals = append(cvals, i.ctensor)
 		}
 		list := lib.AtiTensorList(cvals, len(cvals))
+		runtime.KeepAlive(vals)
 		if err := TorchErr(); err != nil {
 			return nil, err
 		}
@@ -658,11 +661,11 @@ func IValueFromC(cval *CIValue) (*IValue, error) {
 
 		// 3. Get values
 		var tensors []Tensor
-		tensors = append(tensors, Tensor{ctensor: *ptr1})
+		tensors = append(tensors, *newTensor(*ptr1))
 		currPtr := ptr1
 		for i := 1; i < int(len); i++ {
 			nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currPtr)) + unsafe.Sizeof(ptr1)))
-			tensors = append(tensors, Tensor{ctensor: *nextPtr})
+			tensors = append(tensors, *newTensor(*nextPtr))
 			currPtr = nextPtr
 		}
 
@@ -989,11 +992,12 @@ func (cm *CModule) ForwardTs(tensors []Tensor) (*Tensor, error) {
 	// - `nsize` is number of ctensor pointers encoded in binary data.
 	ctensorsPtr := (*lib.Ctensor)(dataPtr)
 	ctensor := lib.AtmForward(cm.Cmodule, ctensorsPtr, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err := TorchErr(); err != nil {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // ForwardIs performs the forward pass for a model on some specified ivalue input.
@@ -1058,6 +1062,15 @@ func (cm *CModule) To(device gotch.Device, kind gotch.DType, nonBlocking bool) {
 	}
 }
 
+// ToDevice moves CModule parameters and buffers to specified device keeping
+// their dtypes.
+func (cm *CModule) ToDevice(device gotch.Device) {
+	lib.AtmToDevice(cm.Cmodule, device.CInt(), false)
+	if err := TorchErr(); err != nil {
+		log.Fatalf("CModule ToDevice method call err: %v\n", err)
+	}
+}
+
 // Save save CModule to a specified path.
 func (cm *CModule) Save(file string) error {
 	lib.AtmSave(cm.Cmodule, file)
@@ -1077,7 +1090,7 @@ func (cm *CModule) NamedParameters() ([]NamedTensor, error) {
 	for _, v := range data.NamedCtensors {
 		namedTensor := NamedTensor{
 			Name:   v.Name,
-			Tensor: &Tensor{v.Ctensor},
+			Tensor: newTensor(v.Ctensor),
 		}
 
 		namedTensors = append(namedTensors, namedTensor)
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/sugarme/gotch"
 	ts "github.com/sugarme/gotch/tensor"
 )
 
@@ -74,6 +75,35 @@ func TestModuleForwardTs(t *testing.T) {
 
 }
 
+func TestModuleToDevice(t *testing.T) {
+	foo, err := ts.ModuleLoadOnDevice("foo1.gt", gotch.CPU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foo.ToDevice(gotch.CPU)
+	foo.SetEval()
+
+	ts1 := ts.TensorFrom([]int64{42})
+	ts2 := ts.TensorFrom([]int64{1337})
+
+	res, err := foo.ForwardTs([]ts.Tensor{*ts1, *ts2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := 1421
+	got := int(res.Float64Values()[0])
+	if want != got {
+		t.Errorf("Expected value: %v\n", want)
+		t.Errorf("Got value: %v\n", got)
+	}
+
+	// foo expects 2 inputs, libtorch reports the missing ones
+	if _, err := foo.ForwardTs(nil); err == nil {
+		t.Errorf("Expected error for missing inputs, got nil\n")
+	}
+}
+
 func TestModuleForwardIValue(t *testing.T) {
 	foo, err := ts.ModuleLoad("foo2.gt")
 	if err != nil {
@@ -0,0 +1,110 @@
+package tensor
+
+// Loss functions.
+
+import (
+	"log"
+)
+
+// LossOptions holds optional parameters for loss functions.
+type LossOptions struct {
+	Weight      *Tensor   // optional rescaling weight for each class. Undefined tensor means no weighting.
+	Reduction   Reduction // reduction applied to the output
+	IgnoreIndex int64     // target value which is ignored and does not contribute to gradient
+}
+
+type LossOption func(*LossOptions)
+
+// NewLossOptions creates LossOptions with default values: no class weight,
+// mean reduction and ignore index of -100 (same as PyTorch).
+func NewLossOptions(options ...LossOption) LossOptions {
+	opts := LossOptions{
+		Weight:      NewTensor(),
+		Reduction:   ReductionMean,
+		IgnoreIndex: -100,
+	}
+
+	for _, o := range options {
+		o(&opts)
+	}
+
+	return opts
+}
+
+func WithLossWeight(weight *Tensor) LossOption {
+	return func(o *LossOptions) {
+		o.Weight = weight
+	}
+}
+
+func WithReduction(reduction Reduction) LossOption {
+	return func(o *LossOptions) {
+		o.Reduction = reduction
+	}
+}
+
+func WithIgnoreIndex(ignoreIndex int64) LossOption {
+	return func(o *LossOptions) {
+		o.IgnoreIndex = ignoreIndex
+	}
+}
+
+// NLLLoss computes the negative log likelihood loss of log-probabilities
+// `logProbs` of shape [N, C] given class indices `targets` of shape [N].
+//
+// The returned loss tensor keeps the autograd graph.
+func NLLLoss(logProbs, targets *Tensor, options ...LossOption) (retVal *Tensor) {
+	opts := NewLossOptions(options...)
+	reduction := reductionInt("NLLLoss", opts.Reduction)
+
+	return logProbs.MustNllLoss(targets, opts.Weight, reduction, opts.IgnoreIndex, false)
+}
+
+// CrossEntropyForLogits computes the cross-entropy loss of unnormalized
+// `logits` of shape [N, C] given class indices `targets` of shape [N]. It
+// combines log-softmax and NLL loss.
+//
+// The returned loss tensor keeps the autograd graph.
+func CrossEntropyForLogits(logits, targets *Tensor, options ...LossOption) (retVal *Tensor) {
+	logSm := logits.MustLogSoftmax(-1, logits.DType(), false)
+	retVal = NLLLoss(logSm, targets, options...)
+	logSm.MustDrop()
+
+	return retVal
+}
+
+// MSELoss computes the mean squared error between `input` and `target`.
+//
+// `input` and `target` should be broadcast-compatible.
+func MSELoss(input, target *Tensor, reduction Reduction) (retVal *Tensor) {
+	return input.MustMseLoss(target, reductionInt("MSELoss", reduction), false)
+}
+
+// L1Loss computes the mean absolute error between `input` and `target`.
+//
+// `input` and `target` should be broadcast-compatible.
+func L1Loss(input, target *Tensor, reduction Reduction) (retVal *Tensor) {
+	return input.MustL1Loss(target, reductionInt("L1Loss", reduction), false)
+}
+
+// SmoothL1Loss computes the smooth L1 (Huber-like) loss between `input` and
+// `target`. It uses a squared term if the absolute element-wise error falls
+// below `beta` and an L1 term otherwise.
+//
+// `input` and `target` should be broadcast-compatible.
+func SmoothL1Loss(input, target *Tensor, beta float64, reduction Reduction) (retVal *Tensor) {
+	return input.MustSmoothL1Loss(target, reductionInt("SmoothL1Loss", reduction), beta, false)
+}
+
+// reductionInt converts a reduction to the libtorch integer value. It panics
+// if the reduction is not supported by loss functions.
+func reductionInt(fnName string, r Reduction) int64 {
+	switch r {
+	case ReductionNone, ReductionMean, ReductionSum:
+		return int64(r.ToInt())
+	default:
+		log.Fatalf("%v - unsupported reduction (%v)\n", fnName, r)
+	}
+
+	return -1
+}
@@ -0,0 +1,120 @@
+package tensor_test
+
+import (
+	"math"
+	"testing"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func crossEntropy(logits []float64, target int) float64 {
+	var sum float64
+	for _, l := range logits {
+		sum += math.Exp(l)
+	}
+
+	return math.Log(sum) - logits[target]
+}
+
+func assertClose(t *testing.T, name string, want, got []float64) {
+	if len(want) != len(got) {
+		t.Errorf("Expected %v: %v\n", name, want)
+		t.Errorf("Got %v: %v\n", name, got)
+		return
+	}
+	for i := range want {
+		if math.Abs(want[i]-got[i]) > 1e-6 {
+			t.Errorf("Expected %v: %v\n", name, want)
+			t.Errorf("Got %v: %v\n", name, got)
+			return
+		}
+	}
+}
+
+func TestReduction(t *testing.T) {
+	tests := []struct {
+		reduction ts.Reduction
+		wantInt   int
+		wantStr   string
+	}{
+		{ts.ReductionNone, 0, "none"},
+		{ts.ReductionMean, 1, "mean"},
+		{ts.ReductionSum, 2, "sum"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.reduction.ToInt(); got != tt.wantInt {
+			t.Errorf("Expected %v libtorch value: %v\n", tt.wantStr, tt.wantInt)
+			t.Errorf("Got %v libtorch value: %v\n", tt.wantStr, got)
+		}
+		if got := tt.reduction.String(); got != tt.wantStr {
+			t.Errorf("Expected string: %v\n", tt.wantStr)
+			t.Errorf("Got string: %v\n", got)
+		}
+	}
+}
+
+func TestCrossEntropyForLogits(t *testing.T) {
+	logits := ts.MustOfSlice([]float64{1, 2, 3, 1, 0, 0}).MustView([]int64{2, 3}, true)
+	targets := ts.MustOfSlice([]int64{2, 0})
+
+	ce0 := crossEntropy([]float64{1, 2, 3}, 2)
+	ce1 := crossEntropy([]float64{1, 0, 0}, 0)
+
+	got := ts.CrossEntropyForLogits(logits, targets).Float64Values()
+	assertClose(t, "mean loss", []float64{(ce0 + ce1) / 2}, got)
+
+	got = ts.CrossEntropyForLogits(logits, targets, ts.WithReduction(ts.ReductionSum)).Float64Values()
+	assertClose(t, "sum loss", []float64{ce0 + ce1}, got)
+
+	got = ts.CrossEntropyForLogits(logits, targets, ts.WithReduction(ts.ReductionNone)).Float64Values()
+	assertClose(t, "unreduced loss", []float64{ce0, ce1}, got)
+
+	// ignored (padded) target does not contribute
+	padded := ts.MustOfSlice([]int64{2, -100})
+	got = ts.CrossEntropyForLogits(logits, padded).Float64Values()
+	assertClose(t, "loss with ignored target", []float64{ce0}, got)
+
+	// weighted mean: sum(w_i * l_i) / sum(w_i)
+	weight := ts.MustOfSlice([]float64{1, 1, 2})
+	got = ts.CrossEntropyForLogits(logits, targets, ts.WithLossWeight(weight)).Float64Values()
+	assertClose(t, "weighted loss", []float64{(2*ce0 + ce1) / 3}, got)
+}
+
+func TestNLLLoss(t *testing.T) {
+	logProbs := ts.MustOfSlice([]float32{-0.5, -1.0, -2.0, -3.0}).MustView([]int64{2, 2}, true)
+	targets := ts.MustOfSlice([]int64{1, 0})
+
+	got := ts.NLLLoss(logProbs, targets, ts.WithReduction(ts.ReductionSum)).Float64Values()
+	assertClose(t, "sum loss", []float64{3.0}, got)
+
+	logProbs.MustRequiresGrad_(true)
+	loss := ts.NLLLoss(logProbs, targets)
+	if !loss.MustRequiresGrad() {
+		t.Errorf("Expected loss to keep autograd graph\n")
+	}
+}
+
+func TestRegressionLosses(t *testing.T) {
+	input := ts.MustOfSlice([]float64{0.0, 1.0, 4.0})
+	target := ts.MustOfSlice([]float64{0.5, 1.0, 1.0})
+	// errors: 0.5, 0, 3
+
+	assertClose(t, "MSE mean", []float64{(0.25 + 9) / 3}, ts.MSELoss(input, target, ts.ReductionMean).Float64Values())
+	assertClose(t, "MSE sum", []float64{9.25}, ts.MSELoss(input, target, ts.ReductionSum).Float64Values())
+	assertClose(t, "MSE none", []float64{0.25, 0, 9}, ts.MSELoss(input, target, ts.ReductionNone).Float64Values())
+
+	assertClose(t, "L1 mean", []float64{3.5 / 3}, ts.L1Loss(input, target, ts.ReductionMean).Float64Values())
+	assertClose(t, "L1 sum", []float64{3.5}, ts.L1Loss(input, target, ts.ReductionSum).Float64Values())
+	assertClose(t, "L1 none", []float64{0.5, 0, 3}, ts.L1Loss(input, target, ts.ReductionNone).Float64Values())
+
+	// beta = 1: 0.5 * 0.5^2 / 1 = 0.125; 3 - 0.5 = 2.5
+	assertClose(t, "SmoothL1 none", []float64{0.125, 0, 2.5}, ts.SmoothL1Loss(input, target, 1.0, ts.ReductionNone).Float64Values())
+	assertClose(t, "SmoothL1 sum", []float64{2.625}, ts.SmoothL1Loss(input, target, 1.0, ts.ReductionSum).Float64Values())
+	assertClose(t, "SmoothL1 mean", []float64{2.625 / 3}, ts.SmoothL1Loss(input, target, 1.0, ts.ReductionMean).Float64Values())
+
+	// identical input and target
+	assertClose(t, "MSE identical", []float64{0}, ts.MSELoss(input, input, ts.ReductionMean).Float64Values())
+	assertClose(t, "L1 identical", []float64{0}, ts.L1Loss(input, input, ts.ReductionMean).Float64Values())
+	assertClose(t, "SmoothL1 identical", []float64{0}, ts.SmoothL1Loss(input, input, 1.0, ts.ReductionMean).Float64Values())
+}
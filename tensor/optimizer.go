@@ -2,6 +2,7 @@ package tensor
 
 import (
 	"log"
+	"runtime"
 
 	lib "github.com/sugarme/gotch/libtch"
 )
@@ -69,6 +70,36 @@ func (co *COptimizer) AddParameters(tensors []Tensor) error {
 
 	// NOTE. temporary switch back as param group not updated yet!
 	lib.AtoAddParametersOld(co.coptimizer, ctensors, ntensors)
+	runtime.KeepAlive(tensors)
+
+	return TorchErr()
+}
+
+// SetParameterGroup moves parameters to param group `group`, removing them
+// from any other group. Groups are created as needed with the optimizer
+// default hyperparameters.
+func (co *COptimizer) SetParameterGroup(tensors []Tensor, group uint) error {
+	for _, t := range tensors {
+		lib.AtoSetParameterGroup(co.coptimizer, t.ctensor, group)
+		runtime.KeepAlive(t)
+		if err := TorchErr(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetLearningRateGroup sets learning rate for a param group of the optimizer.
+func (co *COptimizer) SetLearningRateGroup(group uint, lr float64) error {
+	lib.AtoSetLearningRateGroup(co.coptimizer, group, lr)
+
+	return TorchErr()
+}
+
+// SetWeightDecayGroup sets weight decay for a param group of the optimizer.
+func (co *COptimizer) SetWeightDecayGroup(group uint, wd float64) error {
+	lib.AtoSetWeightDecayGroup(co.coptimizer, group, wd)
 
 	return TorchErr()
 }
@@ -95,12 +126,29 @@ func (co *COptimizer) ZeroGrad() error {
 }
 
 // Steps proceeds optimizer
+//
+// Parameters not requiring grad are skipped and their gradients reset.
 func (co *COptimizer) Step() error {
 	lib.AtoStep(co.coptimizer)
 
 	return TorchErr()
 }
 
+// Save saves the optimizer state (e.g. Adam moment buffers) to a file.
+func (co *COptimizer) Save(path string) error {
+	lib.AtoSave(co.coptimizer, path)
+
+	return TorchErr()
+}
+
+// Load loads the optimizer state saved with `Save`. The optimizer should hold
+// the same parameters, in the same order, as the saved one.
+func (co *COptimizer) Load(path string) error {
+	lib.AtoLoad(co.coptimizer, path)
+
+	return TorchErr()
+}
+
 // Drop removes optimizer and frees up memory.
 func (co *COptimizer) Drop() {
 	lib.AtoFree(co.coptimizer)
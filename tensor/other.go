@@ -3,13 +3,16 @@ package tensor
 // Other tensor methods
 
 import (
+	"fmt"
+	"log"
+
 	"github.com/sugarme/gotch"
 )
 
 // CrossEntropyForLogits computes the cross-entropy loss based on some logits and targets.
 func (ts *Tensor) CrossEntropyForLogits(targets *Tensor) (retVal *Tensor) {
 	weight := NewTensor()
-	reduction := int64(1) // Mean of loss
+	reduction := int64(ReductionMean.ToInt())
 	ignoreIndex := int64(-100)
 
 	logSm := ts.MustLogSoftmax(-1, gotch.Float, true)
@@ -24,6 +27,141 @@ func (ts *Tensor) AccuracyForLogits(targets *Tensor) (retVal *Tensor) {
 	return eq1.MustTotype(gotch.Float, true).MustMean(gotch.Float, true)
 }
 
+// ConfusionMatrix counts predicted versus true classes in a [numClasses, numClasses]
+// int64 tensor on CPU. Row i, column j is the number of samples of true class i
+// predicted as class j.
+//
+// Predictions can be class indices of the same shape as targets, or logits
+// with an extra trailing dimension of size numClasses which are reduced by
+// argmax first. An error is returned if any class index is out of range.
+func ConfusionMatrix(predictions, targets *Tensor, numClasses int64) (*Tensor, error) {
+	preds := predictions
+	if predictions.Dim() == targets.Dim()+1 {
+		preds = predictions.MustArgmax([]int64{-1}, false, false)
+		defer preds.MustDrop()
+	}
+
+	if predShape, targetShape := preds.MustSize(), targets.MustSize(); ElementCount(predShape) != ElementCount(targetShape) {
+		err := fmt.Errorf("ConfusionMatrix() failed: predictions of shape %v mismatched with targets of shape %v.\n", predictions.MustSize(), targetShape)
+		return nil, err
+	}
+
+	predVals := preds.Int64Values()
+	targetVals := targets.Int64Values()
+	counts := make([]int64, numClasses*numClasses)
+	for i, target := range targetVals {
+		pred := predVals[i]
+		if target < 0 || target >= numClasses || pred < 0 || pred >= numClasses {
+			err := fmt.Errorf("ConfusionMatrix() failed: class index out of range [0, %v): prediction %v, target %v.\n", numClasses, pred, target)
+			return nil, err
+		}
+		counts[target*numClasses+pred]++
+	}
+
+	return NewTensorFromData(counts, []int64{numClasses, numClasses})
+}
+
+// MustConfusionMatrix counts predicted versus true classes. It panics if error.
+func MustConfusionMatrix(predictions, targets *Tensor, numClasses int64) *Tensor {
+	retVal, err := ConfusionMatrix(predictions, targets, numClasses)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// PerClassAccuracy returns the accuracy of each class from a confusion matrix
+// (see `ConfusionMatrix`), i.e. its diagonal over its row sums, as a float
+// tensor. Classes without samples have accuracy 0.
+func PerClassAccuracy(confusion *Tensor) (*Tensor, error) {
+	shape := confusion.MustSize()
+	if len(shape) != 2 || shape[0] != shape[1] {
+		err := fmt.Errorf("PerClassAccuracy() failed: expected a square confusion matrix, got shape %v.\n", shape)
+		return nil, err
+	}
+
+	n := shape[0]
+	counts := confusion.Int64Values()
+	acc := make([]float32, n)
+	for i := int64(0); i < n; i++ {
+		var rowSum int64
+		for j := int64(0); j < n; j++ {
+			rowSum += counts[i*n+j]
+		}
+		if rowSum > 0 {
+			acc[i] = float32(counts[i*n+i]) / float32(rowSum)
+		}
+	}
+
+	return OfSlice(acc)
+}
+
+// MustPerClassAccuracy returns the accuracy of each class from a confusion
+// matrix. It panics if error.
+func MustPerClassAccuracy(confusion *Tensor) *Tensor {
+	retVal, err := PerClassAccuracy(confusion)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// PixelUnshuffle reverses `PixelShuffle` by rearranging a tensor of shape
+// [*, C, H*r, W*r] into [*, C*r*r, H, W] where r is the downscale factor.
+//
+// An error is returned if the height or width is not divisible by r.
+func (ts *Tensor) PixelUnshuffle(downscaleFactor int64, del bool) (retVal *Tensor, err error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	shape := ts.MustSize()
+	n := len(shape)
+	if n < 3 {
+		err = fmt.Errorf("PixelUnshuffle() failed: expected a tensor with at least 3 dims, got shape %v.\n", shape)
+		return retVal, err
+	}
+	r := downscaleFactor
+	c, h, w := shape[n-3], shape[n-2], shape[n-1]
+	if r <= 0 || h%r != 0 || w%r != 0 {
+		err = fmt.Errorf("PixelUnshuffle() failed: height (%v) and width (%v) should be divisible by downscale factor (%v).\n", h, w, r)
+		return retVal, err
+	}
+
+	batch := shape[:n-3]
+	viewShape := append(append([]int64{}, batch...), c, h/r, r, w/r, r)
+	dims := make([]int64, 0, n+2)
+	for i := range batch {
+		dims = append(dims, int64(i))
+	}
+	b := int64(len(batch))
+	dims = append(dims, b, b+2, b+4, b+1, b+3)
+	outShape := append(append([]int64{}, batch...), c*r*r, h/r, w/r)
+
+	view, err := ts.View(viewShape, false)
+	if err != nil {
+		return retVal, err
+	}
+	permuted, err := view.Permute(dims, true)
+	if err != nil {
+		return retVal, err
+	}
+
+	return permuted.Reshape(outShape, true)
+}
+
+// MustPixelUnshuffle reverses `PixelShuffle`. It panics if error.
+func (ts *Tensor) MustPixelUnshuffle(downscaleFactor int64, del bool) (retVal *Tensor) {
+	retVal, err := ts.PixelUnshuffle(downscaleFactor, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
 func (ts *Tensor) MaxPool2DDefault(ksize int64, del bool) (retVal *Tensor) {
 	return ts.MustMaxPool2d([]int64{ksize, ksize}, []int64{ksize, ksize}, []int64{0, 0}, []int64{1, 1}, false, del)
 }
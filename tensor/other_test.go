@@ -0,0 +1,80 @@
+package tensor_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestConfusionMatrix(t *testing.T) {
+	preds := ts.MustOfSlice([]int64{0, 1, 1, 2, 2, 0})
+	targets := ts.MustOfSlice([]int64{0, 1, 2, 2, 2, 1})
+
+	cm := ts.MustConfusionMatrix(preds, targets, 3)
+	want := []int64{
+		1, 0, 0,
+		1, 1, 0,
+		0, 1, 2,
+	}
+	if got := cm.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected confusion matrix: %v\n", want)
+		t.Errorf("Got confusion matrix: %v\n", got)
+	}
+
+	// trace over total equals overall accuracy
+	trace := cm.MustTrace(false).Int64Values()[0]
+	total := cm.MustSum(gotch.Int64, false).Int64Values()[0]
+	acc := preds.MustEq1(targets, false).MustTotype(gotch.Double, true).MustMean(gotch.Double, true).Float64Values()[0]
+	if got := float64(trace) / float64(total); math.Abs(got-acc) > 1e-6 {
+		t.Errorf("Expected trace over total: %v\n", acc)
+		t.Errorf("Got trace over total: %v\n", got)
+	}
+
+	wantAcc := []float64{1, 0.5, 2.0 / 3.0}
+	assertClose(t, "per-class accuracy", wantAcc, ts.MustPerClassAccuracy(cm).Float64Values())
+
+	// logits are reduced by argmax
+	logits := preds.MustOneHot(3, false).MustTotype(gotch.Float, true)
+	if got := ts.MustConfusionMatrix(logits, targets, 3).Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected confusion matrix from logits: %v\n", want)
+		t.Errorf("Got confusion matrix from logits: %v\n", got)
+	}
+
+	if _, err := ts.ConfusionMatrix(preds, targets, 2); err == nil {
+		t.Errorf("Expected error for out-of-range class, got nil\n")
+	}
+}
+
+func TestPixelShuffle(t *testing.T) {
+	x := ts.MustArange(ts.IntScalar(16), gotch.Float, gotch.CPU).MustView([]int64{1, 4, 2, 2}, true)
+
+	shuffled := x.MustPixelShuffle(2, false)
+	if want, got := []int64{1, 1, 4, 4}, shuffled.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shuffled shape: %v\n", want)
+		t.Errorf("Got shuffled shape: %v\n", got)
+	}
+	// output pixel (i, j) comes from channel (i%2)*2 + j%2
+	want := []float64{
+		0, 4, 1, 5,
+		8, 12, 9, 13,
+		2, 6, 3, 7,
+		10, 14, 11, 15,
+	}
+	if got := shuffled.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shuffled values: %v\n", want)
+		t.Errorf("Got shuffled values: %v\n", got)
+	}
+
+	unshuffled := shuffled.MustPixelUnshuffle(2, true)
+	if !x.Equal(unshuffled) {
+		t.Errorf("Expected unshuffle to invert shuffle: %v\n", x.Float64Values())
+		t.Errorf("Got: %v\n", unshuffled.Float64Values())
+	}
+
+	if _, err := ts.MustZeros([]int64{1, 1, 3, 4}, gotch.Float, gotch.CPU).PixelUnshuffle(2, true); err == nil {
+		t.Errorf("Expected error for size not divisible by downscale factor, got nil\n")
+	}
+}
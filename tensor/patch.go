@@ -4,7 +4,9 @@ package tensor
 import "C"
 
 import (
+	"fmt"
 	"log"
+	"runtime"
 	"unsafe"
 
 	lib "github.com/sugarme/gotch/libtch"
@@ -50,12 +52,15 @@ func (ts *Tensor) Lstm(hxData []Tensor, paramsData []Tensor, hasBiases bool, num
 	}
 
 	lib.AtgLstm(ctensorPtr1, ts.ctensor, chxData, len(hxData), cparamsData, len(paramsData), chasBiases, numLayers, dropout, ctrain, cbidirectional, cbatchFirst)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(hxData)
+	runtime.KeepAlive(paramsData)
 	err = TorchErr()
 	if err != nil {
 		return output, h, c, err
 	}
 
-	return &Tensor{ctensor: *ctensorPtr1}, &Tensor{ctensor: *ctensorPtr2}, &Tensor{ctensor: *ctensorPtr3}, nil
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), newTensor(*ctensorPtr3), nil
 
 }
 
@@ -100,12 +105,15 @@ func (ts *Tensor) Gru(hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers
 	}
 
 	lib.AtgGru(ctensorPtr1, ts.ctensor, hx.ctensor, cparamsData, len(paramsData), chasBiases, numLayers, dropout, ctrain, cbidirectional, cbatchFirst)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(paramsData)
 	err = TorchErr()
 	if err != nil {
 		return output, h, err
 	}
 
-	return &Tensor{ctensor: *ctensorPtr1}, &Tensor{ctensor: *ctensorPtr2}, nil
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
 
 }
 
@@ -118,7 +126,105 @@ func (ts *Tensor) MustGru(hx *Tensor, paramsData []Tensor, hasBiases bool, numLa
 	return output, h
 }
 
+// RnnTanh applies a multi-layer Elman RNN with tanh nonlinearity to the input
+// sequence. It returns the output sequence and the final hidden state.
+func (ts *Tensor) RnnTanh(hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers int64, dropout float64, train bool, bidirectional bool, batchFirst bool) (output, h *Tensor, err error) {
+	return ts.rnn(lib.AtgRnnTanh, hx, paramsData, hasBiases, numLayers, dropout, train, bidirectional, batchFirst)
+}
+
+func (ts *Tensor) MustRnnTanh(hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers int64, dropout float64, train bool, bidirectional bool, batchFirst bool) (output, h *Tensor) {
+	output, h, err := ts.RnnTanh(hx, paramsData, hasBiases, numLayers, dropout, train, bidirectional, batchFirst)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return output, h
+}
+
+// RnnRelu applies a multi-layer Elman RNN with ReLU nonlinearity to the input
+// sequence. It returns the output sequence and the final hidden state.
+func (ts *Tensor) RnnRelu(hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers int64, dropout float64, train bool, bidirectional bool, batchFirst bool) (output, h *Tensor, err error) {
+	return ts.rnn(lib.AtgRnnRelu, hx, paramsData, hasBiases, numLayers, dropout, train, bidirectional, batchFirst)
+}
+
+func (ts *Tensor) MustRnnRelu(hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers int64, dropout float64, train bool, bidirectional bool, batchFirst bool) (output, h *Tensor) {
+	output, h, err := ts.RnnRelu(hx, paramsData, hasBiases, numLayers, dropout, train, bidirectional, batchFirst)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return output, h
+}
+
+type rnnFn func(ptr *lib.Ctensor, input lib.Ctensor, hx lib.Ctensor, paramsData []lib.Ctensor, paramsLen int, hasBiases int32, numLayers int64, dropout float64, train int32, bidirectional int32, batchFirst int32)
+
+func (ts *Tensor) rnn(fn rnnFn, hx *Tensor, paramsData []Tensor, hasBiases bool, numLayers int64, dropout float64, train bool, bidirectional bool, batchFirst bool) (output, h *Tensor, err error) {
+
+	// NOTE: `atg_rnn_tanh` and `atg_rnn_relu` create 2 consecutive Ctensors in
+	// memory of C land. The first Ctensor will have address given by
+	// `ctensorPtr1` here. The next pointer can be calculated based on
+	// `ctensorPtr1`
+	ctensorPtr1 := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
+	ctensorPtr2 := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(ctensorPtr1)) + unsafe.Sizeof(ctensorPtr1)))
+
+	var cparamsData []lib.Ctensor
+	for _, t := range paramsData {
+		cparamsData = append(cparamsData, t.ctensor)
+	}
+
+	var chasBiases int32 = 0
+	if hasBiases {
+		chasBiases = 1
+	}
+	var ctrain int32 = 0
+	if train {
+		ctrain = 1
+	}
+	var cbidirectional int32 = 0
+	if bidirectional {
+		cbidirectional = 1
+	}
+	var cbatchFirst int32 = 0
+	if batchFirst {
+		cbatchFirst = 1
+	}
+
+	fn(ctensorPtr1, ts.ctensor, hx.ctensor, cparamsData, len(paramsData), chasBiases, numLayers, dropout, ctrain, cbidirectional, cbatchFirst)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(paramsData)
+	err = TorchErr()
+	if err != nil {
+		return output, h, err
+	}
+
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
+}
+
+// TopK returns the k largest (or smallest if largest is false) values of ts
+// along dim and their indices (Int64). If sorted is true, values are sorted
+// in order of decreasing (or increasing) value.
 func (ts *Tensor) TopK(k int64, dim int64, largest bool, sorted bool) (ts1, ts2 *Tensor, err error) {
+	size, err := ts.Size()
+	if err != nil {
+		return ts1, ts2, err
+	}
+	n := int64(1) // 0d tensor
+	if ndims := int64(len(size)); ndims > 0 {
+		d := dim
+		if d < 0 {
+			d += ndims
+		}
+		if d < 0 || d >= ndims {
+			err = fmt.Errorf("TopK() failed: dim %v out of range for tensor of shape %v.\n", dim, size)
+			return ts1, ts2, err
+		}
+		n = size[d]
+	}
+	if k < 0 || k > n {
+		err = fmt.Errorf("TopK() failed: k (%v) should be in range [0, %v] for dim %v of tensor of shape %v.\n", k, n, dim, size)
+		return ts1, ts2, err
+	}
 
 	// NOTE: `lib.AtgTopk` will return 2 tensors in C memory. First tensor pointer
 	// is given by ctensorPtr1
@@ -134,12 +240,13 @@ func (ts *Tensor) TopK(k int64, dim int64, largest bool, sorted bool) (ts1, ts2
 	}
 
 	lib.AtgTopk(ctensorPtr1, ts.ctensor, k, dim, clargest, csorted)
+	runtime.KeepAlive(ts)
 	err = TorchErr()
 	if err != nil {
 		return ts1, ts2, err
 	}
 
-	return &Tensor{ctensor: *ctensorPtr1}, &Tensor{ctensor: *ctensorPtr2}, nil
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
 }
 
 func (ts *Tensor) MustTopK(k int64, dim int64, largest bool, sorted bool) (ts1, ts2 *Tensor) {
@@ -152,6 +259,41 @@ func (ts *Tensor) MustTopK(k int64, dim int64, largest bool, sorted bool) (ts1,
 	return ts1, ts2
 }
 
+// Sort sorts values of ts along dim in ascending (or descending) order. It
+// returns the sorted values and their indices (Int64) in ts.
+func (ts *Tensor) Sort(dim int64, descending bool, del bool) (values, indices *Tensor, err error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	// NOTE: `lib.AtgSort` will return 2 tensors in C memory. First tensor pointer
+	// is given by ctensorPtr1
+	ctensorPtr1 := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
+	ctensorPtr2 := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(ctensorPtr1)) + unsafe.Sizeof(ctensorPtr1)))
+	var cdescending int32 = 0
+	if descending {
+		cdescending = 1
+	}
+
+	lib.AtgSort(ctensorPtr1, ts.ctensor, dim, cdescending)
+	runtime.KeepAlive(ts)
+	if err = TorchErr(); err != nil {
+		return values, indices, err
+	}
+
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
+}
+
+// MustSort sorts values of ts along dim. It panics if error.
+func (ts *Tensor) MustSort(dim int64, descending bool, del bool) (values, indices *Tensor) {
+	values, indices, err := ts.Sort(dim, descending, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return values, indices
+}
+
 // NOTE. `NLLLoss` is a version of `NllLoss` in tensor-generated
 // with default weight, reduction and ignoreIndex
 func (ts *Tensor) NLLLoss(target *Tensor, del bool) (retVal *Tensor, err error) {
@@ -160,16 +302,18 @@ func (ts *Tensor) NLLLoss(target *Tensor, del bool) (retVal *Tensor, err error)
 		defer ts.MustDrop()
 	}
 
-	reduction := int64(1) // Mean of loss
+	reduction := int64(ReductionMean.ToInt())
 	ignoreIndex := int64(-100)
 	// defer C.free(unsafe.Pointer(ptr))
 
 	lib.AtgNllLoss(ptr, ts.ctensor, target.ctensor, nil, reduction, ignoreIndex)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, nil
 }
@@ -208,19 +352,20 @@ func AlignTensors(tensors []Tensor) (retVal []Tensor, err error) {
 	}
 
 	ctensorsPtr := lib.AtgAlignTensors(ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -250,19 +395,20 @@ func BroadcastTensors(tensors []Tensor) (retVal []Tensor, err error) {
 	}
 
 	ctensorsPtr := lib.AtgBroadcastTensors(ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -287,12 +433,13 @@ func MustBroadcastTensors(tensors []Tensor, del bool) (retVal []Tensor) {
 // tensor *atg_chunk(tensor self, int64_t chunks, int64_t dim);
 func (ts *Tensor) Chunk(chunks int64, dim int64) (retVal []Tensor, err error) {
 	ctensorsPtr := lib.AtgChunk(ts.ctensor, chunks, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		// calculate the next pointer value
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
@@ -300,7 +447,7 @@ func (ts *Tensor) Chunk(chunks int64, dim int64) (retVal []Tensor, err error) {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -329,19 +476,20 @@ func (ts *Tensor) Meshgrid(tensors []Tensor) (retVal []Tensor, err error) {
 	}
 
 	ctensorsPtr := lib.AtgMeshgrid(ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -365,19 +513,20 @@ func (ts *Tensor) MustMeshgrid(tensors []Tensor, del bool) (retVal []Tensor) {
 func (ts *Tensor) NonzeroNumpy() (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgNonzeroNumpy(ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -406,6 +555,7 @@ func (ts *Tensor) MustNonzeroNumpy(del bool) (retVal []Tensor) {
 func (ts *Tensor) Split(splitSize, dim int64) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgSplit(ts.ctensor, splitSize, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
@@ -415,7 +565,7 @@ func (ts *Tensor) Split(splitSize, dim int64) (retVal []Tensor, err error) {
 	// calculated from there. The vector of tensors will end if the calculated
 	// pointer value is `null`.
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		// calculate the next pointer value
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
@@ -423,7 +573,7 @@ func (ts *Tensor) Split(splitSize, dim int64) (retVal []Tensor, err error) {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -452,6 +602,7 @@ func (ts *Tensor) MustSplit(splitSize, dim int64, del bool) (retVal []Tensor) {
 func (ts *Tensor) SplitWithSizes(splitSizes []int64, dim int64) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgSplitWithSizes(ts.ctensor, splitSizes, len(splitSizes), dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
@@ -461,7 +612,7 @@ func (ts *Tensor) SplitWithSizes(splitSizes []int64, dim int64) (retVal []Tensor
 	// calculated from there. The vector of tensors will end if the calculated
 	// pointer value is `null`.
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		// calculate the next pointer value
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
@@ -469,7 +620,7 @@ func (ts *Tensor) SplitWithSizes(splitSizes []int64, dim int64) (retVal []Tensor
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -493,19 +644,20 @@ func (ts *Tensor) MustSplitWithSizes(splitSizes []int64, dim int64, del bool) (r
 func (ts *Tensor) Unbind(dim int64) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgUnbind(ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
@@ -529,19 +681,20 @@ func (ts *Tensor) MustUnbind(dim int64, del bool) (retVal []Tensor) {
 func Where(condition Tensor) (retVal []Tensor, err error) {
 
 	ctensorsPtr := lib.AtgWhere(condition.ctensor)
+	runtime.KeepAlive(condition)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
 
 	currentPtr := ctensorsPtr
-	retVal = append(retVal, Tensor{ctensor: *currentPtr})
+	retVal = append(retVal, *newTensor(*currentPtr))
 	for {
 		nextPtr := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(currentPtr)) + unsafe.Sizeof(currentPtr)))
 		if *nextPtr == nil {
 			break
 		}
 
-		retVal = append(retVal, Tensor{ctensor: *nextPtr})
+		retVal = append(retVal, *newTensor(*nextPtr))
 		currentPtr = nextPtr
 	}
 
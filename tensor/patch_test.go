@@ -0,0 +1,114 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestArgmaxArgmin(t *testing.T) {
+	// logits of 3 samples over 4 classes
+	logits := ts.MustOfSlice([]float32{
+		0.1, 2.5, -1, 0.3,
+		3, 0, 1, 2,
+		-2, -1, -0.5, -3,
+	}).MustView([]int64{3, 4}, true)
+
+	argmax := logits.MustArgmax([]int64{-1}, false, false)
+	if want, got := []int64{1, 0, 2}, argmax.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected argmax: %v\n", want)
+		t.Errorf("Got argmax: %v\n", got)
+	}
+
+	argmin := logits.MustArgmin([]int64{0}, true, false)
+	if want, got := []int64{1, 3}, argmin.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected argmin shape: %v\n", want)
+		t.Errorf("Got argmin shape: %v\n", got)
+	}
+	if want, got := []int64{2, 2, 0, 2}, argmin.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected argmin: %v\n", want)
+		t.Errorf("Got argmin: %v\n", got)
+	}
+
+	// over all dims of the flattened tensor
+	if want, got := []int64{4}, logits.MustArgmax(nil, false, false).Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected flattened argmax: %v\n", want)
+		t.Errorf("Got flattened argmax: %v\n", got)
+	}
+
+	argmax.MustDrop()
+	argmin.MustDrop()
+	logits.MustDrop()
+}
+
+func TestSortTopK(t *testing.T) {
+	x := ts.MustOfSlice([]float64{3, 1, 4, 1.5, 9, 2, 6, 5}).MustView([]int64{2, 4}, true)
+
+	values, indices := x.MustSort(-1, false, false)
+	if want, got := []float64{1, 1.5, 3, 4, 2, 5, 6, 9}, values.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected sorted values: %v\n", want)
+		t.Errorf("Got sorted values: %v\n", got)
+	}
+	if want, got := []int64{1, 3, 0, 2, 1, 3, 2, 0}, indices.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected sorted indices: %v\n", want)
+		t.Errorf("Got sorted indices: %v\n", got)
+	}
+	values.MustDrop()
+	indices.MustDrop()
+
+	values, indices = x.MustSort(0, true, false)
+	if want, got := []float64{9, 2, 6, 5, 3, 1, 4, 1.5}, values.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected descending sorted values: %v\n", want)
+		t.Errorf("Got descending sorted values: %v\n", got)
+	}
+	values.MustDrop()
+	indices.MustDrop()
+
+	values, indices = x.MustTopK(3, 1, true, true)
+	if want, got := []int64{2, 3}, values.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected top-k shape: %v\n", want)
+		t.Errorf("Got top-k shape: %v\n", got)
+	}
+	if want, got := []float64{4, 3, 1.5, 9, 6, 5}, values.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected sorted top-k values: %v\n", want)
+		t.Errorf("Got sorted top-k values: %v\n", got)
+	}
+	if want, got := []int64{2, 0, 3, 0, 2, 3}, indices.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected top-k indices: %v\n", want)
+		t.Errorf("Got top-k indices: %v\n", got)
+	}
+	values.MustDrop()
+	indices.MustDrop()
+
+	// smallest
+	values, indices = x.MustTopK(2, -1, false, true)
+	if want, got := []float64{1, 1.5, 2, 5}, values.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected sorted bottom-k values: %v\n", want)
+		t.Errorf("Got sorted bottom-k values: %v\n", got)
+	}
+	values.MustDrop()
+	indices.MustDrop()
+
+	if _, _, err := x.TopK(5, 1, true, true); err == nil {
+		t.Errorf("Expected error for k larger than dim size, got nil\n")
+	}
+	if _, _, err := x.TopK(1, 2, true, true); err == nil {
+		t.Errorf("Expected error for out of range dim, got nil\n")
+	}
+
+	// random values
+	values, indices = ts.MustRandn([]int64{10}, gotch.Float, gotch.CPU).MustSort(0, false, true)
+	vals := values.Float64Values()
+	for i := 1; i < len(vals); i++ {
+		if vals[i-1] > vals[i] {
+			t.Errorf("Expected ascending values, got: %v\n", vals)
+			break
+		}
+	}
+	values.MustDrop()
+	indices.MustDrop()
+
+	x.MustDrop()
+}
@@ -0,0 +1,206 @@
+package tensor
+
+// Tensor summarized printing.
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/sugarme/gotch"
+)
+
+// PrintOptions controls how tensor values are printed. It mirrors
+// `torch.set_printoptions`.
+type PrintOptions struct {
+	Precision int // number of digits after the decimal point for float values.
+	EdgeItems int // number of items shown at the beginning and end of each dimension when summarized.
+	Threshold int // total number of elements which triggers summarization.
+	LineWidth int // number of characters per line before wrapping.
+}
+
+// DefaultPrintOptions returns the default printing options.
+func DefaultPrintOptions() *PrintOptions {
+	return &PrintOptions{
+		Precision: 4,
+		EdgeItems: 3,
+		Threshold: 1000,
+		LineWidth: 80,
+	}
+}
+
+// String implements fmt.Stringer interface. It returns the same as printing
+// the tensor with `fmt` and the `%v` verb (see `Format`), large tensors being
+// summarized with default print options.
+//
+// NOTE: use `StringOpts` to change formatting.
+func (ts *Tensor) String() string {
+	return fmt.Sprintf("%v", ts)
+}
+
+// StringOpts returns tensor values formatted with given print options.
+//
+// If the number of elements exceeds `opts.Threshold`, each dimension is
+// summarized by showing only `opts.EdgeItems` items from each end.
+func (ts *Tensor) StringOpts(opts *PrintOptions) string {
+	shape := ts.MustSize()
+
+	x := ts
+	if ts.MustDevice().IsCuda() {
+		x = ts.MustTo(gotch.CPU, false)
+		defer x.MustDrop()
+	}
+	// Half precision values are stored as raw bits, format them as float.
+	if dtype := x.DType(); dtype == gotch.Half || dtype == gotch.BFloat16 {
+		x = x.MustTotype(gotch.Float, false)
+		defer x.MustDrop()
+	}
+	if isQuantizedDType(x.DType()) {
+		x = x.MustDequantize(false)
+		defer x.MustDrop()
+	}
+
+	data := reflect.ValueOf(x.Vals())
+	summarize := data.Len() > opts.Threshold
+
+	// 0d (scalar)
+	if len(shape) == 0 {
+		return formatElem(data.Index(0), opts.Precision)
+	}
+
+	p := &tensorPrinter{
+		opts:      opts,
+		data:      data,
+		shape:     shape,
+		summarize: summarize,
+		buf:       bytes.NewBuffer(make([]byte, 0)),
+	}
+	p.writeDim(0, 0)
+
+	return p.buf.String()
+}
+
+// PrintOpts prints out tensor values formatted with given print options
+// followed by tensor dtype and shape.
+func (ts *Tensor) PrintOpts(opts *PrintOptions) {
+	shape := ts.MustSize()
+	dims := make([]string, len(shape))
+	for i, d := range shape {
+		dims[i] = strconv.FormatInt(d, 10)
+	}
+
+	fmt.Printf("%v\n[ %v %v{%v} ]\n", ts.StringOpts(opts), ts.MustDevice().Name, ts.DType(), strings.Join(dims, ","))
+}
+
+// tensorPrinter writes nested bracketed tensor values to a buffer.
+type tensorPrinter struct {
+	opts      *PrintOptions
+	data      reflect.Value // flat slice of values
+	shape     []int64
+	summarize bool
+	buf       *bytes.Buffer
+}
+
+// indexes returns indexes of a dimension of size n to print. -1 marks
+// summarized (skipped) items.
+func (p *tensorPrinter) indexes(n int64) []int64 {
+	edge := int64(p.opts.EdgeItems)
+	if !p.summarize || n <= 2*edge {
+		idxs := make([]int64, n)
+		for i := range idxs {
+			idxs[i] = int64(i)
+		}
+		return idxs
+	}
+
+	var idxs []int64
+	for i := int64(0); i < edge; i++ {
+		idxs = append(idxs, i)
+	}
+	idxs = append(idxs, -1)
+	for i := n - edge; i < n; i++ {
+		idxs = append(idxs, i)
+	}
+
+	return idxs
+}
+
+// writeDim recursively writes dimension `d` starting at flat `offset`.
+func (p *tensorPrinter) writeDim(d int, offset int64) {
+	stride := int64(product(p.shape[d+1:]))
+	idxs := p.indexes(p.shape[d])
+	indent := strings.Repeat(" ", d+1)
+
+	p.buf.WriteString("[")
+
+	// last dim: write elements wrapped at line width
+	if d == len(p.shape)-1 {
+		lineLen := d + 1
+		for i, idx := range idxs {
+			el := "..."
+			if idx >= 0 {
+				el = formatElem(p.data.Index(int(offset+idx)), p.opts.Precision)
+			}
+			if i > 0 {
+				p.buf.WriteString(",")
+				lineLen++
+				if lineLen+len(el)+2 > p.opts.LineWidth {
+					p.buf.WriteString("\n" + indent)
+					lineLen = d + 1
+				} else {
+					p.buf.WriteString(" ")
+					lineLen++
+				}
+			}
+			p.buf.WriteString(el)
+			lineLen += len(el)
+		}
+		p.buf.WriteString("]")
+		return
+	}
+
+	// separate sub-tensors by an empty line for dims >= 3
+	sep := ",\n" + strings.Repeat("\n", len(p.shape)-d-2) + indent
+	for i, idx := range idxs {
+		if i > 0 {
+			p.buf.WriteString(sep)
+		}
+		if idx < 0 {
+			p.buf.WriteString("...")
+			continue
+		}
+		p.writeDim(d+1, offset+idx*stride)
+	}
+	p.buf.WriteString("]")
+}
+
+// formatElem formats a single element. Float values are formatted with given
+// precision.
+func formatElem(v reflect.Value, precision int) string {
+	switch v.Kind() {
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', precision, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', precision, 64)
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		im := strconv.FormatFloat(imag(c), 'f', precision, 64)
+		if im[0] != '-' && im[0] != '+' {
+			im = "+" + im
+		}
+		return "(" + strconv.FormatFloat(real(c), 'f', precision, 64) + im + "i)"
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint8:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+		log.Fatalf("Unsupported element kind (%v)\n", v.Kind())
+	}
+
+	return ""
+}
@@ -0,0 +1,70 @@
+package tensor_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestTensorString(t *testing.T) {
+	small := ts.MustArange(ts.IntScalar(6), gotch.Int64, gotch.CPU).MustView([]int64{2, 3}, true)
+	want := "[[0, 1, 2],\n [3, 4, 5]]"
+	got := small.StringOpts(ts.DefaultPrintOptions())
+	if got != want {
+		t.Errorf("Expected tensor string: %q\n", want)
+		t.Errorf("Got tensor string: %q\n", got)
+	}
+
+	floats := ts.MustOfSlice([]float64{1.0, 2.5})
+	opts := ts.DefaultPrintOptions()
+	opts.Precision = 2
+	want = "[1.00, 2.50]"
+	got = floats.StringOpts(opts)
+	if got != want {
+		t.Errorf("Expected tensor string: %q\n", want)
+		t.Errorf("Got tensor string: %q\n", got)
+	}
+}
+
+func TestTensorStringSummarized(t *testing.T) {
+	large := ts.MustArange(ts.IntScalar(10000), gotch.Int64, gotch.CPU).MustView([]int64{100, 100}, true)
+	got := large.StringOpts(ts.DefaultPrintOptions())
+
+	if !strings.Contains(got, "...") {
+		t.Errorf("Expected summarized tensor string, got: %q\n", got)
+	}
+
+	// 3 edge items from each end per dimension
+	want := "[[0, 1, 2, ..., 97, 98, 99],\n [100, 101, 102, ..., 197, 198, 199],\n [200, 201, 202, ..., 297, 298, 299],\n ...,\n [9700, 9701, 9702, ..., 9797, 9798, 9799],\n [9800, 9801, 9802, ..., 9897, 9898, 9899],\n [9900, 9901, 9902, ..., 9997, 9998, 9999]]"
+	if got != want {
+		t.Errorf("Expected tensor string: %q\n", want)
+		t.Errorf("Got tensor string: %q\n", got)
+	}
+}
+
+func TestTensorStringFormat(t *testing.T) {
+	x := ts.MustArange(ts.IntScalar(6), gotch.Int64, gotch.CPU).MustView([]int64{2, 3}, true)
+	if want, got := fmt.Sprintf("%v", x), x.String(); want != got {
+		t.Errorf("Expected String same as fmt: %q\n", want)
+		t.Errorf("Got String: %q\n", got)
+	}
+
+	large := ts.MustArange(ts.IntScalar(10000), gotch.Int64, gotch.CPU).MustView([]int64{100, 100}, true)
+	if want, got := large.StringOpts(ts.DefaultPrintOptions()), large.String(); want != got {
+		t.Errorf("Expected summarized tensor string: %q\n", want)
+		t.Errorf("Got tensor string: %q\n", got)
+	}
+	if want, got := large.String(), fmt.Sprintf("%v", large); want != got {
+		t.Errorf("Expected fmt same as String: %q\n", want)
+		t.Errorf("Got fmt: %q\n", got)
+	}
+
+	scalar := ts.MustOfSlice([]int64{7}).MustView([]int64{}, true)
+	if want, got := "7", scalar.String(); want != got {
+		t.Errorf("Expected scalar tensor string: %q\n", want)
+		t.Errorf("Got scalar tensor string: %q\n", got)
+	}
+}
@@ -29,10 +29,18 @@ func (ts *Tensor) ValueGo() interface{} {
 		dst = make([]int32, numel)
 	case gotch.Int64:
 		dst = make([]int64, numel)
+	case gotch.Half:
+		dst = make([]gotch.GoFloat16, numel)
+	case gotch.BFloat16:
+		dst = make([]gotch.GoBFloat16, numel)
 	case gotch.Float:
 		dst = make([]float32, numel)
 	case gotch.Double:
 		dst = make([]float64, numel)
+	case gotch.ComplexFloat:
+		dst = make([]complex64, numel)
+	case gotch.ComplexDouble:
+		dst = make([]complex128, numel)
 	case gotch.Bool:
 		dst = make([]bool, numel)
 	default:
@@ -152,6 +160,16 @@ func (ts *Tensor) Format(s fmt.State, c rune) {
 		return
 	}
 
+	// Large tensors are summarized with default print options (see
+	// `StringOpts`).
+	if opts := DefaultPrintOptions(); int(ts.Numel()) > opts.Threshold {
+		if p, ok := s.Precision(); ok {
+			opts.Precision = p
+		}
+		fmt.Fprint(s, ts.StringOpts(opts))
+		return
+	}
+
 	data := ts.ValueGo()
 
 	f := newFmtState(s, c, shape)
@@ -160,7 +178,8 @@ func (ts *Tensor) Format(s fmt.State, c rune) {
 
 	// 0d (scalar)
 	if len(shape) == 0 {
-		fmt.Printf("%v", data)
+		fmt.Fprintf(f, f.cleanFmt(), reflect.ValueOf(data).Index(0).Interface())
+		return
 	}
 
 	// 1d (slice)
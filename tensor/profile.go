@@ -0,0 +1,125 @@
+package tensor
+
+// Op-level profiling with the libtorch autograd profiler.
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// ProfileEvent is a libtorch op run recorded by `Profile`.
+type ProfileEvent struct {
+	Name     string // op name, e.g. "aten::matmul"
+	CPUTime  time.Duration
+	CUDATime time.Duration // zero if CUDA is not available
+	Shape    []int64       // shape of the first input, nil if none
+}
+
+// ProfileEvents is a list of profiled op runs in recorded order.
+type ProfileEvents []ProfileEvent
+
+// Profile runs fn under the libtorch autograd profiler and returns an event
+// for each op run. Nested ops (e.g. "aten::mm" called by "aten::matmul") are
+// recorded as separate events.
+//
+// CUDA time is recorded when CUDA is available. Profiling works with stock
+// libtorch builds but adds overhead to each op.
+//
+// NOTE: only ops run by fn on the calling goroutine are recorded, ops run by
+// goroutines it starts are not.
+func Profile(fn func()) (retVal ProfileEvents, err error) {
+	// The profiler state is thread-local.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	lib.AtpEnableProfiler(gotch.CudaIsAvailable(), true)
+	if err = TorchErr(); err != nil {
+		err = fmt.Errorf("Profile() failed: %v\n", err)
+		return retVal, err
+	}
+
+	func() {
+		defer func() {
+			p := lib.AtpDisableProfiler()
+			if err = TorchErr(); err != nil {
+				err = fmt.Errorf("Profile() failed: %v\n", err)
+				return
+			}
+			defer lib.AtpProfileFree(p)
+
+			n := lib.AtpProfileLen(p)
+			retVal = make(ProfileEvents, n)
+			for i := 0; i < n; i++ {
+				retVal[i] = ProfileEvent{
+					Name:     lib.AtpProfileEventName(p, i),
+					CPUTime:  time.Duration(lib.AtpProfileEventCpuUs(p, i) * float64(time.Microsecond)),
+					CUDATime: time.Duration(lib.AtpProfileEventCudaUs(p, i) * float64(time.Microsecond)),
+					Shape:    lib.AtpProfileEventShape(p, i),
+				}
+			}
+		}()
+
+		fn()
+	}()
+
+	return retVal, err
+}
+
+// MustProfile runs fn under the libtorch autograd profiler. It panics if error.
+func MustProfile(fn func()) (retVal ProfileEvents) {
+	retVal, err := Profile(fn)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Table returns a table of events aggregated by op name, with number of
+// calls and total CPU and CUDA time, sorted by total CPU time.
+func (events ProfileEvents) Table() string {
+	type stats struct {
+		name     string
+		calls    int
+		cpuTime  time.Duration
+		cudaTime time.Duration
+	}
+
+	var (
+		ops   []*stats
+		index = make(map[string]*stats)
+	)
+	for _, e := range events {
+		s, ok := index[e.Name]
+		if !ok {
+			s = &stats{name: e.Name}
+			index[e.Name] = s
+			ops = append(ops, s)
+		}
+		s.calls++
+		s.cpuTime += e.CPUTime
+		s.cudaTime += e.CUDATime
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].cpuTime > ops[j].cpuTime
+	})
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tCalls\tCPU total\tCPU avg\tCUDA total")
+	for _, s := range ops {
+		avg := s.cpuTime / time.Duration(s.calls)
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", s.name, s.calls, s.cpuTime, avg, s.cudaTime)
+	}
+	w.Flush()
+
+	return buf.String()
+}
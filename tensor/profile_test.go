@@ -0,0 +1,43 @@
+package tensor_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestProfile(t *testing.T) {
+	x := ts.MustRandn([]int64{16, 32}, gotch.Float, gotch.CPU)
+	y := ts.MustRandn([]int64{32, 8}, gotch.Float, gotch.CPU)
+
+	events, err := ts.Profile(func() {
+		for i := 0; i < 3; i++ {
+			x.MustMatmul(y, false).MustDrop()
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var matmuls []ts.ProfileEvent
+	for _, e := range events {
+		if e.Name == "aten::matmul" {
+			matmuls = append(matmuls, e)
+		}
+	}
+	if len(matmuls) != 3 {
+		t.Fatalf("Expected 3 aten::matmul events, got %v in %v\n", len(matmuls), events)
+	}
+	if want, got := []int64{16, 32}, matmuls[0].Shape; !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected matmul input shape: %v\n", want)
+		t.Errorf("Got matmul input shape: %v\n", got)
+	}
+
+	table := events.Table()
+	if !strings.Contains(table, "aten::matmul") {
+		t.Errorf("Expected table to contain aten::matmul, got:\n%v\n", table)
+	}
+}
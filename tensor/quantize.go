@@ -0,0 +1,178 @@
+package tensor
+
+// Per-tensor affine quantization.
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"runtime"
+
+	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// NOTE: a quantized value q represents the real value `scale * (q - zeroPoint)`.
+// Real values are mapped to `clamp(round(x/scale) + zeroPoint, qmin, qmax)`
+// where [qmin, qmax] is the range of the quantized dtype.
+
+func isQuantizedDType(dtype gotch.DType) bool {
+	switch dtype {
+	case gotch.QInt8, gotch.QUInt8, gotch.QInt32:
+		return true
+	}
+	return false
+}
+
+// QuantRange returns the range [qmin, qmax] of quantized values of dtype.
+func QuantRange(dtype gotch.DType) (qmin, qmax int64, err error) {
+	switch dtype {
+	case gotch.QInt8:
+		return math.MinInt8, math.MaxInt8, nil
+	case gotch.QUInt8:
+		return 0, math.MaxUint8, nil
+	case gotch.QInt32:
+		return math.MinInt32, math.MaxInt32, nil
+	}
+
+	err = fmt.Errorf("QuantRange() failed: expected a quantized dtype (QInt8, QUInt8 or QInt32), got %v.\n", dtype)
+	return 0, 0, err
+}
+
+// ChooseQParams computes per-tensor affine quantization parameters mapping
+// the range of x values (extended to include 0) to the range of dtype.
+func ChooseQParams(x *Tensor, dtype gotch.DType) (scale float64, zeroPoint int64, err error) {
+	qmin, qmax, err := QuantRange(dtype)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	minTs := x.MustMin(false)
+	maxTs := x.MustMax(false)
+	min := math.Min(minTs.Float64Values()[0], 0)
+	max := math.Max(maxTs.Float64Values()[0], 0)
+	minTs.MustDrop()
+	maxTs.MustDrop()
+
+	scale = (max - min) / float64(qmax-qmin)
+	if scale == 0 {
+		scale = 1.0
+	}
+
+	zeroPoint = qmin - int64(math.Round(min/scale))
+	if zeroPoint < qmin {
+		zeroPoint = qmin
+	}
+	if zeroPoint > qmax {
+		zeroPoint = qmax
+	}
+
+	return scale, zeroPoint, nil
+}
+
+// Quantize quantizes a floating point tensor to dtype (QInt8, QUInt8 or
+// QInt32) with per-tensor affine quantization parameters.
+func Quantize(input *Tensor, scale float64, zeroPoint int64, dtype gotch.DType) (*Tensor, error) {
+	if inputDType := input.DType(); !isFloatingDType(inputDType) {
+		err := fmt.Errorf("Quantize() failed: expected a floating point input, got %v.\n", inputDType)
+		return nil, err
+	}
+	qmin, qmax, err := QuantRange(dtype)
+	if err != nil {
+		return nil, err
+	}
+	if scale <= 0 {
+		err := fmt.Errorf("Quantize() failed: expected a positive scale, got %v.\n", scale)
+		return nil, err
+	}
+	if zeroPoint < qmin || zeroPoint > qmax {
+		err := fmt.Errorf("Quantize() failed: zero point %v out of range [%v, %v] of %v.\n", zeroPoint, qmin, qmax, dtype)
+		return nil, err
+	}
+
+	x := input
+	if input.DType() != gotch.Float {
+		x, err = input.Totype(gotch.Float, false)
+		if err != nil {
+			return nil, err
+		}
+		defer x.MustDrop()
+	}
+
+	return x.QuantizePerTensor(scale, zeroPoint, dtype, false)
+}
+
+// MustQuantize quantizes a floating point tensor. It panics if error.
+func MustQuantize(input *Tensor, scale float64, zeroPoint int64, dtype gotch.DType) *Tensor {
+	retVal, err := Quantize(input, scale, zeroPoint, dtype)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Dequantize converts a quantized tensor back to a gotch.Float tensor.
+func Dequantize(input *Tensor) (*Tensor, error) {
+	if dtype := input.DType(); !isQuantizedDType(dtype) {
+		err := fmt.Errorf("Dequantize() failed: expected a quantized input, got %v.\n", dtype)
+		return nil, err
+	}
+
+	return input.Dequantize(false)
+}
+
+// MustDequantize converts a quantized tensor back to a gotch.Float tensor. It
+// panics if error.
+func MustDequantize(input *Tensor) *Tensor {
+	retVal, err := Dequantize(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// QScale returns the scale of a per-tensor quantized tensor.
+func (ts *Tensor) QScale() (float64, error) {
+	scale := lib.AtQScale(ts.ctensor)
+	runtime.KeepAlive(ts)
+	if err := TorchErr(); err != nil {
+		return 0, err
+	}
+
+	return scale, nil
+}
+
+// MustQScale returns the scale of a per-tensor quantized tensor. It panics if
+// error.
+func (ts *Tensor) MustQScale() float64 {
+	scale, err := ts.QScale()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return scale
+}
+
+// QZeroPoint returns the zero point of a per-tensor quantized tensor.
+func (ts *Tensor) QZeroPoint() (int64, error) {
+	zeroPoint := lib.AtQZeroPoint(ts.ctensor)
+	runtime.KeepAlive(ts)
+	if err := TorchErr(); err != nil {
+		return 0, err
+	}
+
+	return zeroPoint, nil
+}
+
+// MustQZeroPoint returns the zero point of a per-tensor quantized tensor. It
+// panics if error.
+func (ts *Tensor) MustQZeroPoint() int64 {
+	zeroPoint, err := ts.QZeroPoint()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return zeroPoint
+}
@@ -0,0 +1,67 @@
+package tensor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestQuantize(t *testing.T) {
+	x := ts.MustRandn([]int64{100}, gotch.Float, gotch.CPU).MustMul1(ts.FloatScalar(3), true)
+	want := x.Float64Values()
+
+	for _, dtype := range []gotch.DType{gotch.QInt8, gotch.QUInt8} {
+		scale, zeroPoint, err := ts.ChooseQParams(x, dtype)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		q := ts.MustQuantize(x, scale, zeroPoint, dtype)
+		if got := q.DType(); got != dtype {
+			t.Errorf("Expected quantized dtype: %v\n", dtype)
+			t.Errorf("Got quantized dtype: %v\n", got)
+		}
+		if got := q.MustQScale(); math.Abs(got-scale) > 1e-9 {
+			t.Errorf("Expected scale: %v\n", scale)
+			t.Errorf("Got scale: %v\n", got)
+		}
+		if got := q.MustQZeroPoint(); got != zeroPoint {
+			t.Errorf("Expected zero point: %v\n", zeroPoint)
+			t.Errorf("Got zero point: %v\n", got)
+		}
+
+		deq := ts.MustDequantize(q)
+		if got := deq.DType(); got != gotch.Float {
+			t.Errorf("Expected dequantized dtype: %v\n", gotch.Float)
+			t.Errorf("Got dequantized dtype: %v\n", got)
+		}
+		for i, v := range deq.Float64Values() {
+			if math.Abs(v-want[i]) > scale {
+				t.Errorf("Expected %v dequantized value within %v of: %v\n", dtype, scale, want[i])
+				t.Errorf("Got %v dequantized value: %v\n", dtype, v)
+				break
+			}
+		}
+
+		q.MustDrop()
+		deq.MustDrop()
+	}
+
+	// invalid arguments
+	if _, err := ts.Quantize(x, 0.1, 0, gotch.Int8); err == nil {
+		t.Errorf("Expected error for non-quantized dtype, got nil\n")
+	}
+	if _, err := ts.Quantize(x, 0.1, 200, gotch.QInt8); err == nil {
+		t.Errorf("Expected error for out of range zero point, got nil\n")
+	}
+	if _, err := ts.Quantize(x, -0.1, 0, gotch.QInt8); err == nil {
+		t.Errorf("Expected error for negative scale, got nil\n")
+	}
+	if _, err := ts.Dequantize(x); err == nil {
+		t.Errorf("Expected error for dequantizing a float tensor, got nil\n")
+	}
+
+	x.MustDrop()
+}
@@ -0,0 +1,273 @@
+package tensor
+
+// Reductions over dimensions.
+
+// #include "stdlib.h"
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"unsafe"
+
+	"github.com/sugarme/gotch"
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// NOTE: the reductions below take a slice of dims to reduce over. A nil or
+// empty slice reduces over all dims. Negative dims count from the last one.
+// If keepDim is true, reduced dims are retained with size 1.
+
+// reduceDims normalizes dim to non-negative dims of ts, or returns all dims if
+// dim is empty.
+func (ts *Tensor) reduceDims(fn string, dim []int64) ([]int64, error) {
+	ndims := int64(ts.Dim())
+	if len(dim) == 0 {
+		dims := make([]int64, ndims)
+		for i := range dims {
+			dims[i] = int64(i)
+		}
+		return dims, nil
+	}
+
+	dims := make([]int64, len(dim))
+	seen := make(map[int64]bool, len(dim))
+	for i, d := range dim {
+		if d < -ndims || d >= ndims {
+			err := fmt.Errorf("%v() failed: dim %v out of range for tensor of %v dims.\n", fn, d, ndims)
+			return nil, err
+		}
+		if d < 0 {
+			d += ndims
+		}
+		if seen[d] {
+			err := fmt.Errorf("%v() failed: dim %v appears multiple times in %v.\n", fn, d, dim)
+			return nil, err
+		}
+		seen[d] = true
+		dims[i] = d
+	}
+
+	return dims, nil
+}
+
+// MeanDim returns the mean of values over dims, computed in dtype.
+func (ts *Tensor) MeanDim(dim []int64, keepDim bool, dtype gotch.DType, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	dims, err := ts.reduceDims("MeanDim", dim)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.Mean1(dims, keepDim, dtype, false)
+}
+
+// MustMeanDim returns the mean of values over dims. It panics if error.
+func (ts *Tensor) MustMeanDim(dim []int64, keepDim bool, dtype gotch.DType, del bool) *Tensor {
+	retVal, err := ts.MeanDim(dim, keepDim, dtype, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// SumDim returns the sum of values over dims, computed in dtype.
+func (ts *Tensor) SumDim(dim []int64, keepDim bool, dtype gotch.DType, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	dims, err := ts.reduceDims("SumDim", dim)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.Sum1(dims, keepDim, dtype, false)
+}
+
+// MustSumDim returns the sum of values over dims. It panics if error.
+func (ts *Tensor) MustSumDim(dim []int64, keepDim bool, dtype gotch.DType, del bool) *Tensor {
+	retVal, err := ts.SumDim(dim, keepDim, dtype, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// ProdDim returns the product of values over dims, computed in dtype.
+func (ts *Tensor) ProdDim(dim []int64, keepDim bool, dtype gotch.DType, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	dims, err := ts.reduceDims("ProdDim", dim)
+	if err != nil {
+		return nil, err
+	}
+
+	// libtorch reduces one dim at a time. Going from the last dim keeps the
+	// remaining dims valid when reduced dims are removed.
+	sort.Slice(dims, func(i, j int) bool { return dims[i] > dims[j] })
+
+	retVal, err := ts.ShallowClone()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dims {
+		retVal, err = retVal.Prod1(d, keepDim, dtype, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 0d tensor or empty dims
+	if retVal.DType() != dtype {
+		return retVal.Totype(dtype, true)
+	}
+
+	return retVal, nil
+}
+
+// MustProdDim returns the product of values over dims. It panics if error.
+func (ts *Tensor) MustProdDim(dim []int64, keepDim bool, dtype gotch.DType, del bool) *Tensor {
+	retVal, err := ts.ProdDim(dim, keepDim, dtype, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// StdDim returns the standard deviation of values over dims. If unbiased is
+// true, Bessel's correction (n - 1) is used.
+func (ts *Tensor) StdDim(dim []int64, unbiased, keepDim bool, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	dims, err := ts.reduceDims("StdDim", dim)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.Std1(dims, unbiased, keepDim, false)
+}
+
+// MustStdDim returns the standard deviation of values over dims. It panics if
+// error.
+func (ts *Tensor) MustStdDim(dim []int64, unbiased, keepDim bool, del bool) *Tensor {
+	retVal, err := ts.StdDim(dim, unbiased, keepDim, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// VarDim returns the variance of values over dims. If unbiased is true,
+// Bessel's correction (n - 1) is used.
+func (ts *Tensor) VarDim(dim []int64, unbiased, keepDim bool, del bool) (*Tensor, error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	dims, err := ts.reduceDims("VarDim", dim)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.Var1(dims, unbiased, keepDim, false)
+}
+
+// MustVarDim returns the variance of values over dims. It panics if error.
+func (ts *Tensor) MustVarDim(dim []int64, unbiased, keepDim bool, del bool) *Tensor {
+	retVal, err := ts.VarDim(dim, unbiased, keepDim, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// MaxDim returns the maximum values over dim and their indices (Int64).
+//
+// NOTE: use `Max` to reduce over all dims, or `Amax` for values only over
+// several dims.
+func (ts *Tensor) MaxDim(dim int64, keepDim bool, del bool) (values, indices *Tensor, err error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	// NOTE: `lib.AtgMax2` will return 2 tensors in C memory. First tensor pointer
+	// is given by ctensorPtr1
+	ctensorPtr1 := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
+	ctensorPtr2 := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(ctensorPtr1)) + unsafe.Sizeof(ctensorPtr1)))
+	var ckeepDim int32 = 0
+	if keepDim {
+		ckeepDim = 1
+	}
+
+	lib.AtgMax2(ctensorPtr1, ts.ctensor, dim, ckeepDim)
+	runtime.KeepAlive(ts)
+	if err = TorchErr(); err != nil {
+		return nil, nil, err
+	}
+
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
+}
+
+// MustMaxDim returns the maximum values over dim and their indices. It panics
+// if error.
+func (ts *Tensor) MustMaxDim(dim int64, keepDim bool, del bool) (values, indices *Tensor) {
+	values, indices, err := ts.MaxDim(dim, keepDim, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return values, indices
+}
+
+// MinDim returns the minimum values over dim and their indices (Int64).
+//
+// NOTE: use `Min` to reduce over all dims, or `Amin` for values only over
+// several dims.
+func (ts *Tensor) MinDim(dim int64, keepDim bool, del bool) (values, indices *Tensor, err error) {
+	if del {
+		defer ts.MustDrop()
+	}
+
+	// NOTE: `lib.AtgMin2` will return 2 tensors in C memory. First tensor pointer
+	// is given by ctensorPtr1
+	ctensorPtr1 := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
+	ctensorPtr2 := (*lib.Ctensor)(unsafe.Pointer(uintptr(unsafe.Pointer(ctensorPtr1)) + unsafe.Sizeof(ctensorPtr1)))
+	var ckeepDim int32 = 0
+	if keepDim {
+		ckeepDim = 1
+	}
+
+	lib.AtgMin2(ctensorPtr1, ts.ctensor, dim, ckeepDim)
+	runtime.KeepAlive(ts)
+	if err = TorchErr(); err != nil {
+		return nil, nil, err
+	}
+
+	return newTensor(*ctensorPtr1), newTensor(*ctensorPtr2), nil
+}
+
+// MustMinDim returns the minimum values over dim and their indices. It panics
+// if error.
+func (ts *Tensor) MustMinDim(dim int64, keepDim bool, del bool) (values, indices *Tensor) {
+	values, indices, err := ts.MinDim(dim, keepDim, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return values, indices
+}
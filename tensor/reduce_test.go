@@ -0,0 +1,138 @@
+package tensor_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestReduceDim(t *testing.T) {
+	// [[1, 2, 3],
+	//  [4, 5, 6]]
+	x := ts.MustOfSlice([]float64{1, 2, 3, 4, 5, 6}).MustView([]int64{2, 3}, true)
+
+	sqrt := func(vals ...float64) []float64 {
+		for i, v := range vals {
+			vals[i] = math.Sqrt(v)
+		}
+		return vals
+	}
+
+	type reduce func(dim []int64, keepDim bool) *ts.Tensor
+	ops := []struct {
+		name string
+		fn   reduce
+		want map[string][]float64 // keyed by dims
+	}{
+		{
+			"mean",
+			func(dim []int64, keepDim bool) *ts.Tensor { return x.MustMeanDim(dim, keepDim, gotch.Double, false) },
+			map[string][]float64{"[0]": {2.5, 3.5, 4.5}, "[1]": {2, 5}, "[]": {3.5}},
+		},
+		{
+			"sum",
+			func(dim []int64, keepDim bool) *ts.Tensor { return x.MustSumDim(dim, keepDim, gotch.Double, false) },
+			map[string][]float64{"[0]": {5, 7, 9}, "[1]": {6, 15}, "[]": {21}},
+		},
+		{
+			"prod",
+			func(dim []int64, keepDim bool) *ts.Tensor { return x.MustProdDim(dim, keepDim, gotch.Double, false) },
+			map[string][]float64{"[0]": {4, 10, 18}, "[1]": {6, 120}, "[]": {720}},
+		},
+		{
+			"var",
+			func(dim []int64, keepDim bool) *ts.Tensor { return x.MustVarDim(dim, true, keepDim, false) },
+			map[string][]float64{"[0]": {4.5, 4.5, 4.5}, "[1]": {1, 1}, "[]": {3.5}},
+		},
+		{
+			"std",
+			func(dim []int64, keepDim bool) *ts.Tensor { return x.MustStdDim(dim, true, keepDim, false) },
+			map[string][]float64{"[0]": sqrt(4.5, 4.5, 4.5), "[1]": {1, 1}, "[]": sqrt(3.5)},
+		},
+	}
+
+	for _, op := range ops {
+		for _, tc := range []struct {
+			key       string
+			dim       []int64
+			keepDim   bool
+			wantShape []int64
+		}{
+			{"[0]", []int64{0}, false, []int64{3}},
+			{"[0]", []int64{0}, true, []int64{1, 3}},
+			{"[1]", []int64{1}, false, []int64{2}},
+			{"[1]", []int64{-1}, true, []int64{2, 1}},
+			{"[]", nil, false, []int64{}},
+			{"[]", nil, true, []int64{1, 1}},
+			{"[]", []int64{1, 0}, false, []int64{}},
+		} {
+			res := op.fn(tc.dim, tc.keepDim)
+			if got := res.MustSize(); !reflect.DeepEqual(tc.wantShape, got) {
+				t.Errorf("Expected %v shape over %v (keepDim=%v): %v\n", op.name, tc.dim, tc.keepDim, tc.wantShape)
+				t.Errorf("Got %v shape over %v (keepDim=%v): %v\n", op.name, tc.dim, tc.keepDim, got)
+			}
+			assertClose(t, op.name+" over "+tc.key, op.want[tc.key], res.Float64Values())
+			res.MustDrop()
+		}
+	}
+
+	if _, err := x.SumDim([]int64{2}, false, gotch.Double, false); err == nil {
+		t.Errorf("Expected error for out of range dim, got nil\n")
+	}
+	if _, err := x.ProdDim([]int64{0, -2}, false, gotch.Double, false); err == nil {
+		t.Errorf("Expected error for repeated dim, got nil\n")
+	}
+
+	x.MustDrop()
+}
+
+func TestMaxMinDim(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 6, 3, 4, 5, 2}).MustView([]int64{2, 3}, true)
+
+	for _, tc := range []struct {
+		name        string
+		max         bool
+		dim         int64
+		keepDim     bool
+		wantShape   []int64
+		wantValues  []float64
+		wantIndices []int64
+	}{
+		{"max", true, 0, false, []int64{3}, []float64{4, 6, 3}, []int64{1, 0, 0}},
+		{"max", true, 1, true, []int64{2, 1}, []float64{6, 5}, []int64{1, 1}},
+		{"min", false, 0, true, []int64{1, 3}, []float64{1, 5, 2}, []int64{0, 1, 1}},
+		{"min", false, -1, false, []int64{2}, []float64{1, 2}, []int64{0, 2}},
+	} {
+		var values, indices *ts.Tensor
+		if tc.max {
+			values, indices = x.MustMaxDim(tc.dim, tc.keepDim, false)
+		} else {
+			values, indices = x.MustMinDim(tc.dim, tc.keepDim, false)
+		}
+
+		if got := values.MustSize(); !reflect.DeepEqual(tc.wantShape, got) {
+			t.Errorf("Expected %v values shape over %v: %v\n", tc.name, tc.dim, tc.wantShape)
+			t.Errorf("Got %v values shape over %v: %v\n", tc.name, tc.dim, got)
+		}
+		if got := indices.MustSize(); !reflect.DeepEqual(tc.wantShape, got) {
+			t.Errorf("Expected %v indices shape over %v: %v\n", tc.name, tc.dim, tc.wantShape)
+			t.Errorf("Got %v indices shape over %v: %v\n", tc.name, tc.dim, got)
+		}
+		if got := values.Float64Values(); !reflect.DeepEqual(tc.wantValues, got) {
+			t.Errorf("Expected %v values over %v: %v\n", tc.name, tc.dim, tc.wantValues)
+			t.Errorf("Got %v values over %v: %v\n", tc.name, tc.dim, got)
+		}
+		if got := indices.Int64Values(); !reflect.DeepEqual(tc.wantIndices, got) {
+			t.Errorf("Expected %v indices over %v: %v\n", tc.name, tc.dim, tc.wantIndices)
+			t.Errorf("Got %v indices over %v: %v\n", tc.name, tc.dim, got)
+		}
+
+		values.MustDrop()
+		indices.MustDrop()
+	}
+
+	x.MustDrop()
+}
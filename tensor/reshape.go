@@ -0,0 +1,128 @@
+package tensor
+
+// Reshaping helpers taking shapes and dimensions as variadic arguments, e.g.
+// `ts.MustView(x, -1, 4)`.
+//
+// NOTE: unlike the generated methods (e.g. `x.View(shape, del)`), these helpers
+// never delete their input.
+
+import (
+	"fmt"
+	"log"
+)
+
+// View returns a view of x with the given shape. At most one dimension can be
+// -1, in which case its size is inferred (see `InferShape`).
+func View(x *Tensor, shape ...int64) (*Tensor, error) {
+	size, err := InferShape(shape, int64(x.Numel()))
+	if err != nil {
+		return nil, fmt.Errorf("View() failed: %v", err)
+	}
+
+	return x.View(size, false)
+}
+
+// MustView returns a view of x with the given shape. It panics if error.
+func MustView(x *Tensor, shape ...int64) *Tensor {
+	retVal, err := View(x, shape...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Reshape returns x with the given shape, as a view if possible or a copy
+// otherwise. At most one dimension can be -1, in which case its size is
+// inferred (see `InferShape`).
+func Reshape(x *Tensor, shape ...int64) (*Tensor, error) {
+	size, err := InferShape(shape, int64(x.Numel()))
+	if err != nil {
+		return nil, fmt.Errorf("Reshape() failed: %v", err)
+	}
+
+	return x.Reshape(size, false)
+}
+
+// MustReshape returns x with the given shape. It panics if error.
+func MustReshape(x *Tensor, shape ...int64) *Tensor {
+	retVal, err := Reshape(x, shape...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Permute returns a view of x with its dimensions permuted, e.g.
+// `Permute(x, 1, 0)` transposes a 2D tensor.
+func Permute(x *Tensor, dims ...int64) (*Tensor, error) {
+	if dim := x.Dim(); uint64(len(dims)) != dim {
+		err := fmt.Errorf("Permute() failed: expected %v dims for a %v-dimensional tensor, got %v.\n", dim, dim, dims)
+		return nil, err
+	}
+
+	return x.Permute(dims, false)
+}
+
+// MustPermute returns a view of x with its dimensions permuted. It panics if
+// error.
+func MustPermute(x *Tensor, dims ...int64) *Tensor {
+	retVal, err := Permute(x, dims...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Flatten flattens dimensions startDim to endDim (inclusive) of x into one.
+// Negative dimensions count from the end, e.g. `Flatten(x, 1, -1)` flattens
+// all but the batch dimension.
+func Flatten(x *Tensor, startDim, endDim int64) (*Tensor, error) {
+	return x.Flatten(startDim, endDim, false)
+}
+
+// MustFlatten flattens dimensions startDim to endDim (inclusive) of x into
+// one. It panics if error.
+func MustFlatten(x *Tensor, startDim, endDim int64) *Tensor {
+	retVal, err := Flatten(x, startDim, endDim)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Unsqueeze returns a view of x with a dimension of size 1 inserted at dim.
+func Unsqueeze(x *Tensor, dim int64) (*Tensor, error) {
+	return x.Unsqueeze(dim, false)
+}
+
+// MustUnsqueeze returns a view of x with a dimension of size 1 inserted at
+// dim. It panics if error.
+func MustUnsqueeze(x *Tensor, dim int64) *Tensor {
+	retVal, err := Unsqueeze(x, dim)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Squeeze returns a view of x with dimension dim removed if its size is 1,
+// x unchanged otherwise.
+func Squeeze(x *Tensor, dim int64) (*Tensor, error) {
+	return x.Squeeze1(dim, false)
+}
+
+// MustSqueeze returns a view of x with dimension dim removed if its size is 1.
+// It panics if error.
+func MustSqueeze(x *Tensor, dim int64) *Tensor {
+	retVal, err := Squeeze(x, dim)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
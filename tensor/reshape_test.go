@@ -0,0 +1,76 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestViewInferDim(t *testing.T) {
+	x := ts.MustArange(ts.IntScalar(24), gotch.Float, gotch.CPU)
+
+	got := ts.MustView(x, 2, -1, 3).MustSize()
+	want := []int64{2, 4, 3}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+
+	got = ts.MustReshape(x, -1, 8).MustSize()
+	want = []int64{3, 8}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+
+	// 24 elements cannot be split in rows of 5.
+	if _, err := ts.View(x, -1, 5); err == nil {
+		t.Errorf("Expected error for shape [-1 5] of 24 elements\n")
+	}
+	if _, err := ts.Reshape(x, -1, -1); err == nil {
+		t.Errorf("Expected error for shape [-1 -1]\n")
+	}
+}
+
+func TestPermute(t *testing.T) {
+	x := ts.MustOfSlice([]int64{1, 2, 3, 4, 5, 6}).MustView([]int64{2, 3}, true)
+
+	y := ts.MustPermute(x, 1, 0)
+	wantShape := []int64{3, 2}
+	if got := y.MustSize(); !reflect.DeepEqual(wantShape, got) {
+		t.Errorf("Expected shape: %v\n", wantShape)
+		t.Errorf("Got shape: %v\n", got)
+	}
+	want := []int64{1, 4, 2, 5, 3, 6}
+	if got := y.Vals(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected values: %v\n", want)
+		t.Errorf("Got values: %v\n", got)
+	}
+
+	if _, err := ts.Permute(x, 0); err == nil {
+		t.Errorf("Expected error for permuting 2 dims with 1 index\n")
+	}
+}
+
+func TestFlattenUnsqueezeSqueeze(t *testing.T) {
+	x := ts.MustZeros([]int64{2, 3, 4}, gotch.Float, gotch.CPU)
+
+	tests := []struct {
+		got  *ts.Tensor
+		want []int64
+	}{
+		{ts.MustFlatten(x, 1, -1), []int64{2, 12}},
+		{ts.MustUnsqueeze(x, 0), []int64{1, 2, 3, 4}},
+		{ts.MustSqueeze(ts.MustUnsqueeze(x, 1), 1), []int64{2, 3, 4}},
+		{ts.MustSqueeze(x, 0), []int64{2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.MustSize(); !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("Expected shape: %v\n", tt.want)
+			t.Errorf("Got shape: %v\n", got)
+		}
+	}
+}
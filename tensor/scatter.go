@@ -0,0 +1,223 @@
+package tensor
+
+// Scatter (segment) reductions.
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/sugarme/gotch"
+)
+
+// scatterOutSize returns shape of `src` with dimension `dim` replaced by `dimSize`.
+func scatterOutSize(src, index *Tensor, dim, dimSize int64) ([]int64, error) {
+	size, err := src.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	if dim < 0 {
+		dim += int64(len(size))
+	}
+	if dim < 0 || dim >= int64(len(size)) {
+		err = fmt.Errorf("Invalid dim (%v) for source tensor of shape %v.\n", dim, size)
+		return nil, err
+	}
+
+	idxSize, err := index.Size()
+	if err != nil {
+		return nil, err
+	}
+	if len(idxSize) != 1 || idxSize[0] != size[dim] {
+		err = fmt.Errorf("Expected a 1D index of length %v, got shape %v.\n", size[dim], idxSize)
+		return nil, err
+	}
+
+	outSize := make([]int64, len(size))
+	copy(outSize, size)
+	outSize[dim] = dimSize
+
+	return outSize, nil
+}
+
+// ScatterMean aggregates slices of `src` along dimension `dim` into `dimSize`
+// output bins by averaging.
+//
+// `index` is a 1D int64 tensor of length `src.size(dim)` holding the output bin
+// of each slice. Bins which receive no slice are filled with zeros.
+func ScatterMean(src, index *Tensor, dim, dimSize int64) (retVal *Tensor, err error) {
+	outSize, err := scatterOutSize(src, index, dim, dimSize)
+	if err != nil {
+		return nil, err
+	}
+	if dim < 0 {
+		dim += int64(len(outSize))
+	}
+
+	dtype := src.DType()
+	device, err := src.Device()
+	if err != nil {
+		return nil, err
+	}
+
+	sum, err := Zeros(outSize, dtype, device)
+	if err != nil {
+		return nil, err
+	}
+	defer sum.MustDrop()
+	if err = sum.IndexAdd_(dim, index, src); err != nil {
+		return nil, err
+	}
+
+	// number of slices per bin
+	ones, err := Ones(index.MustSize(), dtype, device)
+	if err != nil {
+		return nil, err
+	}
+	defer ones.MustDrop()
+	count, err := Zeros([]int64{dimSize}, dtype, device)
+	if err != nil {
+		return nil, err
+	}
+	defer count.MustDrop()
+	if err = count.IndexAdd_(0, index, ones); err != nil {
+		return nil, err
+	}
+	if err = count.ClampMin_(FloatScalar(1.0)); err != nil {
+		return nil, err
+	}
+
+	// broadcast count along dimension `dim`
+	countSize := make([]int64, len(outSize))
+	for i := range countSize {
+		countSize[i] = 1
+	}
+	countSize[dim] = dimSize
+	countView, err := count.View(countSize, false)
+	if err != nil {
+		return nil, err
+	}
+	defer countView.MustDrop()
+
+	return sum.Div(countView, false)
+}
+
+// MustScatterMean aggregates slices of `src` into bins by averaging. It panics if error.
+func MustScatterMean(src, index *Tensor, dim, dimSize int64) (retVal *Tensor) {
+	retVal, err := ScatterMean(src, index, dim, dimSize)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// ScatterMax aggregates slices of `src` along dimension `dim` into `dimSize`
+// output bins by taking the element-wise maximum.
+//
+// `index` is a 1D int64 tensor of length `src.size(dim)` holding the output bin
+// of each slice. Bins which receive no slice are filled with zeros.
+func ScatterMax(src, index *Tensor, dim, dimSize int64) (retVal *Tensor, err error) {
+	outSize, err := scatterOutSize(src, index, dim, dimSize)
+	if err != nil {
+		return nil, err
+	}
+	if dim < 0 {
+		dim += int64(len(outSize))
+	}
+
+	dtype := src.DType()
+	device, err := src.Device()
+	if err != nil {
+		return nil, err
+	}
+	n := index.MustSize()[0]
+	if n == 0 {
+		return Zeros(outSize, dtype, device)
+	}
+
+	// slices along dimension `dim` as rows of shape [n, 1, m]
+	rows, err := src.Transpose(0, dim, false)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.MustDrop()
+	rowsSize := rows.MustSize()
+	flatRows, err := rows.Reshape([]int64{n, 1, -1}, false)
+	if err != nil {
+		return nil, err
+	}
+	defer flatRows.MustDrop()
+
+	// mask[i][b] is true if slice i goes to bin b
+	oneHot, err := index.OneHot(dimSize, false)
+	if err != nil {
+		return nil, err
+	}
+	defer oneHot.MustDrop()
+	mask, err := oneHot.Totype(gotch.Bool, false)
+	if err != nil {
+		return nil, err
+	}
+	defer mask.MustDrop()
+	rowMask, err := mask.Unsqueeze(2, false)
+	if err != nil {
+		return nil, err
+	}
+	defer rowMask.MustDrop()
+
+	// Slices outside a bin are replaced by the minimum of src so that they do
+	// not change the bin maximum.
+	srcMin, err := src.Min(false)
+	if err != nil {
+		return nil, err
+	}
+	defer srcMin.MustDrop()
+	masked, err := flatRows.Where1(rowMask, srcMin, false)
+	if err != nil {
+		return nil, err
+	}
+	defer masked.MustDrop()
+	binMax, err := masked.Amax([]int64{0}, false, false)
+	if err != nil {
+		return nil, err
+	}
+	defer binMax.MustDrop()
+
+	// empty bins are filled with zeros
+	nonEmpty, err := mask.Any1(0, true, false)
+	if err != nil {
+		return nil, err
+	}
+	defer nonEmpty.MustDrop()
+	binMask, err := nonEmpty.View([]int64{dimSize, 1}, false)
+	if err != nil {
+		return nil, err
+	}
+	defer binMask.MustDrop()
+	bins, err := binMax.Where3(binMask, IntScalar(0), false)
+	if err != nil {
+		return nil, err
+	}
+	defer bins.MustDrop()
+
+	// back to the layout of src
+	rowsSize[0] = dimSize
+	binsView, err := bins.View(rowsSize, false)
+	if err != nil {
+		return nil, err
+	}
+	defer binsView.MustDrop()
+
+	return binsView.Transpose(0, dim, false)
+}
+
+// MustScatterMax aggregates slices of `src` into bins by maximum. It panics if error.
+func MustScatterMax(src, index *Tensor, dim, dimSize int64) (retVal *Tensor) {
+	retVal, err := ScatterMax(src, index, dim, dimSize)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
@@ -0,0 +1,98 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestScatterMeanMax(t *testing.T) {
+	src := ts.MustOfSlice([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}).MustView([]int64{5, 2}, true)
+	index := ts.MustOfSlice([]int64{0, 1, 0, 2, 0})
+
+	// bin 3 receives nothing
+	mean := ts.MustScatterMean(src, index, 0, 4)
+	wantMean := []float64{5, 6, 3, 4, 7, 8, 0, 0}
+	gotMean := mean.Float64Values()
+	if !reflect.DeepEqual(wantMean, gotMean) {
+		t.Errorf("Expected per-bin means: %v\n", wantMean)
+		t.Errorf("Got per-bin means: %v\n", gotMean)
+	}
+
+	max := ts.MustScatterMax(src, index, 0, 4)
+	wantMax := []float64{9, 10, 3, 4, 7, 8, 0, 0}
+	gotMax := max.Float64Values()
+	if !reflect.DeepEqual(wantMax, gotMax) {
+		t.Errorf("Expected per-bin maxima: %v\n", wantMax)
+		t.Errorf("Got per-bin maxima: %v\n", gotMax)
+	}
+
+	wantShape := []int64{4, 2}
+	if !reflect.DeepEqual(wantShape, max.MustSize()) {
+		t.Errorf("Expected output shape: %v\n", wantShape)
+		t.Errorf("Got output shape: %v\n", max.MustSize())
+	}
+
+	_, err := ts.ScatterMean(src, ts.MustOfSlice([]int64{0, 1}), 0, 4)
+	if err == nil {
+		t.Errorf("Expected error for index of mismatched length, got nil\n")
+	}
+
+	// inner dimension with negative values
+	neg := ts.MustOfSlice([]float64{-1, -5, 2, -3, -4, 6}).MustView([]int64{2, 3}, true)
+	negMax := ts.MustScatterMax(neg, ts.MustOfSlice([]int64{1, 0, 1}), 1, 3)
+	if want, got := []float64{-5, 2, 0, -4, 6, 0}, negMax.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected per-bin maxima: %v\n", want)
+		t.Errorf("Got per-bin maxima: %v\n", got)
+	}
+
+	if _, err := ts.ScatterMax(src, ts.MustOfSlice([]int64{0, 1, 0, 4, 0}), 0, 4); err == nil {
+		t.Errorf("Expected error for index out of range, got nil\n")
+	}
+}
+
+func TestGatherScatter(t *testing.T) {
+	// log-probs of 3 steps over 4 actions
+	logProbs := ts.MustArange(ts.IntScalar(12), gotch.Float, gotch.CPU).MustView([]int64{3, 4}, true)
+	actions := ts.MustOfSlice([]int64{2, 0, 3}).MustView([]int64{3, 1}, true)
+
+	chosen := logProbs.MustGather(1, actions, false, false)
+	if want, got := []float64{2, 4, 11}, chosen.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected gathered values: %v\n", want)
+		t.Errorf("Got gathered values: %v\n", got)
+	}
+	if want, got := []int64{3, 1}, chosen.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected gathered shape: %v\n", want)
+		t.Errorf("Got gathered shape: %v\n", got)
+	}
+
+	// histogram of values in [0, 4)
+	values := ts.MustOfSlice([]int64{1, 3, 1, 0, 1, 3})
+	ones := ts.MustOnes([]int64{6}, gotch.Float, gotch.CPU)
+	hist := ts.MustZeros([]int64{4}, gotch.Float, gotch.CPU).MustScatterAdd(0, values, ones, true)
+	if want, got := []float64{1, 3, 0, 2}, hist.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected scatter-add histogram: %v\n", want)
+		t.Errorf("Got scatter-add histogram: %v\n", got)
+	}
+
+	// scatter overwrites, out[index[i][j]][j] = src[i][j]
+	src := ts.MustOfSlice([]float32{1, 2, 3, 4}).MustView([]int64{2, 2}, true)
+	index := ts.MustOfSlice([]int64{2, 0, 0, 1}).MustView([]int64{2, 2}, true)
+	scattered := ts.MustZeros([]int64{3, 2}, gotch.Float, gotch.CPU).MustScatter(0, index, src, true)
+	if want, got := []float64{3, 2, 0, 4, 1, 0}, scattered.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected scattered values: %v\n", want)
+		t.Errorf("Got scattered values: %v\n", got)
+	}
+
+	// index out of range
+	badIndex := ts.MustOfSlice([]int64{4, 0, 1}).MustView([]int64{3, 1}, true)
+	if _, err := logProbs.Gather(1, badIndex, false, false); err == nil {
+		t.Errorf("Expected Gather error for out of range index, got nil\n")
+	}
+	// index with more dims than input
+	if _, err := logProbs.Gather(1, badIndex.MustView([]int64{3, 1, 1}, false), false, false); err == nil {
+		t.Errorf("Expected Gather error for index of mismatched dims, got nil\n")
+	}
+}
@@ -0,0 +1,88 @@
+package tensor
+
+// Sparse (COO) tensor helpers.
+
+import (
+	"fmt"
+	"log"
+)
+
+// NewSparseCOO creates a sparse tensor in COO (coordinate) format.
+//
+// indices - int64 tensor of shape [ndim, nnz] holding coordinates of non-zero values.
+// values - tensor of shape [nnz] holding the non-zero values.
+// size - shape of the (equivalent dense) sparse tensor.
+//
+// The sparse tensor has same dtype and device as `values`. Use `ToDense` to
+// convert it to a dense tensor and `ToSparse` for the reverse direction.
+func NewSparseCOO(indices, values *Tensor, size []int64) (retVal *Tensor, err error) {
+	device, err := values.Device()
+	if err != nil {
+		return nil, err
+	}
+
+	return SparseCooTensor2(indices, values, size, values.DType(), device)
+}
+
+// MustNewSparseCOO creates a sparse tensor in COO format. It panics if error.
+func MustNewSparseCOO(indices, values *Tensor, size []int64) (retVal *Tensor) {
+	retVal, err := NewSparseCOO(indices, values, size)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// SparseMM multiplies a 2D sparse tensor with a 2D dense tensor and returns a
+// dense tensor.
+func SparseMM(sparse, dense *Tensor) (retVal *Tensor, err error) {
+	isSparse, err := sparse.IsSparse()
+	if err != nil {
+		return nil, err
+	}
+	if !isSparse {
+		err = fmt.Errorf("SparseMM() failed: expected a sparse tensor as first argument.\n")
+		return nil, err
+	}
+
+	return sparse.Mm(dense, false)
+}
+
+// MustSparseMM multiplies a sparse tensor with a dense tensor. It panics if error.
+func MustSparseMM(sparse, dense *Tensor) (retVal *Tensor) {
+	retVal, err := SparseMM(sparse, dense)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// SparseSumDim sums a sparse tensor over given dimensions.
+//
+// The result is sparse unless all sparse dimensions are summed over, in which
+// case it is dense. Duplicate coordinates are accounted for, i.e. the tensor
+// does not need to be coalesced first.
+func (ts *Tensor) SparseSumDim(dims []int64, del bool) (retVal *Tensor, err error) {
+	isSparse, err := ts.IsSparse()
+	if err != nil {
+		return nil, err
+	}
+	if !isSparse {
+		err = fmt.Errorf("SparseSumDim() failed: expected a sparse tensor.\n")
+		return nil, err
+	}
+
+	return ts._SparseSum2(dims, del)
+}
+
+// MustSparseSumDim sums a sparse tensor over given dimensions. It panics if error.
+func (ts *Tensor) MustSparseSumDim(dims []int64, del bool) (retVal *Tensor) {
+	retVal, err := ts.SparseSumDim(dims, del)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
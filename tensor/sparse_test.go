@@ -0,0 +1,76 @@
+package tensor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestSparseMM(t *testing.T) {
+	// [[0, 2, 0],
+	//  [1, 0, 3]]
+	indices := ts.MustOfSlice([]int64{0, 1, 1, 1, 0, 2}).MustView([]int64{2, 3}, true)
+	values := ts.MustOfSlice([]float32{2, 1, 3})
+	sparse := ts.MustNewSparseCOO(indices, values, []int64{2, 3})
+
+	dense := sparse.MustToDense(false)
+	wantDense := []float64{0, 2, 0, 1, 0, 3}
+	gotDense := dense.Float64Values()
+	if !reflect.DeepEqual(wantDense, gotDense) {
+		t.Errorf("Expected dense values: %v\n", wantDense)
+		t.Errorf("Got dense values: %v\n", gotDense)
+	}
+
+	other := ts.MustRandn([]int64{3, 4}, gotch.Float, gotch.CPU)
+	want := dense.MustMm(other, false).Float64Values()
+	got := ts.MustSparseMM(sparse, other).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected sparse-dense matmul: %v\n", want)
+		t.Errorf("Got sparse-dense matmul: %v\n", got)
+	}
+
+	// dense -> sparse -> dense
+	roundTrip := dense.MustToSparse(false).MustToDense(true).Float64Values()
+	if !reflect.DeepEqual(wantDense, roundTrip) {
+		t.Errorf("Expected dense values: %v\n", wantDense)
+		t.Errorf("Got dense values: %v\n", roundTrip)
+	}
+
+	_, err := ts.SparseMM(dense, other)
+	if err == nil {
+		t.Errorf("Expected error for a dense first argument, got nil\n")
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	// coordinate (0, 1) appears twice
+	indices := ts.MustOfSlice([]int64{0, 1, 0, 1, 0, 1}).MustView([]int64{2, 3}, true)
+	values := ts.MustOfSlice([]float32{2, 1, 3})
+	sparse := ts.MustNewSparseCOO(indices, values, []int64{2, 2})
+
+	coalesced := sparse.MustCoalesce(false)
+
+	wantNnz := int64(2)
+	gotNnz := coalesced.MustIndices(false).MustSize()[1]
+	if wantNnz != gotNnz {
+		t.Errorf("Expected number of non-zero values: %v\n", wantNnz)
+		t.Errorf("Got number of non-zero values: %v\n", gotNnz)
+	}
+
+	want := []float64{0, 5, 1, 0}
+	got := coalesced.MustToDense(false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected dense values: %v\n", want)
+		t.Errorf("Got dense values: %v\n", got)
+	}
+
+	// summing over rows: [1, 5]
+	wantSum := []float64{1, 5}
+	gotSum := sparse.MustSparseSumDim([]int64{0}, false).MustToDense(true).Float64Values()
+	if !reflect.DeepEqual(wantSum, gotSum) {
+		t.Errorf("Expected sparse sum: %v\n", wantSum)
+		t.Errorf("Got sparse sum: %v\n", gotSum)
+	}
+}
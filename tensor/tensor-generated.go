@@ -6,6 +6,8 @@ package tensor
 import "C"
 
 import (
+	"fmt"
+	"runtime"
 	"unsafe"
 
 	"github.com/sugarme/gotch"
@@ -16,6 +18,7 @@ func (ts *Tensor) __And_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__And_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -27,6 +30,8 @@ func (ts *Tensor) __And1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__And1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -38,6 +43,7 @@ func (ts *Tensor) __Iand_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Iand_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -49,6 +55,8 @@ func (ts *Tensor) __Iand1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Iand1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -60,6 +68,7 @@ func (ts *Tensor) __Ilshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ilshift_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -71,6 +80,8 @@ func (ts *Tensor) __Ilshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ilshift1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -82,6 +93,7 @@ func (ts *Tensor) __Ior_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ior_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -93,6 +105,8 @@ func (ts *Tensor) __Ior1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ior1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -104,6 +118,7 @@ func (ts *Tensor) __Irshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Irshift_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -115,6 +130,8 @@ func (ts *Tensor) __Irshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Irshift1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -126,6 +143,7 @@ func (ts *Tensor) __Ixor_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ixor_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -137,6 +155,8 @@ func (ts *Tensor) __Ixor1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Ixor1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -148,6 +168,7 @@ func (ts *Tensor) __Lshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Lshift_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -159,6 +180,8 @@ func (ts *Tensor) __Lshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Lshift1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -170,6 +193,7 @@ func (ts *Tensor) __Or_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Or_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -181,6 +205,8 @@ func (ts *Tensor) __Or1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Or1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -192,6 +218,7 @@ func (ts *Tensor) __Rshift_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Rshift_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -203,6 +230,8 @@ func (ts *Tensor) __Rshift1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Rshift1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -214,6 +243,7 @@ func (ts *Tensor) __Xor_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Xor_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -225,6 +255,8 @@ func (ts *Tensor) __Xor1(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg__Xor1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -239,10 +271,11 @@ func (ts *Tensor) _AdaptiveAvgPool2d(outputSize []int64, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AdaptiveAvgPool2d(ptr, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -254,10 +287,12 @@ func (ts *Tensor) _AdaptiveAvgPool2dBackward(gradOutput *Tensor, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AdaptiveAvgPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -269,10 +304,11 @@ func (ts *Tensor) _AddBatchDim(batchDim int64, level int64, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddBatchDim(ptr, ts.ctensor, batchDim, level)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -284,10 +320,12 @@ func (ts *Tensor) _AddRelu(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddRelu(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -296,6 +334,8 @@ func (ts *Tensor) _AddRelu_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddRelu_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -310,10 +350,13 @@ func (ts *Tensor) _AddReluOut(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddReluOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -322,6 +365,10 @@ func (ts *Tensor) _AddmvImpl_(self2 *Tensor, mat *Tensor, vec *Tensor) (err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AddmvImpl_(ptr, ts.ctensor, self2.ctensor, mat.ctensor, vec.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(self2)
+	runtime.KeepAlive(mat)
+	runtime.KeepAlive(vec)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -333,10 +380,13 @@ func _AmpUpdateScale(growthTracker *Tensor, currentScale *Tensor, foundInf *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_AmpUpdateScale(ptr, growthTracker.ctensor, currentScale.ctensor, foundInf.ctensor, scaleGrowthFactor, scaleBackoffFactor, growthInterval)
+	runtime.KeepAlive(growthTracker)
+	runtime.KeepAlive(currentScale)
+	runtime.KeepAlive(foundInf)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -345,6 +395,9 @@ func (ts *Tensor) _BaddbmmMkl_(batch1 *Tensor, batch2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_BaddbmmMkl_(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -363,10 +416,12 @@ func (ts *Tensor) _Bmm(mat2 *Tensor, deterministic bool, del bool) (retVal *Tens
 		cdeterministic = int32(1)
 	}
 	lib.Atg_Bmm(ptr, ts.ctensor, mat2.ctensor, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -382,10 +437,13 @@ func (ts *Tensor) _BmmOut(out *Tensor, mat2 *Tensor, deterministic bool, del boo
 		cdeterministic = int32(1)
 	}
 	lib.Atg_BmmOut(ptr, out.ctensor, ts.ctensor, mat2.ctensor, cdeterministic)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -401,10 +459,11 @@ func (ts *Tensor) _CastByte(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastByte(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -420,10 +479,11 @@ func (ts *Tensor) _CastChar(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastChar(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -439,10 +499,11 @@ func (ts *Tensor) _CastDouble(nonBlocking bool, del bool) (retVal *Tensor, err e
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastDouble(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -458,10 +519,11 @@ func (ts *Tensor) _CastFloat(nonBlocking bool, del bool) (retVal *Tensor, err er
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastFloat(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -477,10 +539,11 @@ func (ts *Tensor) _CastHalf(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastHalf(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -496,10 +559,11 @@ func (ts *Tensor) _CastInt(nonBlocking bool, del bool) (retVal *Tensor, err erro
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastInt(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -515,10 +579,11 @@ func (ts *Tensor) _CastLong(nonBlocking bool, del bool) (retVal *Tensor, err err
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastLong(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -534,15 +599,20 @@ func (ts *Tensor) _CastShort(nonBlocking bool, del bool) (retVal *Tensor, err er
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CastShort(ptr, ts.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func _Cat(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("_Cat() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -550,15 +620,20 @@ func _Cat(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.Atg_Cat(ptr, ctensors, len(ctensors), dim)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func _CatOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("_CatOut() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -566,10 +641,12 @@ func _CatOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err erro
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.Atg_CatOut(ptr, out.ctensor, ctensors, len(ctensors), dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -578,10 +655,14 @@ func _CdistBackward(grad *Tensor, x1 *Tensor, x2 *Tensor, p float64, cdist *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_CdistBackward(ptr, grad.ctensor, x1.ctensor, x2.ctensor, p, cdist.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(x1)
+	runtime.KeepAlive(x2)
+	runtime.KeepAlive(cdist)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -597,10 +678,11 @@ func (ts *Tensor) _CholeskyHelper(upper bool, del bool) (retVal *Tensor, err err
 		cupper = int32(1)
 	}
 	lib.Atg_CholeskyHelper(ptr, ts.ctensor, cupper)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -616,10 +698,12 @@ func (ts *Tensor) _CholeskySolveHelper(a *Tensor, upper bool, del bool) (retVal
 		cupper = int32(1)
 	}
 	lib.Atg_CholeskySolveHelper(ptr, ts.ctensor, a.ctensor, cupper)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(a)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -632,6 +716,7 @@ func (ts *Tensor) _Coalesced_(coalesced bool) (err error) {
 		ccoalesced = int32(1)
 	}
 	lib.Atg_Coalesced_(ptr, ts.ctensor, ccoalesced)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -643,10 +728,12 @@ func _ComputeLinearCombination(input *Tensor, coefficients *Tensor) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ComputeLinearCombination(ptr, input.ctensor, coefficients.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(coefficients)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -655,10 +742,13 @@ func _ComputeLinearCombinationOut(out *Tensor, input *Tensor, coefficients *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ComputeLinearCombinationOut(ptr, out.ctensor, input.ctensor, coefficients.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(coefficients)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -670,10 +760,11 @@ func (ts *Tensor) _Conj(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Conj(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -698,10 +789,13 @@ func _Convolution(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, p
 		ccudnnEnabled = int32(1)
 	}
 	lib.Atg_Convolution(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups, cbenchmark, cdeterministic, ccudnnEnabled)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -730,10 +824,13 @@ func _Convolution1(input *Tensor, weight *Tensor, bias *Tensor, stride []int64,
 		callowTf32 = int32(1)
 	}
 	lib.Atg_Convolution1(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups, cbenchmark, cdeterministic, ccudnnEnabled, callowTf32)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -746,10 +843,13 @@ func _ConvolutionNogroup(input *Tensor, weight *Tensor, bias *Tensor, stride []i
 		ctransposed = int32(1)
 	}
 	lib.Atg_ConvolutionNogroup(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -765,10 +865,12 @@ func (ts *Tensor) _CopyFrom(dst *Tensor, nonBlocking bool, del bool) (retVal *Te
 		cnonBlocking = int32(1)
 	}
 	lib.Atg_CopyFrom(ptr, ts.ctensor, dst.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(dst)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -781,10 +883,15 @@ func _CtcLossBackward(grad *Tensor, logProbs *Tensor, targets *Tensor, inputLeng
 		czeroInfinity = int32(1)
 	}
 	lib.Atg_CtcLossBackward(ptr, grad.ctensor, logProbs.ctensor, targets.ctensor, inputLengths, len(inputLengths), targetLengths, len(targetLengths), negLogLikelihood.ctensor, logAlpha.ctensor, blank, czeroInfinity)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(logProbs)
+	runtime.KeepAlive(targets)
+	runtime.KeepAlive(negLogLikelihood)
+	runtime.KeepAlive(logAlpha)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -800,7 +907,7 @@ func _CudnnInitDropoutState(dropout float64, train bool, dropoutSeed int64, opti
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -821,10 +928,11 @@ func _CudnnRnnFlattenWeight(weightArr []Tensor, weightStride0 int64, inputSize i
 		cbidirectional = int32(1)
 	}
 	lib.Atg_CudnnRnnFlattenWeight(ptr, cweightArr, len(cweightArr), weightStride0, inputSize, mode, hiddenSize, numLayers, cbatchFirst, cbidirectional)
+	runtime.KeepAlive(weightArr)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -836,10 +944,11 @@ func (ts *Tensor) _Cumprod(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Cumprod(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -851,10 +960,12 @@ func (ts *Tensor) _CumprodOut(out *Tensor, dim int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_CumprodOut(ptr, out.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -866,10 +977,11 @@ func (ts *Tensor) _Cumsum(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Cumsum(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -881,10 +993,12 @@ func (ts *Tensor) _CumsumOut(out *Tensor, dim int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_CumsumOut(ptr, out.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -893,10 +1007,11 @@ func _DimArange(like *Tensor, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_DimArange(ptr, like.ctensor, dim)
+	runtime.KeepAlive(like)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -905,10 +1020,13 @@ func _DirichletGrad(x *Tensor, alpha *Tensor, total *Tensor) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_DirichletGrad(ptr, x.ctensor, alpha.ctensor, total.ctensor)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(alpha)
+	runtime.KeepAlive(total)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -925,10 +1043,17 @@ func _EmbeddingBagBackward(grad *Tensor, indices *Tensor, offsets *Tensor, offse
 		csparse = int32(1)
 	}
 	lib.Atg_EmbeddingBagBackward(ptr, grad.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, bagSize.ctensor, maximumIndices.ctensor, numWeights, cscaleGradByFreq, mode, csparse, perSampleWeights.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(offsets)
+	runtime.KeepAlive(offset2bag)
+	runtime.KeepAlive(bagSize)
+	runtime.KeepAlive(maximumIndices)
+	runtime.KeepAlive(perSampleWeights)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -941,10 +1066,17 @@ func _EmbeddingBagDenseBackward(grad *Tensor, indices *Tensor, offsets *Tensor,
 		cscaleGradByFreq = int32(1)
 	}
 	lib.Atg_EmbeddingBagDenseBackward(ptr, grad.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, bagSize.ctensor, maximumIndices.ctensor, numWeights, cscaleGradByFreq, mode, perSampleWeights.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(offsets)
+	runtime.KeepAlive(offset2bag)
+	runtime.KeepAlive(bagSize)
+	runtime.KeepAlive(maximumIndices)
+	runtime.KeepAlive(perSampleWeights)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -953,10 +1085,15 @@ func _EmbeddingBagPerSampleWeightsBackward(grad *Tensor, weight *Tensor, indices
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EmbeddingBagPerSampleWeightsBackward(ptr, grad.ctensor, weight.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, mode)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(offsets)
+	runtime.KeepAlive(offset2bag)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -969,10 +1106,16 @@ func _EmbeddingBagSparseBackward(grad *Tensor, indices *Tensor, offsets *Tensor,
 		cscaleGradByFreq = int32(1)
 	}
 	lib.Atg_EmbeddingBagSparseBackward(ptr, grad.ctensor, indices.ctensor, offsets.ctensor, offset2bag.ctensor, bagSize.ctensor, numWeights, cscaleGradByFreq, mode, perSampleWeights.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(offsets)
+	runtime.KeepAlive(offset2bag)
+	runtime.KeepAlive(bagSize)
+	runtime.KeepAlive(perSampleWeights)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -984,7 +1127,7 @@ func _EmptyAffineQuantized(size []int64, optionsKind gotch.DType, optionsDevice
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -993,10 +1136,12 @@ func _EmptyPerChannelAffineQuantized(size []int64, scales *Tensor, zeroPoints *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EmptyPerChannelAffineQuantized(ptr, size, len(size), scales.ctensor, zeroPoints.ctensor, axis, optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(scales)
+	runtime.KeepAlive(zeroPoints)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1005,10 +1150,12 @@ func _EuclideanDist(x1 *Tensor, x2 *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_EuclideanDist(ptr, x1.ctensor, x2.ctensor)
+	runtime.KeepAlive(x1)
+	runtime.KeepAlive(x2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1020,10 +1167,13 @@ func (ts *Tensor) _FakeQuantizeLearnablePerChannelAffine(scale *Tensor, zeroPoin
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_FakeQuantizeLearnablePerChannelAffine(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis, quantMin, quantMax)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(scale)
+	runtime.KeepAlive(zeroPoint)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1035,10 +1185,13 @@ func (ts *Tensor) _FakeQuantizeLearnablePerTensorAffine(scale *Tensor, zeroPoint
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_FakeQuantizeLearnablePerTensorAffine(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, quantMin, quantMax)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(scale)
+	runtime.KeepAlive(zeroPoint)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1070,10 +1223,11 @@ func (ts *Tensor) _FftWithSize(signalNdim int64, complexInput bool, complexOutpu
 		conesided = int32(1)
 	}
 	lib.Atg_FftWithSize(ptr, ts.ctensor, signalNdim, ccomplexInput, ccomplexOutput, cinverse, checkedSignalSizes, len(checkedSignalSizes), cnormalized, conesided, outputSizes, len(outputSizes))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1101,10 +1255,11 @@ func (ts *Tensor) _FftWithSize1(signalNdim int64, complexInput bool, complexOutp
 		conesided = int32(1)
 	}
 	lib.Atg_FftWithSize1(ptr, ts.ctensor, signalNdim, ccomplexInput, ccomplexOutput, cinverse, checkedSignalSizes, len(checkedSignalSizes), normalization, conesided, outputSizes, len(outputSizes))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1116,10 +1271,13 @@ func (ts *Tensor) _GatherSparseBackward(dim int64, index *Tensor, grad *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_GatherSparseBackward(ptr, ts.ctensor, dim, index.ctensor, grad.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1132,10 +1290,12 @@ func _GridSampler2dCpuFallback(input *Tensor, grid *Tensor, interpolationMode in
 		calignCorners = int32(1)
 	}
 	lib.Atg_GridSampler2dCpuFallback(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(grid)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1144,6 +1304,9 @@ func (ts *Tensor) _IndexCopy_(dim int64, index *Tensor, source *Tensor) (err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_IndexCopy_(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -1152,6 +1315,10 @@ func (ts *Tensor) _IndexCopy_(dim int64, index *Tensor, source *Tensor) (err err
 }
 
 func (ts *Tensor) _IndexPutImpl_(indices []Tensor, values *Tensor, accumulate bool, unsafety bool) (err error) {
+	if len(indices) == 0 {
+		err = fmt.Errorf("_IndexPutImpl_() failed: empty tensor list input (indices)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var cindices []lib.Ctensor
@@ -1167,6 +1334,9 @@ func (ts *Tensor) _IndexPutImpl_(indices []Tensor, values *Tensor, accumulate bo
 		cunsafety = int32(1)
 	}
 	lib.Atg_IndexPutImpl_(ptr, ts.ctensor, cindices, len(cindices), values.ctensor, caccumulate, cunsafety)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -1181,10 +1351,11 @@ func (ts *Tensor) _Indices(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Indices(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1196,10 +1367,11 @@ func (ts *Tensor) _InverseHelper(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_InverseHelper(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1215,10 +1387,11 @@ func (ts *Tensor) _LogSoftmax(dim int64, halfToFloat bool, del bool) (retVal *Te
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_LogSoftmax(ptr, ts.ctensor, dim, chalfToFloat)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1230,10 +1403,13 @@ func (ts *Tensor) _LogSoftmaxBackwardData(gradOutput *Tensor, output *Tensor, di
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_LogSoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1245,10 +1421,11 @@ func (ts *Tensor) _Logcumsumexp(dim int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Logcumsumexp(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1260,10 +1437,12 @@ func (ts *Tensor) _LogcumsumexpOut(out *Tensor, dim int64, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_LogcumsumexpOut(ptr, out.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1275,10 +1454,13 @@ func (ts *Tensor) _LuSolveHelper(lUData *Tensor, lUPivots *Tensor, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_LuSolveHelper(ptr, ts.ctensor, lUData.ctensor, lUPivots.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(lUData)
+	runtime.KeepAlive(lUPivots)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1290,10 +1472,13 @@ func (ts *Tensor) _MakePerChannelQuantizedTensor(scale *Tensor, zeroPoint *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MakePerChannelQuantizedTensor(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(scale)
+	runtime.KeepAlive(zeroPoint)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1305,10 +1490,11 @@ func (ts *Tensor) _MakePerTensorQuantizedTensor(scale float64, zeroPoint int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MakePerTensorQuantizedTensor(ptr, ts.ctensor, scale, zeroPoint)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1320,10 +1506,12 @@ func (ts *Tensor) _MaskedScale(mask *Tensor, scale float64, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MaskedScale(ptr, ts.ctensor, mask.ctensor, scale)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1335,10 +1523,11 @@ func (ts *Tensor) _MkldnnReshape(shape []int64, del bool) (retVal *Tensor, err e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MkldnnReshape(ptr, ts.ctensor, shape, len(shape))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1350,10 +1539,11 @@ func (ts *Tensor) _MkldnnTranspose(dim0 int64, dim1 int64, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MkldnnTranspose(ptr, ts.ctensor, dim0, dim1)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1362,6 +1552,7 @@ func (ts *Tensor) _MkldnnTranspose_(dim0 int64, dim1 int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MkldnnTranspose_(ptr, ts.ctensor, dim0, dim1)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -1373,10 +1564,12 @@ func _MultinomialAliasDraw(j *Tensor, q *Tensor, numSamples int64) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_MultinomialAliasDraw(ptr, j.ctensor, q.ctensor, numSamples)
+	runtime.KeepAlive(j)
+	runtime.KeepAlive(q)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1385,10 +1578,13 @@ func _NnpackSpatialConvolution(input *Tensor, weight *Tensor, bias *Tensor, padd
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_NnpackSpatialConvolution(ptr, input.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1397,10 +1593,13 @@ func _NnpackSpatialConvolutionBackwardInput(input *Tensor, gradOutput *Tensor, w
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_NnpackSpatialConvolutionBackwardInput(ptr, input.ctensor, gradOutput.ctensor, weight.ctensor, padding, len(padding))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1409,10 +1608,12 @@ func _NnpackSpatialConvolutionBackwardWeight(input *Tensor, weightsize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_NnpackSpatialConvolutionBackwardWeight(ptr, input.ctensor, weightsize, len(weightsize), gradOutput.ctensor, padding, len(padding))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1425,10 +1626,12 @@ func _PackPaddedSequenceBackward(grad *Tensor, inputSize []int64, batchSizes *Te
 		cbatchFirst = int32(1)
 	}
 	lib.Atg_PackPaddedSequenceBackward(ptr, grad.ctensor, inputSize, len(inputSize), batchSizes.ctensor, cbatchFirst)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(batchSizes)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1440,10 +1643,13 @@ func (ts *Tensor) _PdistBackward(grad *Tensor, p float64, pdist *Tensor, del boo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_PdistBackward(ptr, grad.ctensor, ts.ctensor, p, pdist.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(pdist)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1455,10 +1661,11 @@ func (ts *Tensor) _RemoveBatchDim(level int64, batchSize int64, outDim int64, de
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_RemoveBatchDim(ptr, ts.ctensor, level, batchSize, outDim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1470,10 +1677,12 @@ func (ts *Tensor) _ReshapeFromTensor(shape *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ReshapeFromTensor(ptr, ts.ctensor, shape.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(shape)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1485,10 +1694,13 @@ func (ts *Tensor) _SWhere(condition *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SWhere(ptr, condition.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(condition)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1500,10 +1712,11 @@ func (ts *Tensor) _SampleDirichlet(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SampleDirichlet(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1512,10 +1725,11 @@ func _SaturateWeightToFp16(weight *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SaturateWeightToFp16(ptr, weight.ctensor)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1527,10 +1741,11 @@ func (ts *Tensor) _ShapeAsTensor(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_ShapeAsTensor(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1539,6 +1754,8 @@ func (ts *Tensor) _SobolEngineFf_(n int64, sobolstate *Tensor, dimension int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SobolEngineFf_(ptr, ts.ctensor, n, sobolstate.ctensor, dimension, numGenerated)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(sobolstate)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -1550,6 +1767,7 @@ func (ts *Tensor) _SobolEngineInitializeState_(dimension int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SobolEngineInitializeState_(ptr, ts.ctensor, dimension)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -1561,6 +1779,8 @@ func (ts *Tensor) _SobolEngineScramble_(ltm *Tensor, dimension int64) (err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SobolEngineScramble_(ptr, ts.ctensor, ltm.ctensor, dimension)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(ltm)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -1579,10 +1799,11 @@ func (ts *Tensor) _Softmax(dim int64, halfToFloat bool, del bool) (retVal *Tenso
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_Softmax(ptr, ts.ctensor, dim, chalfToFloat)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1594,10 +1815,13 @@ func (ts *Tensor) _SoftmaxBackwardData(gradOutput *Tensor, output *Tensor, dim i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1609,10 +1833,13 @@ func (ts *Tensor) _SparseAddmm(sparse *Tensor, dense *Tensor, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseAddmm(ptr, ts.ctensor, sparse.ctensor, dense.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(sparse)
+	runtime.KeepAlive(dense)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1621,10 +1848,12 @@ func _SparseCooTensorUnsafe(indices *Tensor, values *Tensor, size []int64, optio
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseCooTensorUnsafe(ptr, indices.ctensor, values.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1636,7 +1865,7 @@ func _SparseCooTensorWithDims(sparseDim int64, denseDim int64, size []int64, opt
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1645,10 +1874,12 @@ func _SparseCooTensorWithDimsAndTensors(sparseDim int64, denseDim int64, size []
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseCooTensorWithDimsAndTensors(ptr, sparseDim, denseDim, size, len(size), indices.ctensor, values.ctensor, optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1660,10 +1891,11 @@ func (ts *Tensor) _SparseLogSoftmax(dim int64, dtype gotch.DType, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseLogSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1679,10 +1911,11 @@ func (ts *Tensor) _SparseLogSoftmax1(dim int64, halfToFloat bool, del bool) (ret
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_SparseLogSoftmax1(ptr, ts.ctensor, dim, chalfToFloat)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1694,10 +1927,13 @@ func (ts *Tensor) _SparseLogSoftmaxBackwardData(gradOutput *Tensor, output *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseLogSoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1706,10 +1942,12 @@ func _SparseMm(sparse *Tensor, dense *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseMm(ptr, sparse.ctensor, dense.ctensor)
+	runtime.KeepAlive(sparse)
+	runtime.KeepAlive(dense)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1721,10 +1959,11 @@ func (ts *Tensor) _SparseSoftmax(dim int64, dtype gotch.DType, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1740,10 +1979,11 @@ func (ts *Tensor) _SparseSoftmax1(dim int64, halfToFloat bool, del bool) (retVal
 		chalfToFloat = int32(1)
 	}
 	lib.Atg_SparseSoftmax1(ptr, ts.ctensor, dim, chalfToFloat)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1755,10 +1995,13 @@ func (ts *Tensor) _SparseSoftmaxBackwardData(gradOutput *Tensor, output *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSoftmaxBackwardData(ptr, gradOutput.ctensor, output.ctensor, dim, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1770,10 +2013,11 @@ func (ts *Tensor) _SparseSum(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1785,10 +2029,11 @@ func (ts *Tensor) _SparseSum1(dtype gotch.DType, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum1(ptr, ts.ctensor, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1800,10 +2045,11 @@ func (ts *Tensor) _SparseSum2(dim []int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum2(ptr, ts.ctensor, dim, len(dim))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1815,10 +2061,11 @@ func (ts *Tensor) _SparseSum3(dim []int64, dtype gotch.DType, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSum3(ptr, ts.ctensor, dim, len(dim), dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1830,10 +2077,12 @@ func (ts *Tensor) _SparseSumBackward(grad *Tensor, dim []int64, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_SparseSumBackward(ptr, grad.ctensor, ts.ctensor, dim, len(dim))
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1845,10 +2094,11 @@ func (ts *Tensor) _StandardGamma(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_StandardGamma(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1860,10 +2110,12 @@ func (ts *Tensor) _StandardGammaGrad(output *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_StandardGammaGrad(ptr, ts.ctensor, output.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1879,10 +2131,11 @@ func (ts *Tensor) _Std(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.Atg_Std(ptr, ts.ctensor, cunbiased)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1891,10 +2144,11 @@ func _TestOptionalFilledIntlist(values *Tensor, addends []int64) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_TestOptionalFilledIntlist(ptr, values.ctensor, addends, len(addends))
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1903,10 +2157,11 @@ func _TestOptionalIntlist(values *Tensor, addends []int64) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_TestOptionalIntlist(ptr, values.ctensor, addends, len(addends))
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1918,10 +2173,12 @@ func (ts *Tensor) _TestSerializationSubcmul(other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_TestSerializationSubcmul(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1930,10 +2187,13 @@ func _Trilinear(i1 *Tensor, i2 *Tensor, i3 *Tensor, expand1 []int64, expand2 []i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Trilinear(ptr, i1.ctensor, i2.ctensor, i3.ctensor, expand1, len(expand1), expand2, len(expand2), expand3, len(expand3), sumdim, len(sumdim), unrollDim)
+	runtime.KeepAlive(i1)
+	runtime.KeepAlive(i2)
+	runtime.KeepAlive(i3)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1945,10 +2205,11 @@ func (ts *Tensor) _UnsafeView(size []int64, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_UnsafeView(ptr, ts.ctensor, size, len(size))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1960,10 +2221,11 @@ func (ts *Tensor) _Values(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_Values(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1979,10 +2241,11 @@ func (ts *Tensor) _Var(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.Atg_Var(ptr, ts.ctensor, cunbiased)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -1991,10 +2254,12 @@ func _WeightNorm(v *Tensor, g *Tensor, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.Atg_WeightNorm(ptr, v.ctensor, g.ctensor, dim)
+	runtime.KeepAlive(v)
+	runtime.KeepAlive(g)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2006,10 +2271,11 @@ func (ts *Tensor) Abs(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbs(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2018,6 +2284,7 @@ func (ts *Tensor) Abs_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbs_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2032,10 +2299,12 @@ func (ts *Tensor) AbsOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2047,10 +2316,11 @@ func (ts *Tensor) Absolute(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsolute(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2059,6 +2329,7 @@ func (ts *Tensor) Absolute_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsolute_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2073,10 +2344,12 @@ func (ts *Tensor) AbsoluteOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAbsoluteOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2088,10 +2361,11 @@ func (ts *Tensor) Acos(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcos(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2100,6 +2374,7 @@ func (ts *Tensor) Acos_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcos_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2114,10 +2389,12 @@ func (ts *Tensor) AcosOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcosOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2129,10 +2406,11 @@ func (ts *Tensor) Acosh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcosh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2141,6 +2419,7 @@ func (ts *Tensor) Acosh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcosh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2155,10 +2434,12 @@ func (ts *Tensor) AcoshOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAcoshOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2170,10 +2451,11 @@ func (ts *Tensor) AdaptiveAvgPool1d(outputSize []int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool1d(ptr, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2185,10 +2467,11 @@ func (ts *Tensor) AdaptiveAvgPool2d(outputSize []int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool2d(ptr, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2200,10 +2483,12 @@ func (ts *Tensor) AdaptiveAvgPool2dOut(out *Tensor, outputSize []int64, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2215,10 +2500,11 @@ func (ts *Tensor) AdaptiveAvgPool3d(outputSize []int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3d(ptr, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2230,10 +2516,12 @@ func (ts *Tensor) AdaptiveAvgPool3dBackward(gradOutput *Tensor, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2245,10 +2533,13 @@ func (ts *Tensor) AdaptiveAvgPool3dBackwardOut(gradInput *Tensor, gradOutput *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2260,10 +2551,12 @@ func (ts *Tensor) AdaptiveAvgPool3dOut(out *Tensor, outputSize []int64, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveAvgPool3dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2275,10 +2568,13 @@ func (ts *Tensor) AdaptiveMaxPool2dBackward(gradOutput *Tensor, indices *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2290,10 +2586,14 @@ func (ts *Tensor) AdaptiveMaxPool2dBackwardOut(gradInput *Tensor, gradOutput *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2305,10 +2605,13 @@ func (ts *Tensor) AdaptiveMaxPool3dBackward(gradOutput *Tensor, indices *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2320,10 +2623,14 @@ func (ts *Tensor) AdaptiveMaxPool3dBackwardOut(gradInput *Tensor, gradOutput *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdaptiveMaxPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2335,10 +2642,12 @@ func (ts *Tensor) Add(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2350,10 +2659,11 @@ func (ts *Tensor) Add1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2362,6 +2672,8 @@ func (ts *Tensor) Add_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2373,6 +2685,7 @@ func (ts *Tensor) Add1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAdd1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2387,10 +2700,13 @@ func (ts *Tensor) AddOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2402,10 +2718,13 @@ func (ts *Tensor) Addbmm(batch1 *Tensor, batch2 *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddbmm(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2414,6 +2733,9 @@ func (ts *Tensor) Addbmm_(batch1 *Tensor, batch2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddbmm_(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2428,10 +2750,14 @@ func (ts *Tensor) AddbmmOut(out *Tensor, batch1 *Tensor, batch2 *Tensor, del boo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddbmmOut(ptr, out.ctensor, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2443,10 +2769,13 @@ func (ts *Tensor) Addcdiv(tensor1 *Tensor, tensor2 *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcdiv(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor1)
+	runtime.KeepAlive(tensor2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2455,6 +2784,9 @@ func (ts *Tensor) Addcdiv_(tensor1 *Tensor, tensor2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcdiv_(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor1)
+	runtime.KeepAlive(tensor2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2469,10 +2801,14 @@ func (ts *Tensor) AddcdivOut(out *Tensor, tensor1 *Tensor, tensor2 *Tensor, del
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcdivOut(ptr, out.ctensor, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor1)
+	runtime.KeepAlive(tensor2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2484,10 +2820,13 @@ func (ts *Tensor) Addcmul(tensor1 *Tensor, tensor2 *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcmul(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor1)
+	runtime.KeepAlive(tensor2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2496,6 +2835,9 @@ func (ts *Tensor) Addcmul_(tensor1 *Tensor, tensor2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcmul_(ptr, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor1)
+	runtime.KeepAlive(tensor2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2510,10 +2852,14 @@ func (ts *Tensor) AddcmulOut(out *Tensor, tensor1 *Tensor, tensor2 *Tensor, del
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddcmulOut(ptr, out.ctensor, ts.ctensor, tensor1.ctensor, tensor2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor1)
+	runtime.KeepAlive(tensor2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2525,10 +2871,13 @@ func (ts *Tensor) Addmm(mat1 *Tensor, mat2 *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmm(ptr, ts.ctensor, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2537,6 +2886,9 @@ func (ts *Tensor) Addmm_(mat1 *Tensor, mat2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmm_(ptr, ts.ctensor, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2551,10 +2903,14 @@ func (ts *Tensor) AddmmOut(out *Tensor, mat1 *Tensor, mat2 *Tensor, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmmOut(ptr, out.ctensor, ts.ctensor, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2566,10 +2922,13 @@ func (ts *Tensor) Addmv(mat *Tensor, vec *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmv(ptr, ts.ctensor, mat.ctensor, vec.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat)
+	runtime.KeepAlive(vec)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2578,6 +2937,9 @@ func (ts *Tensor) Addmv_(mat *Tensor, vec *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmv_(ptr, ts.ctensor, mat.ctensor, vec.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat)
+	runtime.KeepAlive(vec)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2592,10 +2954,14 @@ func (ts *Tensor) AddmvOut(out *Tensor, mat *Tensor, vec *Tensor, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddmvOut(ptr, out.ctensor, ts.ctensor, mat.ctensor, vec.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat)
+	runtime.KeepAlive(vec)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2607,10 +2973,13 @@ func (ts *Tensor) Addr(vec1 *Tensor, vec2 *Tensor, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddr(ptr, ts.ctensor, vec1.ctensor, vec2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec1)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2619,6 +2988,9 @@ func (ts *Tensor) Addr_(vec1 *Tensor, vec2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddr_(ptr, ts.ctensor, vec1.ctensor, vec2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec1)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2633,10 +3005,14 @@ func (ts *Tensor) AddrOut(out *Tensor, vec1 *Tensor, vec2 *Tensor, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAddrOut(ptr, out.ctensor, ts.ctensor, vec1.ctensor, vec2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec1)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2649,10 +3025,11 @@ func AffineGridGenerator(theta *Tensor, size []int64, alignCorners bool) (retVal
 		calignCorners = int32(1)
 	}
 	lib.AtgAffineGridGenerator(ptr, theta.ctensor, size, len(size), calignCorners)
+	runtime.KeepAlive(theta)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2665,10 +3042,11 @@ func AffineGridGeneratorBackward(grad *Tensor, size []int64, alignCorners bool)
 		calignCorners = int32(1)
 	}
 	lib.AtgAffineGridGeneratorBackward(ptr, grad.ctensor, size, len(size), calignCorners)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2680,10 +3058,11 @@ func (ts *Tensor) Alias(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAlias(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2695,10 +3074,12 @@ func (ts *Tensor) AlignAs(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAlignAs(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2710,10 +3091,11 @@ func (ts *Tensor) All(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAll(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2729,10 +3111,11 @@ func (ts *Tensor) All1(dim int64, keepdim bool, del bool) (retVal *Tensor, err e
 		ckeepdim = int32(1)
 	}
 	lib.AtgAll1(ptr, ts.ctensor, dim, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2748,10 +3131,12 @@ func (ts *Tensor) AllOut(out *Tensor, dim int64, keepdim bool, del bool) (retVal
 		ckeepdim = int32(1)
 	}
 	lib.AtgAllOut(ptr, out.ctensor, ts.ctensor, dim, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2764,10 +3149,11 @@ func AlphaDropout(input *Tensor, p float64, train bool) (retVal *Tensor, err err
 		ctrain = int32(1)
 	}
 	lib.AtgAlphaDropout(ptr, input.ctensor, p, ctrain)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2780,6 +3166,7 @@ func (ts *Tensor) AlphaDropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgAlphaDropout_(ptr, ts.ctensor, p, ctrain)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -2798,10 +3185,11 @@ func (ts *Tensor) Amax(dim []int64, keepdim bool, del bool) (retVal *Tensor, err
 		ckeepdim = int32(1)
 	}
 	lib.AtgAmax(ptr, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2817,10 +3205,12 @@ func (ts *Tensor) AmaxOut(out *Tensor, dim []int64, keepdim bool, del bool) (ret
 		ckeepdim = int32(1)
 	}
 	lib.AtgAmaxOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2836,10 +3226,11 @@ func (ts *Tensor) Amin(dim []int64, keepdim bool, del bool) (retVal *Tensor, err
 		ckeepdim = int32(1)
 	}
 	lib.AtgAmin(ptr, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2855,10 +3246,12 @@ func (ts *Tensor) AminOut(out *Tensor, dim []int64, keepdim bool, del bool) (ret
 		ckeepdim = int32(1)
 	}
 	lib.AtgAminOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2870,10 +3263,11 @@ func (ts *Tensor) Angle(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAngle(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2885,10 +3279,12 @@ func (ts *Tensor) AngleOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAngleOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2900,10 +3296,11 @@ func (ts *Tensor) Any(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAny(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2919,10 +3316,11 @@ func (ts *Tensor) Any1(dim int64, keepdim bool, del bool) (retVal *Tensor, err e
 		ckeepdim = int32(1)
 	}
 	lib.AtgAny1(ptr, ts.ctensor, dim, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2938,10 +3336,12 @@ func (ts *Tensor) AnyOut(out *Tensor, dim int64, keepdim bool, del bool) (retVal
 		ckeepdim = int32(1)
 	}
 	lib.AtgAnyOut(ptr, out.ctensor, ts.ctensor, dim, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2953,7 +3353,7 @@ func Arange(end *Scalar, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2965,7 +3365,7 @@ func Arange1(start *Scalar, end *Scalar, optionsKind gotch.DType, optionsDevice
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2977,7 +3377,7 @@ func Arange2(start *Scalar, end *Scalar, step *Scalar, optionsKind gotch.DType,
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2986,10 +3386,11 @@ func ArangeOut(out *Tensor, end *Scalar) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArangeOut(ptr, out.ctensor, end.cscalar)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -2998,10 +3399,11 @@ func ArangeOut1(out *Tensor, start *Scalar, end *Scalar) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArangeOut1(ptr, out.ctensor, start.cscalar, end.cscalar)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3013,10 +3415,11 @@ func (ts *Tensor) Arccos(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccos(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3025,6 +3428,7 @@ func (ts *Tensor) Arccos_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccos_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3039,10 +3443,12 @@ func (ts *Tensor) ArccosOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccosOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3054,10 +3460,11 @@ func (ts *Tensor) Arccosh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccosh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3066,6 +3473,7 @@ func (ts *Tensor) Arccosh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccosh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3080,10 +3488,12 @@ func (ts *Tensor) ArccoshOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArccoshOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3095,10 +3505,11 @@ func (ts *Tensor) Arcsin(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsin(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3107,6 +3518,7 @@ func (ts *Tensor) Arcsin_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsin_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3121,10 +3533,12 @@ func (ts *Tensor) ArcsinOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3136,10 +3550,11 @@ func (ts *Tensor) Arcsinh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3148,6 +3563,7 @@ func (ts *Tensor) Arcsinh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3162,10 +3578,12 @@ func (ts *Tensor) ArcsinhOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArcsinhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3177,10 +3595,11 @@ func (ts *Tensor) Arctan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctan(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3189,6 +3608,7 @@ func (ts *Tensor) Arctan_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctan_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3203,10 +3623,12 @@ func (ts *Tensor) ArctanOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3218,10 +3640,11 @@ func (ts *Tensor) Arctanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3230,6 +3653,7 @@ func (ts *Tensor) Arctanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3244,10 +3668,12 @@ func (ts *Tensor) ArctanhOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgArctanhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3269,10 +3695,11 @@ func (ts *Tensor) Argmax(dim []int64, keepdim bool, del bool) (retVal *Tensor, e
 		ckeepdim = int32(1)
 	}
 	lib.AtgArgmax(ptr, ts.ctensor, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3294,10 +3721,11 @@ func (ts *Tensor) Argmin(dim []int64, keepdim bool, del bool) (retVal *Tensor, e
 		ckeepdim = int32(1)
 	}
 	lib.AtgArgmin(ptr, ts.ctensor, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3313,10 +3741,11 @@ func (ts *Tensor) Argsort(dim int64, descending bool, del bool) (retVal *Tensor,
 		cdescending = int32(1)
 	}
 	lib.AtgArgsort(ptr, ts.ctensor, dim, cdescending)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3334,10 +3763,11 @@ func (ts *Tensor) AsStrided(size []int64, stride []int64, storageOffset []int64,
 		cstorageOffsetNull = 0
 	}
 	lib.AtgAsStrided(ptr, ts.ctensor, size, len(size), stride, len(stride), cstorageOffsetVal, cstorageOffsetNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3352,6 +3782,7 @@ func (ts *Tensor) AsStrided_(size []int64, stride []int64, storageOffset []int64
 		cstorageOffsetNull = 0
 	}
 	lib.AtgAsStrided_(ptr, ts.ctensor, size, len(size), stride, len(stride), cstorageOffsetVal, cstorageOffsetNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3366,10 +3797,11 @@ func (ts *Tensor) Asin(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsin(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3378,6 +3810,7 @@ func (ts *Tensor) Asin_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsin_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3392,10 +3825,12 @@ func (ts *Tensor) AsinOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3407,10 +3842,11 @@ func (ts *Tensor) Asinh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3419,6 +3855,7 @@ func (ts *Tensor) Asinh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3433,10 +3870,12 @@ func (ts *Tensor) AsinhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAsinhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3448,10 +3887,11 @@ func (ts *Tensor) Atan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3463,10 +3903,12 @@ func (ts *Tensor) Atan2(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan2(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3475,6 +3917,8 @@ func (ts *Tensor) Atan2_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan2_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3489,10 +3933,13 @@ func (ts *Tensor) Atan2Out(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan2Out(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3501,6 +3948,7 @@ func (ts *Tensor) Atan_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtan_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3515,10 +3963,12 @@ func (ts *Tensor) AtanOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3530,10 +3980,11 @@ func (ts *Tensor) Atanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3542,6 +3993,7 @@ func (ts *Tensor) Atanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3556,10 +4008,12 @@ func (ts *Tensor) AtanhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtanhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3571,10 +4025,11 @@ func (ts *Tensor) Atleast1d(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtleast1d(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3586,10 +4041,11 @@ func (ts *Tensor) Atleast2d(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtleast2d(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3601,10 +4057,11 @@ func (ts *Tensor) Atleast3d(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgAtleast3d(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3624,10 +4081,11 @@ func (ts *Tensor) AvgPool1d(kernelSize []int64, stride []int64, padding []int64,
 		ccountIncludePad = int32(1)
 	}
 	lib.AtgAvgPool1d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3653,10 +4111,11 @@ func (ts *Tensor) AvgPool2d(kernelSize []int64, stride []int64, padding []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3682,10 +4141,12 @@ func (ts *Tensor) AvgPool2dBackward(gradOutput *Tensor, kernelSize []int64, stri
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3711,10 +4172,13 @@ func (ts *Tensor) AvgPool2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, ke
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3740,10 +4204,12 @@ func (ts *Tensor) AvgPool2dOut(out *Tensor, kernelSize []int64, stride []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool2dOut(ptr, out.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3769,10 +4235,11 @@ func (ts *Tensor) AvgPool3d(kernelSize []int64, stride []int64, padding []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3798,10 +4265,12 @@ func (ts *Tensor) AvgPool3dBackward(gradOutput *Tensor, kernelSize []int64, stri
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3827,10 +4296,13 @@ func (ts *Tensor) AvgPool3dBackwardOut(gradInput *Tensor, gradOutput *Tensor, ke
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3856,10 +4328,12 @@ func (ts *Tensor) AvgPool3dOut(out *Tensor, kernelSize []int64, stride []int64,
 		cdivisorOverrideNull = 0
 	}
 	lib.AtgAvgPool3dOut(ptr, out.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), cceilMode, ccountIncludePad, cdivisorOverrideVal, cdivisorOverrideNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3871,10 +4345,13 @@ func (ts *Tensor) Baddbmm(batch1 *Tensor, batch2 *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBaddbmm(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3883,6 +4360,9 @@ func (ts *Tensor) Baddbmm_(batch1 *Tensor, batch2 *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBaddbmm_(ptr, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -3897,10 +4377,14 @@ func (ts *Tensor) BaddbmmOut(out *Tensor, batch1 *Tensor, batch2 *Tensor, del bo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBaddbmmOut(ptr, out.ctensor, ts.ctensor, batch1.ctensor, batch2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(batch1)
+	runtime.KeepAlive(batch2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3912,7 +4396,7 @@ func BartlettWindow(windowLength int64, optionsKind gotch.DType, optionsDevice g
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3928,7 +4412,7 @@ func BartlettWindow1(windowLength int64, periodic bool, optionsKind gotch.DType,
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3945,10 +4429,15 @@ func BatchNorm(input *Tensor, weight *Tensor, bias *Tensor, runningMean *Tensor,
 		ccudnnEnabled = int32(1)
 	}
 	lib.AtgBatchNorm(ptr, input.ctensor, weight.ctensor, bias.ctensor, runningMean.ctensor, runningVar.ctensor, ctraining, momentum, eps, ccudnnEnabled)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
+	runtime.KeepAlive(runningMean)
+	runtime.KeepAlive(runningVar)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3957,10 +4446,17 @@ func BatchNormBackwardElemt(gradOut *Tensor, input *Tensor, mean *Tensor, invstd
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBatchNormBackwardElemt(ptr, gradOut.ctensor, input.ctensor, mean.ctensor, invstd.ctensor, weight.ctensor, meanDy.ctensor, meanDyXmu.ctensor)
+	runtime.KeepAlive(gradOut)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(mean)
+	runtime.KeepAlive(invstd)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(meanDy)
+	runtime.KeepAlive(meanDyXmu)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3969,10 +4465,15 @@ func BatchNormElemt(input *Tensor, weight *Tensor, bias *Tensor, mean *Tensor, i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBatchNormElemt(ptr, input.ctensor, weight.ctensor, bias.ctensor, mean.ctensor, invstd.ctensor, eps)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
+	runtime.KeepAlive(mean)
+	runtime.KeepAlive(invstd)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3981,10 +4482,16 @@ func BatchNormElemtOut(out *Tensor, input *Tensor, weight *Tensor, bias *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBatchNormElemtOut(ptr, out.ctensor, input.ctensor, weight.ctensor, bias.ctensor, mean.ctensor, invstd.ctensor, eps)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
+	runtime.KeepAlive(mean)
+	runtime.KeepAlive(invstd)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -3996,10 +4503,11 @@ func (ts *Tensor) Bernoulli(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4011,10 +4519,11 @@ func (ts *Tensor) Bernoulli1(p float64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli1(ptr, ts.ctensor, p)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4023,6 +4532,8 @@ func (ts *Tensor) Bernoulli_(p *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli_(ptr, ts.ctensor, p.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(p)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4034,6 +4545,7 @@ func (ts *Tensor) Bernoulli1_(p float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulli1_(ptr, ts.ctensor, p)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4048,10 +4560,12 @@ func (ts *Tensor) BernoulliOut(out *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBernoulliOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4060,10 +4574,14 @@ func Bilinear(input1 *Tensor, input2 *Tensor, weight *Tensor, bias *Tensor) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBilinear(ptr, input1.ctensor, input2.ctensor, weight.ctensor, bias.ctensor)
+	runtime.KeepAlive(input1)
+	runtime.KeepAlive(input2)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4075,10 +4593,13 @@ func (ts *Tensor) BinaryCrossEntropy(target *Tensor, weight *Tensor, reduction i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropy(ptr, ts.ctensor, target.ctensor, weight.ctensor, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4090,10 +4611,14 @@ func (ts *Tensor) BinaryCrossEntropyBackward(gradOutput *Tensor, target *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4105,10 +4630,15 @@ func (ts *Tensor) BinaryCrossEntropyBackwardOut(gradInput *Tensor, gradOutput *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4120,10 +4650,14 @@ func (ts *Tensor) BinaryCrossEntropyOut(out *Tensor, target *Tensor, weight *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyOut(ptr, out.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4135,10 +4669,14 @@ func (ts *Tensor) BinaryCrossEntropyWithLogits(target *Tensor, weight *Tensor, p
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyWithLogits(ptr, ts.ctensor, target.ctensor, weight.ctensor, posWeight.ctensor, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(posWeight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4150,10 +4688,15 @@ func (ts *Tensor) BinaryCrossEntropyWithLogitsBackward(gradOutput *Tensor, targe
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinaryCrossEntropyWithLogitsBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, posWeight.ctensor, reduction)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(posWeight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4165,10 +4708,12 @@ func (ts *Tensor) Bincount(weights *Tensor, minlength int64, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBincount(ptr, ts.ctensor, weights.ctensor, minlength)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weights)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4177,10 +4722,12 @@ func Binomial(count *Tensor, prob *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBinomial(ptr, count.ctensor, prob.ctensor)
+	runtime.KeepAlive(count)
+	runtime.KeepAlive(prob)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4192,10 +4739,11 @@ func (ts *Tensor) BitwiseAnd(other *Scalar, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4207,10 +4755,12 @@ func (ts *Tensor) BitwiseAnd1(other *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4219,6 +4769,7 @@ func (ts *Tensor) BitwiseAnd_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4230,6 +4781,8 @@ func (ts *Tensor) BitwiseAnd1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAnd1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4244,10 +4797,13 @@ func (ts *Tensor) BitwiseAndOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAndOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4259,10 +4815,12 @@ func (ts *Tensor) BitwiseAndOut1(out *Tensor, other *Scalar, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseAndOut1(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4274,10 +4832,11 @@ func (ts *Tensor) BitwiseNot(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseNot(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4286,6 +4845,7 @@ func (ts *Tensor) BitwiseNot_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseNot_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4300,10 +4860,12 @@ func (ts *Tensor) BitwiseNotOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseNotOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4315,10 +4877,11 @@ func (ts *Tensor) BitwiseOr(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4330,10 +4893,12 @@ func (ts *Tensor) BitwiseOr1(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4342,6 +4907,7 @@ func (ts *Tensor) BitwiseOr_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4353,6 +4919,8 @@ func (ts *Tensor) BitwiseOr1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOr1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4367,10 +4935,13 @@ func (ts *Tensor) BitwiseOrOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOrOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4382,10 +4953,12 @@ func (ts *Tensor) BitwiseOrOut1(out *Tensor, other *Scalar, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseOrOut1(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4397,10 +4970,11 @@ func (ts *Tensor) BitwiseXor(other *Scalar, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4412,10 +4986,12 @@ func (ts *Tensor) BitwiseXor1(other *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4424,6 +5000,7 @@ func (ts *Tensor) BitwiseXor_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4435,6 +5012,8 @@ func (ts *Tensor) BitwiseXor1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXor1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4449,10 +5028,13 @@ func (ts *Tensor) BitwiseXorOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXorOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4464,10 +5046,12 @@ func (ts *Tensor) BitwiseXorOut1(out *Tensor, other *Scalar, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBitwiseXorOut1(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4479,7 +5063,7 @@ func BlackmanWindow(windowLength int64, optionsKind gotch.DType, optionsDevice g
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4495,7 +5079,7 @@ func BlackmanWindow1(windowLength int64, periodic bool, optionsKind gotch.DType,
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4508,10 +5092,11 @@ func BlockDiag(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgBlockDiag(ptr, ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4523,10 +5108,12 @@ func (ts *Tensor) Bmm(mat2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBmm(ptr, ts.ctensor, mat2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4538,10 +5125,13 @@ func (ts *Tensor) BmmOut(out *Tensor, mat2 *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgBmmOut(ptr, out.ctensor, ts.ctensor, mat2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4561,10 +5151,12 @@ func (ts *Tensor) Bucketize(boundaries *Tensor, outInt32 bool, right bool, del b
 		cright = int32(1)
 	}
 	lib.AtgBucketize(ptr, ts.ctensor, boundaries.ctensor, coutInt32, cright)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(boundaries)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4581,10 +5173,11 @@ func Bucketize1(selfScalar *Scalar, boundaries *Tensor, outInt32 bool, right boo
 		cright = int32(1)
 	}
 	lib.AtgBucketize1(ptr, selfScalar.cscalar, boundaries.ctensor, coutInt32, cright)
+	runtime.KeepAlive(boundaries)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4604,15 +5197,22 @@ func (ts *Tensor) BucketizeOut(out *Tensor, boundaries *Tensor, outInt32 bool, r
 		cright = int32(1)
 	}
 	lib.AtgBucketizeOut(ptr, out.ctensor, ts.ctensor, boundaries.ctensor, coutInt32, cright)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(boundaries)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func CartesianProd(tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("CartesianProd() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -4620,15 +5220,20 @@ func CartesianProd(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgCartesianProd(ptr, ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func Cat(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("Cat() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -4636,15 +5241,20 @@ func Cat(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgCat(ptr, ctensors, len(ctensors), dim)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func CatOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("CatOut() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -4652,10 +5262,12 @@ func CatOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err error
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgCatOut(ptr, out.ctensor, ctensors, len(ctensors), dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4664,6 +5276,7 @@ func (ts *Tensor) Cauchy_(median float64, sigma float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCauchy_(ptr, ts.ctensor, median, sigma)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4681,10 +5294,12 @@ func Cdist(x1 *Tensor, x2 *Tensor, p float64, computeMode []int64) (retVal *Tens
 		ccomputeModeNull = 0
 	}
 	lib.AtgCdist(ptr, x1.ctensor, x2.ctensor, p, ccomputeModeVal, ccomputeModeNull)
+	runtime.KeepAlive(x1)
+	runtime.KeepAlive(x2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4696,10 +5311,11 @@ func (ts *Tensor) Ceil(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCeil(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4708,6 +5324,7 @@ func (ts *Tensor) Ceil_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCeil_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4722,10 +5339,12 @@ func (ts *Tensor) CeilOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCeilOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4737,10 +5356,11 @@ func (ts *Tensor) Celu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCelu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4749,6 +5369,7 @@ func (ts *Tensor) Celu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCelu_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4757,6 +5378,10 @@ func (ts *Tensor) Celu_() (err error) {
 }
 
 func ChainMatmul(matrices []Tensor) (retVal *Tensor, err error) {
+	if len(matrices) == 0 {
+		err = fmt.Errorf("ChainMatmul() failed: empty tensor list input (matrices)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var cmatrices []lib.Ctensor
@@ -4764,10 +5389,11 @@ func ChainMatmul(matrices []Tensor) (retVal *Tensor, err error) {
 		cmatrices = append(cmatrices, t.ctensor)
 	}
 	lib.AtgChainMatmul(ptr, cmatrices, len(cmatrices))
+	runtime.KeepAlive(matrices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4779,10 +5405,11 @@ func (ts *Tensor) ChannelShuffle(groups int64, del bool) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgChannelShuffle(ptr, ts.ctensor, groups)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4798,10 +5425,11 @@ func (ts *Tensor) Cholesky(upper bool, del bool) (retVal *Tensor, err error) {
 		cupper = int32(1)
 	}
 	lib.AtgCholesky(ptr, ts.ctensor, cupper)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4817,10 +5445,11 @@ func (ts *Tensor) CholeskyInverse(upper bool, del bool) (retVal *Tensor, err err
 		cupper = int32(1)
 	}
 	lib.AtgCholeskyInverse(ptr, ts.ctensor, cupper)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4836,10 +5465,12 @@ func (ts *Tensor) CholeskyInverseOut(out *Tensor, upper bool, del bool) (retVal
 		cupper = int32(1)
 	}
 	lib.AtgCholeskyInverseOut(ptr, out.ctensor, ts.ctensor, cupper)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4855,10 +5486,12 @@ func (ts *Tensor) CholeskyOut(out *Tensor, upper bool, del bool) (retVal *Tensor
 		cupper = int32(1)
 	}
 	lib.AtgCholeskyOut(ptr, out.ctensor, ts.ctensor, cupper)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4874,10 +5507,12 @@ func (ts *Tensor) CholeskySolve(input2 *Tensor, upper bool, del bool) (retVal *T
 		cupper = int32(1)
 	}
 	lib.AtgCholeskySolve(ptr, ts.ctensor, input2.ctensor, cupper)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(input2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4893,10 +5528,13 @@ func (ts *Tensor) CholeskySolveOut(out *Tensor, input2 *Tensor, upper bool, del
 		cupper = int32(1)
 	}
 	lib.AtgCholeskySolveOut(ptr, out.ctensor, ts.ctensor, input2.ctensor, cupper)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(input2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4908,10 +5546,11 @@ func (ts *Tensor) Clamp(min *Scalar, max *Scalar, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClamp(ptr, ts.ctensor, min.cscalar, max.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4920,6 +5559,7 @@ func (ts *Tensor) Clamp_(min *Scalar, max *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClamp_(ptr, ts.ctensor, min.cscalar, max.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4934,10 +5574,11 @@ func (ts *Tensor) ClampMax(max *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMax(ptr, ts.ctensor, max.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4946,6 +5587,7 @@ func (ts *Tensor) ClampMax_(max *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMax_(ptr, ts.ctensor, max.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -4960,10 +5602,12 @@ func (ts *Tensor) ClampMaxOut(out *Tensor, max *Scalar, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMaxOut(ptr, out.ctensor, ts.ctensor, max.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4975,10 +5619,11 @@ func (ts *Tensor) ClampMin(min *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMin(ptr, ts.ctensor, min.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -4987,6 +5632,7 @@ func (ts *Tensor) ClampMin_(min *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMin_(ptr, ts.ctensor, min.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -5001,10 +5647,12 @@ func (ts *Tensor) ClampMinOut(out *Tensor, min *Scalar, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampMinOut(ptr, out.ctensor, ts.ctensor, min.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5016,10 +5664,12 @@ func (ts *Tensor) ClampOut(out *Tensor, min *Scalar, max *Scalar, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClampOut(ptr, out.ctensor, ts.ctensor, min.cscalar, max.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5031,10 +5681,11 @@ func (ts *Tensor) Clip(min *Scalar, max *Scalar, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClip(ptr, ts.ctensor, min.cscalar, max.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5043,6 +5694,7 @@ func (ts *Tensor) Clip_(min *Scalar, max *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClip_(ptr, ts.ctensor, min.cscalar, max.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -5057,10 +5709,12 @@ func (ts *Tensor) ClipOut(out *Tensor, min *Scalar, max *Scalar, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgClipOut(ptr, out.ctensor, ts.ctensor, min.cscalar, max.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5072,10 +5726,11 @@ func (ts *Tensor) Coalesce(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCoalesce(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5087,10 +5742,11 @@ func (ts *Tensor) Col2im(outputSize []int64, kernelSize []int64, dilation []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2im(ptr, ts.ctensor, outputSize, len(outputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5099,10 +5755,11 @@ func Col2imBackward(gradOutput *Tensor, kernelSize []int64, dilation []int64, pa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2imBackward(ptr, gradOutput.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5111,10 +5768,12 @@ func Col2imBackwardOut(gradInput *Tensor, gradOutput *Tensor, kernelSize []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2imBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5126,10 +5785,12 @@ func (ts *Tensor) Col2imOut(out *Tensor, outputSize []int64, kernelSize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCol2imOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5145,10 +5806,11 @@ func (ts *Tensor) Combinations(r int64, withReplacement bool, del bool) (retVal
 		cwithReplacement = int32(1)
 	}
 	lib.AtgCombinations(ptr, ts.ctensor, r, cwithReplacement)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5157,10 +5819,12 @@ func Complex(real *Tensor, imag *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgComplex(ptr, real.ctensor, imag.ctensor)
+	runtime.KeepAlive(real)
+	runtime.KeepAlive(imag)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5169,10 +5833,13 @@ func ComplexOut(out *Tensor, real *Tensor, imag *Tensor) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgComplexOut(ptr, out.ctensor, real.ctensor, imag.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(real)
+	runtime.KeepAlive(imag)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5184,10 +5851,11 @@ func (ts *Tensor) Conj(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConj(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5199,10 +5867,12 @@ func (ts *Tensor) ConjOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConjOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5214,10 +5884,11 @@ func (ts *Tensor) ConstantPadNd(pad []int64, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConstantPadNd(ptr, ts.ctensor, pad, len(pad))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5229,10 +5900,11 @@ func (ts *Tensor) Contiguous(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgContiguous(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5241,10 +5913,13 @@ func Conv1d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, padding
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConv1d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), groups)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5253,10 +5928,13 @@ func Conv2d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, padding
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConv2d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), groups)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5265,10 +5943,13 @@ func Conv3d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, padding
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConv3d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), groups)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5280,10 +5961,13 @@ func (ts *Tensor) ConvTbc(weight *Tensor, bias *Tensor, pad int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTbc(ptr, ts.ctensor, weight.ctensor, bias.ctensor, pad)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5292,10 +5976,13 @@ func ConvTranspose1d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTranspose1d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), groups, dilation, len(dilation))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5304,10 +5991,13 @@ func ConvTranspose2d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTranspose2d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), groups, dilation, len(dilation))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5316,10 +6006,13 @@ func ConvTranspose3d(input *Tensor, weight *Tensor, bias *Tensor, stride []int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgConvTranspose3d(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), groups, dilation, len(dilation))
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5332,10 +6025,13 @@ func Convolution(input *Tensor, weight *Tensor, bias *Tensor, stride []int64, pa
 		ctransposed = int32(1)
 	}
 	lib.AtgConvolution(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5348,10 +6044,13 @@ func ConvolutionOverrideable(input *Tensor, weight *Tensor, bias *Tensor, stride
 		ctransposed = int32(1)
 	}
 	lib.AtgConvolutionOverrideable(ptr, input.ctensor, weight.ctensor, bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation), ctransposed, outputPadding, len(outputPadding), groups)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5364,6 +6063,8 @@ func (ts *Tensor) CopySparseToSparse_(src *Tensor, nonBlocking bool) (err error)
 		cnonBlocking = int32(1)
 	}
 	lib.AtgCopySparseToSparse_(ptr, ts.ctensor, src.ctensor, cnonBlocking)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(src)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -5378,10 +6079,11 @@ func (ts *Tensor) Cos(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCos(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5390,6 +6092,7 @@ func (ts *Tensor) Cos_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCos_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -5404,10 +6107,12 @@ func (ts *Tensor) CosOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5419,10 +6124,11 @@ func (ts *Tensor) Cosh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5431,6 +6137,7 @@ func (ts *Tensor) Cosh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -5445,10 +6152,12 @@ func (ts *Tensor) CoshOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCoshOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5457,10 +6166,13 @@ func CosineEmbeddingLoss(input1 *Tensor, input2 *Tensor, target *Tensor, margin
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosineEmbeddingLoss(ptr, input1.ctensor, input2.ctensor, target.ctensor, margin, reduction)
+	runtime.KeepAlive(input1)
+	runtime.KeepAlive(input2)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5469,10 +6181,12 @@ func CosineSimilarity(x1 *Tensor, x2 *Tensor, dim int64, eps float64) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCosineSimilarity(ptr, x1.ctensor, x2.ctensor, dim, eps)
+	runtime.KeepAlive(x1)
+	runtime.KeepAlive(x2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5484,10 +6198,11 @@ func (ts *Tensor) CountNonzero(dim []int64, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCountNonzero(ptr, ts.ctensor, dim, len(dim))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5505,10 +6220,11 @@ func (ts *Tensor) CountNonzero1(dim []int64, del bool) (retVal *Tensor, err erro
 		cdimNull = 0
 	}
 	lib.AtgCountNonzero1(ptr, ts.ctensor, cdimVal, cdimNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5526,10 +6242,12 @@ func (ts *Tensor) Cross(other *Tensor, dim []int64, del bool) (retVal *Tensor, e
 		cdimNull = 0
 	}
 	lib.AtgCross(ptr, ts.ctensor, other.ctensor, cdimVal, cdimNull)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5547,10 +6265,13 @@ func (ts *Tensor) CrossOut(out *Tensor, other *Tensor, dim []int64, del bool) (r
 		cdimNull = 0
 	}
 	lib.AtgCrossOut(ptr, out.ctensor, ts.ctensor, other.ctensor, cdimVal, cdimNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5563,10 +6284,12 @@ func CtcLoss(logProbs *Tensor, targets *Tensor, inputLengths []int64, targetLeng
 		czeroInfinity = int32(1)
 	}
 	lib.AtgCtcLoss(ptr, logProbs.ctensor, targets.ctensor, inputLengths, len(inputLengths), targetLengths, len(targetLengths), blank, reduction, czeroInfinity)
+	runtime.KeepAlive(logProbs)
+	runtime.KeepAlive(targets)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5579,10 +6302,14 @@ func CtcLoss1(logProbs *Tensor, targets *Tensor, inputLengths *Tensor, targetLen
 		czeroInfinity = int32(1)
 	}
 	lib.AtgCtcLoss1(ptr, logProbs.ctensor, targets.ctensor, inputLengths.ctensor, targetLengths.ctensor, blank, reduction, czeroInfinity)
+	runtime.KeepAlive(logProbs)
+	runtime.KeepAlive(targets)
+	runtime.KeepAlive(inputLengths)
+	runtime.KeepAlive(targetLengths)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5591,10 +6318,11 @@ func CudnnAffineGridGenerator(theta *Tensor, n int64, c int64, h int64, w int64)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCudnnAffineGridGenerator(ptr, theta.ctensor, n, c, h, w)
+	runtime.KeepAlive(theta)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5603,10 +6331,11 @@ func CudnnAffineGridGeneratorBackward(grad *Tensor, n int64, c int64, h int64, w
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCudnnAffineGridGeneratorBackward(ptr, grad.ctensor, n, c, h, w)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5626,10 +6355,12 @@ func (ts *Tensor) CudnnConvolution(weight *Tensor, padding []int64, stride []int
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolution(ptr, ts.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5649,10 +6380,13 @@ func (ts *Tensor) CudnnConvolution1(weight *Tensor, bias *Tensor, padding []int6
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolution1(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5676,10 +6410,12 @@ func (ts *Tensor) CudnnConvolution2(weight *Tensor, padding []int64, stride []in
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolution2(ptr, ts.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5700,10 +6436,12 @@ func CudnnConvolutionBackwardInput(selfSize []int64, gradOutput *Tensor, weight
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5727,10 +6465,12 @@ func (ts *Tensor) CudnnConvolutionBackwardWeight(weightSize []int64, gradOutput
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5750,10 +6490,12 @@ func (ts *Tensor) CudnnConvolutionTranspose(weight *Tensor, padding []int64, out
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolutionTranspose(ptr, ts.ctensor, weight.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5773,10 +6515,13 @@ func (ts *Tensor) CudnnConvolutionTranspose1(weight *Tensor, bias *Tensor, paddi
 		cdeterministic = int32(1)
 	}
 	lib.AtgCudnnConvolutionTranspose1(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5800,10 +6545,12 @@ func (ts *Tensor) CudnnConvolutionTranspose2(weight *Tensor, padding []int64, ou
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionTranspose2(ptr, ts.ctensor, weight.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5824,10 +6571,12 @@ func CudnnConvolutionTransposeBackwardInput(gradOutput *Tensor, weight *Tensor,
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionTransposeBackwardInput(ptr, gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5851,10 +6600,12 @@ func (ts *Tensor) CudnnConvolutionTransposeBackwardWeight(weightSize []int64, gr
 		callowTf32 = int32(1)
 	}
 	lib.AtgCudnnConvolutionTransposeBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic, callowTf32)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5866,10 +6617,12 @@ func (ts *Tensor) CudnnGridSampler(grid *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCudnnGridSampler(ptr, ts.ctensor, grid.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(grid)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5878,10 +6631,13 @@ func CummaxminBackward(grad *Tensor, input *Tensor, indices *Tensor, dim int64)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCummaxminBackward(ptr, grad.ctensor, input.ctensor, indices.ctensor, dim)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5893,10 +6649,11 @@ func (ts *Tensor) Cumprod(dim int64, dtype gotch.DType, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumprod(ptr, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5905,10 +6662,12 @@ func CumprodBackward(grad *Tensor, input *Tensor, dim int64) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumprodBackward(ptr, grad.ctensor, input.ctensor, dim)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5920,10 +6679,12 @@ func (ts *Tensor) CumprodOut(out *Tensor, dim int64, dtype gotch.DType, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumprodOut(ptr, out.ctensor, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5935,10 +6696,11 @@ func (ts *Tensor) Cumsum(dim int64, dtype gotch.DType, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumsum(ptr, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5950,10 +6712,12 @@ func (ts *Tensor) CumsumOut(out *Tensor, dim int64, dtype gotch.DType, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgCumsumOut(ptr, out.ctensor, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5965,10 +6729,11 @@ func (ts *Tensor) Data(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgData(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5980,10 +6745,11 @@ func (ts *Tensor) Deg2rad(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDeg2rad(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -5992,6 +6758,7 @@ func (ts *Tensor) Deg2rad_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDeg2rad_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6006,10 +6773,12 @@ func (ts *Tensor) Deg2radOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDeg2radOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6021,10 +6790,11 @@ func (ts *Tensor) Dequantize(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDequantize(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6036,10 +6806,11 @@ func (ts *Tensor) Det(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDet(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6051,10 +6822,11 @@ func (ts *Tensor) Detach(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDetach(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6063,6 +6835,7 @@ func (ts *Tensor) Detach_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDetach_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6077,10 +6850,11 @@ func (ts *Tensor) Diag(diagonal int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiag(ptr, ts.ctensor, diagonal)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6089,10 +6863,11 @@ func DiagBackward(grad *Tensor, inputSizes []int64, diagonal int64) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), diagonal)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6104,10 +6879,11 @@ func (ts *Tensor) DiagEmbed(offset int64, dim1 int64, dim2 int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagEmbed(ptr, ts.ctensor, offset, dim1, dim2)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6119,10 +6895,12 @@ func (ts *Tensor) DiagOut(out *Tensor, diagonal int64, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagOut(ptr, out.ctensor, ts.ctensor, diagonal)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6134,10 +6912,11 @@ func (ts *Tensor) Diagflat(offset int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagflat(ptr, ts.ctensor, offset)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6149,10 +6928,11 @@ func (ts *Tensor) Diagonal(offset int64, dim1 int64, dim2 int64, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagonal(ptr, ts.ctensor, offset, dim1, dim2)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6161,10 +6941,11 @@ func DiagonalBackward(grad *Tensor, inputSizes []int64, offset int64, dim1 int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiagonalBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), offset, dim1, dim2)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6176,10 +6957,11 @@ func (ts *Tensor) Digamma(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDigamma(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6188,6 +6970,7 @@ func (ts *Tensor) Digamma_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDigamma_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6202,10 +6985,12 @@ func (ts *Tensor) DigammaOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDigammaOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6217,10 +7002,12 @@ func (ts *Tensor) Dist(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDist(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6232,10 +7019,12 @@ func (ts *Tensor) Div(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6247,10 +7036,11 @@ func (ts *Tensor) Div1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6259,6 +7049,8 @@ func (ts *Tensor) Div_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6270,6 +7062,7 @@ func (ts *Tensor) Div1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDiv1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6284,10 +7077,13 @@ func (ts *Tensor) DivOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6299,10 +7095,12 @@ func (ts *Tensor) Divide(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6314,10 +7112,11 @@ func (ts *Tensor) Divide1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6326,6 +7125,8 @@ func (ts *Tensor) Divide_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6337,6 +7138,7 @@ func (ts *Tensor) Divide1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivide1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6351,10 +7153,13 @@ func (ts *Tensor) DivideOut(out *Tensor, other *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDivideOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6366,10 +7171,12 @@ func (ts *Tensor) Dot(tensor *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDot(ptr, ts.ctensor, tensor.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6381,10 +7188,13 @@ func (ts *Tensor) DotOut(out *Tensor, tensor *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgDotOut(ptr, out.ctensor, ts.ctensor, tensor.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(tensor)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6397,10 +7207,11 @@ func Dropout(input *Tensor, p float64, train bool) (retVal *Tensor, err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgDropout(ptr, input.ctensor, p, ctrain)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6413,6 +7224,7 @@ func (ts *Tensor) Dropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgDropout_(ptr, ts.ctensor, p, ctrain)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6421,6 +7233,10 @@ func (ts *Tensor) Dropout_(p float64, train bool) (err error) {
 }
 
 func Dstack(tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("Dstack() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -6428,15 +7244,20 @@ func Dstack(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgDstack(ptr, ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func DstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("DstackOut() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -6444,15 +7265,21 @@ func DstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgDstackOut(ptr, out.ctensor, ctensors, len(ctensors))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func Einsum(equation string, tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("Einsum() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -6460,10 +7287,11 @@ func Einsum(equation string, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgEinsum(ptr, equation, ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6475,10 +7303,11 @@ func (ts *Tensor) Elu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgElu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6487,6 +7316,7 @@ func (ts *Tensor) Elu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgElu_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6498,10 +7328,12 @@ func EluBackward(gradOutput *Tensor, alpha *Scalar, scale *Scalar, inputScale *S
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEluBackward(ptr, gradOutput.ctensor, alpha.cscalar, scale.cscalar, inputScale.cscalar, output.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6510,10 +7342,13 @@ func EluBackwardOut(gradInput *Tensor, gradOutput *Tensor, alpha *Scalar, scale
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEluBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, alpha.cscalar, scale.cscalar, inputScale.cscalar, output.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6525,10 +7360,12 @@ func (ts *Tensor) EluOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEluOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6545,10 +7382,12 @@ func Embedding(weight *Tensor, indices *Tensor, paddingIdx int64, scaleGradByFre
 		csparse = int32(1)
 	}
 	lib.AtgEmbedding(ptr, weight.ctensor, indices.ctensor, paddingIdx, cscaleGradByFreq, csparse)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6565,10 +7404,12 @@ func EmbeddingBackward(grad *Tensor, indices *Tensor, numWeights int64, paddingI
 		csparse = int32(1)
 	}
 	lib.AtgEmbeddingBackward(ptr, grad.ctensor, indices.ctensor, numWeights, paddingIdx, cscaleGradByFreq, csparse)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6581,10 +7422,12 @@ func EmbeddingDenseBackward(gradOutput *Tensor, indices *Tensor, numWeights int6
 		cscaleGradByFreq = int32(1)
 	}
 	lib.AtgEmbeddingDenseBackward(ptr, gradOutput.ctensor, indices.ctensor, numWeights, paddingIdx, cscaleGradByFreq)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6593,6 +7436,8 @@ func (ts *Tensor) EmbeddingRenorm_(indices *Tensor, maxNorm float64, normType fl
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmbeddingRenorm_(ptr, ts.ctensor, indices.ctensor, maxNorm, normType)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6608,10 +7453,12 @@ func EmbeddingSparseBackward(grad *Tensor, indices *Tensor, numWeights int64, pa
 		cscaleGradByFreq = int32(1)
 	}
 	lib.AtgEmbeddingSparseBackward(ptr, grad.ctensor, indices.ctensor, numWeights, paddingIdx, cscaleGradByFreq)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6623,7 +7470,7 @@ func Empty(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6635,10 +7482,11 @@ func (ts *Tensor) EmptyLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyLike(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6650,7 +7498,7 @@ func EmptyMeta(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6659,10 +7507,11 @@ func EmptyOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyOut(ptr, out.ctensor, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6671,10 +7520,11 @@ func EmptyQuantized(size []int64, qtensor *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEmptyQuantized(ptr, size, len(size), qtensor.ctensor)
+	runtime.KeepAlive(qtensor)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6686,7 +7536,7 @@ func EmptyStrided(size []int64, stride []int64, optionsKind gotch.DType, options
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6698,10 +7548,11 @@ func (ts *Tensor) Eq(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6713,10 +7564,12 @@ func (ts *Tensor) Eq1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6725,6 +7578,7 @@ func (ts *Tensor) Eq_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6736,6 +7590,8 @@ func (ts *Tensor) Eq1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEq1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6750,10 +7606,12 @@ func (ts *Tensor) EqOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEqOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6765,10 +7623,13 @@ func (ts *Tensor) EqOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEqOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6780,10 +7641,11 @@ func (ts *Tensor) Erf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErf(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6792,6 +7654,7 @@ func (ts *Tensor) Erf_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErf_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6806,10 +7669,12 @@ func (ts *Tensor) ErfOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6821,10 +7686,11 @@ func (ts *Tensor) Erfc(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfc(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6833,6 +7699,7 @@ func (ts *Tensor) Erfc_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfc_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6847,10 +7714,12 @@ func (ts *Tensor) ErfcOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfcOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6862,10 +7731,11 @@ func (ts *Tensor) Erfinv(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfinv(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6874,6 +7744,7 @@ func (ts *Tensor) Erfinv_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfinv_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6888,10 +7759,12 @@ func (ts *Tensor) ErfinvOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgErfinvOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6903,10 +7776,11 @@ func (ts *Tensor) Exp(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6918,10 +7792,11 @@ func (ts *Tensor) Exp2(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp2(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6930,6 +7805,7 @@ func (ts *Tensor) Exp2_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp2_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6944,10 +7820,12 @@ func (ts *Tensor) Exp2Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp2Out(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6956,6 +7834,7 @@ func (ts *Tensor) Exp_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExp_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -6970,10 +7849,12 @@ func (ts *Tensor) ExpOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -6989,10 +7870,11 @@ func (ts *Tensor) Expand(size []int64, implicit bool, del bool) (retVal *Tensor,
 		cimplicit = int32(1)
 	}
 	lib.AtgExpand(ptr, ts.ctensor, size, len(size), cimplicit)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7004,10 +7886,12 @@ func (ts *Tensor) ExpandAs(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpandAs(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7019,10 +7903,11 @@ func (ts *Tensor) Expm1(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpm1(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7031,6 +7916,7 @@ func (ts *Tensor) Expm1_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpm1_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7045,10 +7931,12 @@ func (ts *Tensor) Expm1Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExpm1Out(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7057,6 +7945,7 @@ func (ts *Tensor) Exponential_(lambd float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgExponential_(ptr, ts.ctensor, lambd)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7071,7 +7960,7 @@ func Eye(n int64, optionsKind gotch.DType, optionsDevice gotch.Device) (retVal *
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7083,7 +7972,7 @@ func Eye1(n int64, m int64, optionsKind gotch.DType, optionsDevice gotch.Device)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7092,10 +7981,11 @@ func EyeOut(out *Tensor, n int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEyeOut(ptr, out.ctensor, n)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7104,10 +7994,11 @@ func EyeOut1(out *Tensor, n int64, m int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgEyeOut1(ptr, out.ctensor, n, m)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7119,10 +8010,13 @@ func (ts *Tensor) FakeQuantizePerChannelAffine(scale *Tensor, zeroPoint *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerChannelAffine(ptr, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis, quantMin, quantMax)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(scale)
+	runtime.KeepAlive(zeroPoint)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7134,10 +8028,14 @@ func (ts *Tensor) FakeQuantizePerChannelAffineBackward(grad *Tensor, scale *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerChannelAffineBackward(ptr, grad.ctensor, ts.ctensor, scale.ctensor, zeroPoint.ctensor, axis, quantMin, quantMax)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(scale)
+	runtime.KeepAlive(zeroPoint)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7149,10 +8047,11 @@ func (ts *Tensor) FakeQuantizePerTensorAffine(scale float64, zeroPoint int64, qu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerTensorAffine(ptr, ts.ctensor, scale, zeroPoint, quantMin, quantMax)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7164,10 +8063,12 @@ func (ts *Tensor) FakeQuantizePerTensorAffineBackward(grad *Tensor, scale float6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFakeQuantizePerTensorAffineBackward(ptr, grad.ctensor, ts.ctensor, scale, zeroPoint, quantMin, quantMax)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7176,10 +8077,13 @@ func FbgemmLinearFp16Weight(input *Tensor, packedWeight *Tensor, bias *Tensor) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearFp16Weight(ptr, input.ctensor, packedWeight.ctensor, bias.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(packedWeight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7188,10 +8092,13 @@ func FbgemmLinearFp16WeightFp32Activation(input *Tensor, packedWeight *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearFp16WeightFp32Activation(ptr, input.ctensor, packedWeight.ctensor, bias.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(packedWeight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7200,10 +8107,15 @@ func FbgemmLinearInt8Weight(input *Tensor, weight *Tensor, packed *Tensor, colOf
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearInt8Weight(ptr, input.ctensor, weight.ctensor, packed.ctensor, colOffsets.ctensor, weightScale.cscalar, weightZeroPoint.cscalar, bias.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(packed)
+	runtime.KeepAlive(colOffsets)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7212,10 +8124,15 @@ func FbgemmLinearInt8WeightFp32Activation(input *Tensor, weight *Tensor, packed
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmLinearInt8WeightFp32Activation(ptr, input.ctensor, weight.ctensor, packed.ctensor, colOffsets.ctensor, weightScale.cscalar, weightZeroPoint.cscalar, bias.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(packed)
+	runtime.KeepAlive(colOffsets)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7224,10 +8141,11 @@ func FbgemmPackGemmMatrixFp16(input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmPackGemmMatrixFp16(ptr, input.ctensor)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7236,10 +8154,11 @@ func FbgemmPackQuantizedMatrix(input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmPackQuantizedMatrix(ptr, input.ctensor)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7248,10 +8167,11 @@ func FbgemmPackQuantizedMatrix1(input *Tensor, k int64, n int64) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFbgemmPackQuantizedMatrix1(ptr, input.ctensor, k, n)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7264,10 +8184,11 @@ func FeatureAlphaDropout(input *Tensor, p float64, train bool) (retVal *Tensor,
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureAlphaDropout(ptr, input.ctensor, p, ctrain)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7280,6 +8201,7 @@ func (ts *Tensor) FeatureAlphaDropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureAlphaDropout_(ptr, ts.ctensor, p, ctrain)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7295,10 +8217,11 @@ func FeatureDropout(input *Tensor, p float64, train bool) (retVal *Tensor, err e
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureDropout(ptr, input.ctensor, p, ctrain)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7311,6 +8234,7 @@ func (ts *Tensor) FeatureDropout_(p float64, train bool) (err error) {
 		ctrain = int32(1)
 	}
 	lib.AtgFeatureDropout_(ptr, ts.ctensor, p, ctrain)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7329,10 +8253,11 @@ func (ts *Tensor) Fft(signalNdim int64, normalized bool, del bool) (retVal *Tens
 		cnormalized = int32(1)
 	}
 	lib.AtgFft(ptr, ts.ctensor, signalNdim, cnormalized)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7350,10 +8275,11 @@ func (ts *Tensor) FftFft(n []int64, dim int64, norm string, del bool) (retVal *T
 		cnNull = 0
 	}
 	lib.AtgFftFft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7365,10 +8291,11 @@ func (ts *Tensor) FftFftn(s []int64, dim []int64, norm string, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftFftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7386,10 +8313,11 @@ func (ts *Tensor) FftHfft(n []int64, dim int64, norm string, del bool) (retVal *
 		cnNull = 0
 	}
 	lib.AtgFftHfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7407,10 +8335,11 @@ func (ts *Tensor) FftIfft(n []int64, dim int64, norm string, del bool) (retVal *
 		cnNull = 0
 	}
 	lib.AtgFftIfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7422,10 +8351,11 @@ func (ts *Tensor) FftIfftn(s []int64, dim []int64, norm string, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftIfftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7443,10 +8373,11 @@ func (ts *Tensor) FftIhfft(n []int64, dim int64, norm string, del bool) (retVal
 		cnNull = 0
 	}
 	lib.AtgFftIhfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7464,10 +8395,11 @@ func (ts *Tensor) FftIrfft(n []int64, dim int64, norm string, del bool) (retVal
 		cnNull = 0
 	}
 	lib.AtgFftIrfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7479,10 +8411,11 @@ func (ts *Tensor) FftIrfftn(s []int64, dim []int64, norm string, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftIrfftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7500,10 +8433,11 @@ func (ts *Tensor) FftRfft(n []int64, dim int64, norm string, del bool) (retVal *
 		cnNull = 0
 	}
 	lib.AtgFftRfft(ptr, ts.ctensor, cnVal, cnNull, dim, norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7515,10 +8449,11 @@ func (ts *Tensor) FftRfftn(s []int64, dim []int64, norm string, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFftRfftn(ptr, ts.ctensor, s, len(s), dim, len(dim), norm)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7527,6 +8462,7 @@ func (ts *Tensor) Fill_(value *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFill_(ptr, ts.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7538,6 +8474,8 @@ func (ts *Tensor) Fill1_(value *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFill1_(ptr, ts.ctensor, value.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(value)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7553,6 +8491,7 @@ func (ts *Tensor) FillDiagonal_(fillValue *Scalar, wrap bool) (err error) {
 		cwrap = int32(1)
 	}
 	lib.AtgFillDiagonal_(ptr, ts.ctensor, fillValue.cscalar, cwrap)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7567,10 +8506,11 @@ func (ts *Tensor) Fix(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFix(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7579,6 +8519,7 @@ func (ts *Tensor) Fix_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFix_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7593,10 +8534,12 @@ func (ts *Tensor) FixOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFixOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7608,10 +8551,11 @@ func (ts *Tensor) Flatten(startDim int64, endDim int64, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFlatten(ptr, ts.ctensor, startDim, endDim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7623,10 +8567,11 @@ func (ts *Tensor) Flip(dims []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFlip(ptr, ts.ctensor, dims, len(dims))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7638,10 +8583,11 @@ func (ts *Tensor) Fliplr(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFliplr(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7653,10 +8599,11 @@ func (ts *Tensor) Flipud(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFlipud(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7668,10 +8615,11 @@ func (ts *Tensor) Floor(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloor(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7680,6 +8628,7 @@ func (ts *Tensor) Floor_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloor_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7694,10 +8643,12 @@ func (ts *Tensor) FloorDivide(other *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7709,10 +8660,11 @@ func (ts *Tensor) FloorDivide1(other *Scalar, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7721,6 +8673,8 @@ func (ts *Tensor) FloorDivide_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7732,6 +8686,7 @@ func (ts *Tensor) FloorDivide1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivide1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7746,10 +8701,13 @@ func (ts *Tensor) FloorDivideOut(out *Tensor, other *Tensor, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorDivideOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7761,10 +8719,12 @@ func (ts *Tensor) FloorOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFloorOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7776,10 +8736,11 @@ func (ts *Tensor) Fmod(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7791,10 +8752,12 @@ func (ts *Tensor) Fmod1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7803,6 +8766,7 @@ func (ts *Tensor) Fmod_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7814,6 +8778,8 @@ func (ts *Tensor) Fmod1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmod1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7828,10 +8794,12 @@ func (ts *Tensor) FmodOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmodOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7843,10 +8811,13 @@ func (ts *Tensor) FmodOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFmodOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7858,10 +8829,11 @@ func (ts *Tensor) Frac(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFrac(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7870,6 +8842,7 @@ func (ts *Tensor) Frac_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFrac_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -7884,10 +8857,12 @@ func (ts *Tensor) FracOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFracOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7899,10 +8874,13 @@ func (ts *Tensor) FractionalMaxPool2dBackward(gradOutput *Tensor, kernelSize []i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7914,10 +8892,14 @@ func (ts *Tensor) FractionalMaxPool2dBackwardOut(gradInput *Tensor, gradOutput *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7929,10 +8911,13 @@ func (ts *Tensor) FractionalMaxPool3dBackward(gradOutput *Tensor, kernelSize []i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7944,10 +8929,14 @@ func (ts *Tensor) FractionalMaxPool3dBackwardOut(gradInput *Tensor, gradOutput *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFractionalMaxPool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), outputSize, len(outputSize), indices.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7959,10 +8948,11 @@ func (ts *Tensor) FrobeniusNorm(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFrobeniusNorm(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7978,10 +8968,11 @@ func (ts *Tensor) FrobeniusNorm1(dim []int64, keepdim bool, del bool) (retVal *T
 		ckeepdim = int32(1)
 	}
 	lib.AtgFrobeniusNorm1(ptr, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -7997,10 +8988,12 @@ func (ts *Tensor) FrobeniusNormOut(out *Tensor, dim []int64, keepdim bool, del b
 		ckeepdim = int32(1)
 	}
 	lib.AtgFrobeniusNormOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8022,7 +9015,7 @@ func FromFile(filename string, shared bool, size []int64, optionsKind gotch.DTyp
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8034,7 +9027,7 @@ func Full(size []int64, fillValue *Scalar, optionsKind gotch.DType, optionsDevic
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8046,10 +9039,11 @@ func (ts *Tensor) FullLike(fillValue *Scalar, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFullLike(ptr, ts.ctensor, fillValue.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8058,10 +9052,11 @@ func FullOut(out *Tensor, size []int64, fillValue *Scalar) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgFullOut(ptr, out.ctensor, size, len(size), fillValue.cscalar)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8077,10 +9072,12 @@ func (ts *Tensor) Gather(dim int64, index *Tensor, sparseGrad bool, del bool) (r
 		csparseGrad = int32(1)
 	}
 	lib.AtgGather(ptr, ts.ctensor, dim, index.ctensor, csparseGrad)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8096,10 +9093,13 @@ func (ts *Tensor) GatherBackward(grad *Tensor, dim int64, index *Tensor, sparseG
 		csparseGrad = int32(1)
 	}
 	lib.AtgGatherBackward(ptr, grad.ctensor, ts.ctensor, dim, index.ctensor, csparseGrad)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8115,10 +9115,13 @@ func (ts *Tensor) GatherOut(out *Tensor, dim int64, index *Tensor, sparseGrad bo
 		csparseGrad = int32(1)
 	}
 	lib.AtgGatherOut(ptr, out.ctensor, ts.ctensor, dim, index.ctensor, csparseGrad)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8130,10 +9133,12 @@ func (ts *Tensor) Gcd(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGcd(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8142,6 +9147,8 @@ func (ts *Tensor) Gcd_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGcd_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8156,10 +9163,13 @@ func (ts *Tensor) GcdOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGcdOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8171,10 +9181,11 @@ func (ts *Tensor) Ge(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8186,10 +9197,12 @@ func (ts *Tensor) Ge1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8198,6 +9211,7 @@ func (ts *Tensor) Ge_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8209,6 +9223,8 @@ func (ts *Tensor) Ge1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGe1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8223,10 +9239,12 @@ func (ts *Tensor) GeOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8238,10 +9256,13 @@ func (ts *Tensor) GeOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8253,10 +9274,11 @@ func (ts *Tensor) Gelu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGelu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8268,10 +9290,12 @@ func (ts *Tensor) GeluBackward(grad *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeluBackward(ptr, grad.ctensor, ts.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8280,6 +9304,7 @@ func (ts *Tensor) Geometric_(p float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGeometric_(ptr, ts.ctensor, p)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8294,10 +9319,12 @@ func (ts *Tensor) Ger(vec2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGer(ptr, ts.ctensor, vec2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8309,10 +9336,13 @@ func (ts *Tensor) GerOut(out *Tensor, vec2 *Tensor, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGerOut(ptr, out.ctensor, ts.ctensor, vec2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8324,10 +9354,11 @@ func (ts *Tensor) Glu(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGlu(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8339,10 +9370,12 @@ func (ts *Tensor) GluBackward(gradOutput *Tensor, dim int64, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGluBackward(ptr, gradOutput.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8354,10 +9387,13 @@ func (ts *Tensor) GluBackwardOut(gradInput *Tensor, gradOutput *Tensor, dim int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGluBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8369,10 +9405,12 @@ func (ts *Tensor) GluOut(out *Tensor, dim int64, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGluOut(ptr, out.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8384,10 +9422,11 @@ func (ts *Tensor) Grad(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGrad(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8399,10 +9438,11 @@ func (ts *Tensor) Greater(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8414,10 +9454,12 @@ func (ts *Tensor) Greater1(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8426,6 +9468,7 @@ func (ts *Tensor) Greater_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8437,6 +9480,8 @@ func (ts *Tensor) Greater1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreater1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8451,10 +9496,11 @@ func (ts *Tensor) GreaterEqual(other *Scalar, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8466,10 +9512,12 @@ func (ts *Tensor) GreaterEqual1(other *Tensor, del bool) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8478,6 +9526,7 @@ func (ts *Tensor) GreaterEqual_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8489,6 +9538,8 @@ func (ts *Tensor) GreaterEqual1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqual1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8503,10 +9554,12 @@ func (ts *Tensor) GreaterEqualOut(out *Tensor, other *Scalar, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqualOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8518,10 +9571,13 @@ func (ts *Tensor) GreaterEqualOut1(out *Tensor, other *Tensor, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterEqualOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8533,10 +9589,12 @@ func (ts *Tensor) GreaterOut(out *Tensor, other *Scalar, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8548,10 +9606,13 @@ func (ts *Tensor) GreaterOut1(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGreaterOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8564,10 +9625,12 @@ func GridSampler(input *Tensor, grid *Tensor, interpolationMode int64, paddingMo
 		calignCorners = int32(1)
 	}
 	lib.AtgGridSampler(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(grid)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8580,10 +9643,12 @@ func GridSampler2d(input *Tensor, grid *Tensor, interpolationMode int64, padding
 		calignCorners = int32(1)
 	}
 	lib.AtgGridSampler2d(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(grid)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8596,10 +9661,12 @@ func GridSampler3d(input *Tensor, grid *Tensor, interpolationMode int64, padding
 		calignCorners = int32(1)
 	}
 	lib.AtgGridSampler3d(ptr, input.ctensor, grid.ctensor, interpolationMode, paddingMode, calignCorners)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(grid)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8612,10 +9679,13 @@ func GroupNorm(input *Tensor, numGroups int64, weight *Tensor, bias *Tensor, eps
 		ccudnnEnabled = int32(1)
 	}
 	lib.AtgGroupNorm(ptr, input.ctensor, numGroups, weight.ctensor, bias.ctensor, eps, ccudnnEnabled)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8624,10 +9694,16 @@ func GruCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGruCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(wIh)
+	runtime.KeepAlive(wHh)
+	runtime.KeepAlive(bIh)
+	runtime.KeepAlive(bHh)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8639,10 +9715,11 @@ func (ts *Tensor) Gt(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8654,10 +9731,12 @@ func (ts *Tensor) Gt1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8666,6 +9745,7 @@ func (ts *Tensor) Gt_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8677,6 +9757,8 @@ func (ts *Tensor) Gt1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGt1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8691,10 +9773,12 @@ func (ts *Tensor) GtOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGtOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8706,10 +9790,13 @@ func (ts *Tensor) GtOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgGtOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8721,7 +9808,7 @@ func HammingWindow(windowLength int64, optionsKind gotch.DType, optionsDevice go
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8737,7 +9824,7 @@ func HammingWindow1(windowLength int64, periodic bool, optionsKind gotch.DType,
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8753,7 +9840,7 @@ func HammingWindow2(windowLength int64, periodic bool, alpha float64, optionsKin
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8769,7 +9856,7 @@ func HammingWindow3(windowLength int64, periodic bool, alpha float64, beta float
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8781,7 +9868,7 @@ func HannWindow(windowLength int64, optionsKind gotch.DType, optionsDevice gotch
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8797,7 +9884,7 @@ func HannWindow1(windowLength int64, periodic bool, optionsKind gotch.DType, opt
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8809,10 +9896,11 @@ func (ts *Tensor) Hardshrink(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardshrink(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8824,10 +9912,12 @@ func (ts *Tensor) HardshrinkBackward(gradOut *Tensor, lambd *Scalar, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardshrinkBackward(ptr, gradOut.ctensor, ts.ctensor, lambd.cscalar)
+	runtime.KeepAlive(gradOut)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8839,10 +9929,11 @@ func (ts *Tensor) Hardsigmoid(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoid(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8851,6 +9942,7 @@ func (ts *Tensor) Hardsigmoid_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoid_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8865,10 +9957,12 @@ func (ts *Tensor) HardsigmoidBackward(gradOutput *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoidBackward(ptr, gradOutput.ctensor, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8880,10 +9974,12 @@ func (ts *Tensor) HardsigmoidOut(out *Tensor, del bool) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardsigmoidOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8895,10 +9991,11 @@ func (ts *Tensor) Hardswish(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswish(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8907,6 +10004,7 @@ func (ts *Tensor) Hardswish_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswish_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8921,10 +10019,12 @@ func (ts *Tensor) HardswishBackward(gradOutput *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswishBackward(ptr, gradOutput.ctensor, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8936,10 +10036,12 @@ func (ts *Tensor) HardswishOut(out *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardswishOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8951,10 +10053,11 @@ func (ts *Tensor) Hardtanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8963,6 +10066,7 @@ func (ts *Tensor) Hardtanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -8977,10 +10081,12 @@ func (ts *Tensor) HardtanhBackward(gradOutput *Tensor, minVal *Scalar, maxVal *S
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanhBackward(ptr, gradOutput.ctensor, ts.ctensor, minVal.cscalar, maxVal.cscalar)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -8992,10 +10098,13 @@ func (ts *Tensor) HardtanhBackwardOut(gradInput *Tensor, gradOutput *Tensor, min
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanhBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, minVal.cscalar, maxVal.cscalar)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9007,10 +10116,12 @@ func (ts *Tensor) HardtanhOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHardtanhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9022,10 +10133,12 @@ func (ts *Tensor) Heaviside(values *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHeaviside(ptr, ts.ctensor, values.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9034,6 +10147,8 @@ func (ts *Tensor) Heaviside_(values *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHeaviside_(ptr, ts.ctensor, values.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9048,10 +10163,13 @@ func (ts *Tensor) HeavisideOut(out *Tensor, values *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHeavisideOut(ptr, out.ctensor, ts.ctensor, values.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9063,10 +10181,12 @@ func (ts *Tensor) HingeEmbeddingLoss(target *Tensor, margin float64, reduction i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHingeEmbeddingLoss(ptr, ts.ctensor, target.ctensor, margin, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9078,10 +10198,11 @@ func (ts *Tensor) Histc(bins int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHistc(ptr, ts.ctensor, bins)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9093,10 +10214,12 @@ func (ts *Tensor) HistcOut(out *Tensor, bins int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHistcOut(ptr, out.ctensor, ts.ctensor, bins)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9105,10 +10228,12 @@ func Hspmm(mat1 *Tensor, mat2 *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHspmm(ptr, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9117,15 +10242,22 @@ func HspmmOut(out *Tensor, mat1 *Tensor, mat2 *Tensor) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHspmmOut(ptr, out.ctensor, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func Hstack(tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("Hstack() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -9133,15 +10265,20 @@ func Hstack(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgHstack(ptr, ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func HstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("HstackOut() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -9149,10 +10286,12 @@ func HstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgHstackOut(ptr, out.ctensor, ctensors, len(ctensors))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9164,10 +10303,12 @@ func (ts *Tensor) Hypot(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHypot(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9176,6 +10317,8 @@ func (ts *Tensor) Hypot_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHypot_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9190,10 +10333,13 @@ func (ts *Tensor) HypotOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgHypotOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9205,10 +10351,11 @@ func (ts *Tensor) I0(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgI0(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9217,6 +10364,7 @@ func (ts *Tensor) I0_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgI0_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9231,10 +10379,12 @@ func (ts *Tensor) I0Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgI0Out(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9250,10 +10400,11 @@ func (ts *Tensor) Ifft(signalNdim int64, normalized bool, del bool) (retVal *Ten
 		cnormalized = int32(1)
 	}
 	lib.AtgIfft(ptr, ts.ctensor, signalNdim, cnormalized)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9265,10 +10416,11 @@ func (ts *Tensor) Im2col(kernelSize []int64, dilation []int64, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2col(ptr, ts.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9277,10 +10429,11 @@ func Im2colBackward(gradOutput *Tensor, inputSize []int64, kernelSize []int64, d
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2colBackward(ptr, gradOutput.ctensor, inputSize, len(inputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9289,10 +10442,12 @@ func Im2colBackwardOut(gradInput *Tensor, gradOutput *Tensor, inputSize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2colBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, inputSize, len(inputSize), kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9304,10 +10459,12 @@ func (ts *Tensor) Im2colOut(out *Tensor, kernelSize []int64, dilation []int64, p
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIm2colOut(ptr, out.ctensor, ts.ctensor, kernelSize, len(kernelSize), dilation, len(dilation), padding, len(padding), stride, len(stride))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9319,15 +10476,20 @@ func (ts *Tensor) Imag(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgImag(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func (ts *Tensor) Index(indices []Tensor, del bool) (retVal *Tensor, err error) {
+	if len(indices) == 0 {
+		err = fmt.Errorf("Index() failed: empty tensor list input (indices)\n")
+		return
+	}
 	if del {
 		defer ts.MustDrop()
 	}
@@ -9338,10 +10500,12 @@ func (ts *Tensor) Index(indices []Tensor, del bool) (retVal *Tensor, err error)
 		cindices = append(cindices, t.ctensor)
 	}
 	lib.AtgIndex(ptr, ts.ctensor, cindices, len(cindices))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9353,10 +10517,13 @@ func (ts *Tensor) IndexAdd(dim int64, index *Tensor, source *Tensor, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexAdd(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9365,6 +10532,9 @@ func (ts *Tensor) IndexAdd_(dim int64, index *Tensor, source *Tensor) (err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexAdd_(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9379,10 +10549,13 @@ func (ts *Tensor) IndexCopy(dim int64, index *Tensor, source *Tensor, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexCopy(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9391,6 +10564,9 @@ func (ts *Tensor) IndexCopy_(dim int64, index *Tensor, source *Tensor) (err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexCopy_(ptr, ts.ctensor, dim, index.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9405,10 +10581,12 @@ func (ts *Tensor) IndexFill(dim int64, index *Tensor, value *Scalar, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9420,10 +10598,13 @@ func (ts *Tensor) IndexFill1(dim int64, index *Tensor, value *Tensor, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill1(ptr, ts.ctensor, dim, index.ctensor, value.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(value)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9432,6 +10613,8 @@ func (ts *Tensor) IndexFill_(dim int64, index *Tensor, value *Scalar) (err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill_(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9443,6 +10626,9 @@ func (ts *Tensor) IndexFill1_(dim int64, index *Tensor, value *Tensor) (err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexFill1_(ptr, ts.ctensor, dim, index.ctensor, value.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(value)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9451,6 +10637,10 @@ func (ts *Tensor) IndexFill1_(dim int64, index *Tensor, value *Tensor) (err erro
 }
 
 func (ts *Tensor) IndexPut(indices []Tensor, values *Tensor, accumulate bool, del bool) (retVal *Tensor, err error) {
+	if len(indices) == 0 {
+		err = fmt.Errorf("IndexPut() failed: empty tensor list input (indices)\n")
+		return
+	}
 	if del {
 		defer ts.MustDrop()
 	}
@@ -9465,15 +10655,22 @@ func (ts *Tensor) IndexPut(indices []Tensor, values *Tensor, accumulate bool, de
 		caccumulate = int32(1)
 	}
 	lib.AtgIndexPut(ptr, ts.ctensor, cindices, len(cindices), values.ctensor, caccumulate)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func (ts *Tensor) IndexPut_(indices []Tensor, values *Tensor, accumulate bool) (err error) {
+	if len(indices) == 0 {
+		err = fmt.Errorf("IndexPut_() failed: empty tensor list input (indices)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var cindices []lib.Ctensor
@@ -9485,6 +10682,9 @@ func (ts *Tensor) IndexPut_(indices []Tensor, values *Tensor, accumulate bool) (
 		caccumulate = int32(1)
 	}
 	lib.AtgIndexPut_(ptr, ts.ctensor, cindices, len(cindices), values.ctensor, caccumulate)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -9499,10 +10699,12 @@ func (ts *Tensor) IndexSelect(dim int64, index *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexSelect(ptr, ts.ctensor, dim, index.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9511,10 +10713,12 @@ func IndexSelectBackward(grad *Tensor, selfSizes []int64, dim int64, index *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexSelectBackward(ptr, grad.ctensor, selfSizes, len(selfSizes), dim, index.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9526,10 +10730,13 @@ func (ts *Tensor) IndexSelectOut(out *Tensor, dim int64, index *Tensor, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndexSelectOut(ptr, out.ctensor, ts.ctensor, dim, index.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9541,10 +10748,11 @@ func (ts *Tensor) Indices(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIndices(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9556,10 +10764,12 @@ func (ts *Tensor) InfinitelyDifferentiableGeluBackward(grad *Tensor, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgInfinitelyDifferentiableGeluBackward(ptr, grad.ctensor, ts.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9576,10 +10786,15 @@ func InstanceNorm(input *Tensor, weight *Tensor, bias *Tensor, runningMean *Tens
 		ccudnnEnabled = int32(1)
 	}
 	lib.AtgInstanceNorm(ptr, input.ctensor, weight.ctensor, bias.ctensor, runningMean.ctensor, runningVar.ctensor, cuseInputStats, momentum, eps, ccudnnEnabled)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
+	runtime.KeepAlive(runningMean)
+	runtime.KeepAlive(runningVar)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9591,10 +10806,11 @@ func (ts *Tensor) IntRepr(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIntRepr(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9606,10 +10822,11 @@ func (ts *Tensor) Inverse(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgInverse(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9621,10 +10838,12 @@ func (ts *Tensor) InverseOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgInverseOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9644,10 +10863,11 @@ func (ts *Tensor) Irfft(signalNdim int64, normalized bool, onesided bool, signal
 		conesided = int32(1)
 	}
 	lib.AtgIrfft(ptr, ts.ctensor, signalNdim, cnormalized, conesided, signalSizes, len(signalSizes))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9663,10 +10883,12 @@ func (ts *Tensor) Isclose(other *Tensor, rtol float64, atol float64, equalNan bo
 		cequalNan = int32(1)
 	}
 	lib.AtgIsclose(ptr, ts.ctensor, other.ctensor, rtol, atol, cequalNan)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9678,10 +10900,11 @@ func (ts *Tensor) Isfinite(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsfinite(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9693,10 +10916,11 @@ func (ts *Tensor) Isinf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsinf(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9708,10 +10932,11 @@ func (ts *Tensor) Isnan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsnan(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9723,10 +10948,11 @@ func (ts *Tensor) Isneginf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsneginf(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9738,10 +10964,12 @@ func (ts *Tensor) IsneginfOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsneginfOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9753,10 +10981,11 @@ func (ts *Tensor) Isposinf(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsposinf(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9768,10 +10997,12 @@ func (ts *Tensor) IsposinfOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsposinfOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9783,10 +11014,11 @@ func (ts *Tensor) Isreal(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgIsreal(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9832,10 +11064,12 @@ func (ts *Tensor) Istft(nFft int64, hopLength []int64, winLength []int64, window
 		creturnComplex = int32(1)
 	}
 	lib.AtgIstft(ptr, ts.ctensor, nFft, chopLengthVal, chopLengthNull, cwinLengthVal, cwinLengthNull, window.ctensor, ccenter, cnormalized, conesided, clengthVal, clengthNull, creturnComplex)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(window)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9847,7 +11081,7 @@ func KaiserWindow(windowLength int64, optionsKind gotch.DType, optionsDevice got
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9863,7 +11097,7 @@ func KaiserWindow1(windowLength int64, periodic bool, optionsKind gotch.DType, o
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9879,7 +11113,7 @@ func KaiserWindow2(windowLength int64, periodic bool, beta float64, optionsKind
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9895,10 +11129,12 @@ func (ts *Tensor) KlDiv(target *Tensor, reduction int64, logTarget bool, del boo
 		clogTarget = int32(1)
 	}
 	lib.AtgKlDiv(ptr, ts.ctensor, target.ctensor, reduction, clogTarget)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9914,10 +11150,13 @@ func (ts *Tensor) KlDivBackward(gradOutput *Tensor, target *Tensor, reduction in
 		clogTarget = int32(1)
 	}
 	lib.AtgKlDivBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, clogTarget)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9929,10 +11168,12 @@ func (ts *Tensor) L1Loss(target *Tensor, reduction int64, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1Loss(ptr, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9944,10 +11185,13 @@ func (ts *Tensor) L1LossBackward(gradOutput *Tensor, target *Tensor, reduction i
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1LossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9959,10 +11203,14 @@ func (ts *Tensor) L1LossBackwardOut(gradInput *Tensor, gradOutput *Tensor, targe
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1LossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9974,10 +11222,13 @@ func (ts *Tensor) L1LossOut(out *Tensor, target *Tensor, reduction int64, del bo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgL1LossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -9990,10 +11241,13 @@ func LayerNorm(input *Tensor, normalizedShape []int64, weight *Tensor, bias *Ten
 		ccudnnEnable = int32(1)
 	}
 	lib.AtgLayerNorm(ptr, input.ctensor, normalizedShape, len(normalizedShape), weight.ctensor, bias.ctensor, eps, ccudnnEnable)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10005,10 +11259,12 @@ func (ts *Tensor) Lcm(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLcm(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10017,6 +11273,8 @@ func (ts *Tensor) Lcm_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLcm_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10031,10 +11289,13 @@ func (ts *Tensor) LcmOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLcmOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10046,10 +11307,11 @@ func (ts *Tensor) Le(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10061,10 +11323,12 @@ func (ts *Tensor) Le1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10073,6 +11337,7 @@ func (ts *Tensor) Le_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10084,6 +11349,8 @@ func (ts *Tensor) Le1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLe1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10098,10 +11365,12 @@ func (ts *Tensor) LeOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10113,10 +11382,13 @@ func (ts *Tensor) LeOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10128,10 +11400,11 @@ func (ts *Tensor) LeakyRelu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeakyRelu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10140,6 +11413,7 @@ func (ts *Tensor) LeakyRelu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeakyRelu_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10158,10 +11432,12 @@ func (ts *Tensor) LeakyReluBackward(gradOutput *Tensor, negativeSlope *Scalar, s
 		cselfIsResult = int32(1)
 	}
 	lib.AtgLeakyReluBackward(ptr, gradOutput.ctensor, ts.ctensor, negativeSlope.cscalar, cselfIsResult)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10173,10 +11449,12 @@ func (ts *Tensor) LeakyReluOut(out *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLeakyReluOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10188,10 +11466,12 @@ func (ts *Tensor) Lerp(end *Tensor, weight *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp(ptr, ts.ctensor, end.ctensor, weight.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(end)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10203,10 +11483,13 @@ func (ts *Tensor) Lerp1(end *Tensor, weight *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp1(ptr, ts.ctensor, end.ctensor, weight.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(end)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10215,6 +11498,8 @@ func (ts *Tensor) Lerp_(end *Tensor, weight *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp_(ptr, ts.ctensor, end.ctensor, weight.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(end)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10226,6 +11511,9 @@ func (ts *Tensor) Lerp1_(end *Tensor, weight *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerp1_(ptr, ts.ctensor, end.ctensor, weight.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(end)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10240,10 +11528,13 @@ func (ts *Tensor) LerpOut(out *Tensor, end *Tensor, weight *Scalar, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerpOut(ptr, out.ctensor, ts.ctensor, end.ctensor, weight.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(end)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10255,10 +11546,14 @@ func (ts *Tensor) LerpOut1(out *Tensor, end *Tensor, weight *Tensor, del bool) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLerpOut1(ptr, out.ctensor, ts.ctensor, end.ctensor, weight.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(end)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10270,10 +11565,11 @@ func (ts *Tensor) Less(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10285,10 +11581,12 @@ func (ts *Tensor) Less1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10297,6 +11595,7 @@ func (ts *Tensor) Less_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10308,6 +11607,8 @@ func (ts *Tensor) Less1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLess1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10322,10 +11623,11 @@ func (ts *Tensor) LessEqual(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10337,10 +11639,12 @@ func (ts *Tensor) LessEqual1(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10349,6 +11653,7 @@ func (ts *Tensor) LessEqual_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10360,6 +11665,8 @@ func (ts *Tensor) LessEqual1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqual1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10374,10 +11681,12 @@ func (ts *Tensor) LessEqualOut(out *Tensor, other *Scalar, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqualOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10389,10 +11698,13 @@ func (ts *Tensor) LessEqualOut1(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessEqualOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10404,10 +11716,12 @@ func (ts *Tensor) LessOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10419,10 +11733,13 @@ func (ts *Tensor) LessOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLessOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10434,10 +11751,11 @@ func (ts *Tensor) Lgamma(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLgamma(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10446,6 +11764,7 @@ func (ts *Tensor) Lgamma_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLgamma_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10460,10 +11779,12 @@ func (ts *Tensor) LgammaOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLgammaOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10475,10 +11796,11 @@ func (ts *Tensor) LinalgDet(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLinalgDet(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10494,10 +11816,11 @@ func (ts *Tensor) LinalgNorm(ord *Scalar, dim []int64, keepdim bool, dtype gotch
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNorm(ptr, ts.ctensor, ord.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10513,10 +11836,11 @@ func (ts *Tensor) LinalgNorm1(ord string, dim []int64, keepdim bool, dtype gotch
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNorm1(ptr, ts.ctensor, ord, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10532,10 +11856,12 @@ func (ts *Tensor) LinalgNormOut(out *Tensor, ord *Scalar, dim []int64, keepdim b
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNormOut(ptr, out.ctensor, ts.ctensor, ord.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10551,10 +11877,12 @@ func (ts *Tensor) LinalgNormOut1(out *Tensor, ord string, dim []int64, keepdim b
 		ckeepdim = int32(1)
 	}
 	lib.AtgLinalgNormOut1(ptr, out.ctensor, ts.ctensor, ord, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10563,10 +11891,13 @@ func Linear(input *Tensor, weight *Tensor, bias *Tensor) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLinear(ptr, input.ctensor, weight.ctensor, bias.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10584,7 +11915,7 @@ func Linspace(start *Scalar, end *Scalar, steps []int64, optionsKind gotch.DType
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10599,10 +11930,11 @@ func LinspaceOut(out *Tensor, start *Scalar, end *Scalar, steps []int64) (retVal
 		cstepsNull = 0
 	}
 	lib.AtgLinspaceOut(ptr, out.ctensor, start.cscalar, end.cscalar, cstepsVal, cstepsNull)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10614,10 +11946,11 @@ func (ts *Tensor) Log(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10629,10 +11962,11 @@ func (ts *Tensor) Log10(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog10(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10641,6 +11975,7 @@ func (ts *Tensor) Log10_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog10_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10655,10 +11990,12 @@ func (ts *Tensor) Log10Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog10Out(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10670,10 +12007,11 @@ func (ts *Tensor) Log1p(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog1p(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10682,6 +12020,7 @@ func (ts *Tensor) Log1p_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog1p_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10696,10 +12035,12 @@ func (ts *Tensor) Log1pOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog1pOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10711,10 +12052,11 @@ func (ts *Tensor) Log2(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog2(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10723,6 +12065,7 @@ func (ts *Tensor) Log2_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog2_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10737,10 +12080,12 @@ func (ts *Tensor) Log2Out(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog2Out(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10749,6 +12094,7 @@ func (ts *Tensor) Log_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLog_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10760,6 +12106,7 @@ func (ts *Tensor) LogNormal_(mean float64, std float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogNormal_(ptr, ts.ctensor, mean, std)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10774,10 +12121,12 @@ func (ts *Tensor) LogOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10789,10 +12138,11 @@ func (ts *Tensor) LogSigmoid(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoid(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10804,10 +12154,13 @@ func (ts *Tensor) LogSigmoidBackward(gradOutput *Tensor, buffer *Tensor, del boo
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoidBackward(ptr, gradOutput.ctensor, ts.ctensor, buffer.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(buffer)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10819,10 +12172,14 @@ func (ts *Tensor) LogSigmoidBackwardOut(gradInput *Tensor, gradOutput *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoidBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, buffer.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(buffer)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10834,10 +12191,12 @@ func (ts *Tensor) LogSigmoidOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSigmoidOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10849,10 +12208,11 @@ func (ts *Tensor) LogSoftmax(dim int64, dtype gotch.DType, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10864,10 +12224,12 @@ func (ts *Tensor) Logaddexp(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexp(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10879,10 +12241,12 @@ func (ts *Tensor) Logaddexp2(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexp2(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10894,10 +12258,13 @@ func (ts *Tensor) Logaddexp2Out(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexp2Out(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10909,10 +12276,13 @@ func (ts *Tensor) LogaddexpOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogaddexpOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10924,10 +12294,11 @@ func (ts *Tensor) Logcumsumexp(dim int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogcumsumexp(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10939,10 +12310,12 @@ func (ts *Tensor) LogcumsumexpOut(out *Tensor, dim int64, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogcumsumexpOut(ptr, out.ctensor, ts.ctensor, dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10954,10 +12327,11 @@ func (ts *Tensor) Logdet(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogdet(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10969,10 +12343,12 @@ func (ts *Tensor) LogicalAnd(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalAnd(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -10981,6 +12357,8 @@ func (ts *Tensor) LogicalAnd_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalAnd_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -10995,10 +12373,13 @@ func (ts *Tensor) LogicalAndOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalAndOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11010,10 +12391,11 @@ func (ts *Tensor) LogicalNot(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalNot(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11022,6 +12404,7 @@ func (ts *Tensor) LogicalNot_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalNot_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11036,10 +12419,12 @@ func (ts *Tensor) LogicalNotOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalNotOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11051,10 +12436,12 @@ func (ts *Tensor) LogicalOr(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalOr(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11063,6 +12450,8 @@ func (ts *Tensor) LogicalOr_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalOr_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11077,10 +12466,13 @@ func (ts *Tensor) LogicalOrOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalOrOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11092,10 +12484,12 @@ func (ts *Tensor) LogicalXor(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalXor(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11104,6 +12498,8 @@ func (ts *Tensor) LogicalXor_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalXor_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11118,10 +12514,13 @@ func (ts *Tensor) LogicalXorOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLogicalXorOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11139,10 +12538,11 @@ func (ts *Tensor) Logit(eps []float64, del bool) (retVal *Tensor, err error) {
 		cepsNull = 0
 	}
 	lib.AtgLogit(ptr, ts.ctensor, cepsVal, cepsNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11157,6 +12557,7 @@ func (ts *Tensor) Logit_(eps []float64) (err error) {
 		cepsNull = 0
 	}
 	lib.AtgLogit_(ptr, ts.ctensor, cepsVal, cepsNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11177,10 +12578,12 @@ func (ts *Tensor) LogitBackward(gradOutput *Tensor, eps []float64, del bool) (re
 		cepsNull = 0
 	}
 	lib.AtgLogitBackward(ptr, gradOutput.ctensor, ts.ctensor, cepsVal, cepsNull)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11198,10 +12601,13 @@ func (ts *Tensor) LogitBackwardOut(gradInput *Tensor, gradOutput *Tensor, eps []
 		cepsNull = 0
 	}
 	lib.AtgLogitBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, cepsVal, cepsNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11219,10 +12625,12 @@ func (ts *Tensor) LogitOut(out *Tensor, eps []float64, del bool) (retVal *Tensor
 		cepsNull = 0
 	}
 	lib.AtgLogitOut(ptr, out.ctensor, ts.ctensor, cepsVal, cepsNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11240,7 +12648,7 @@ func Logspace(start *Scalar, end *Scalar, steps []int64, base float64, optionsKi
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11255,10 +12663,11 @@ func LogspaceOut(out *Tensor, start *Scalar, end *Scalar, steps []int64, base fl
 		cstepsNull = 0
 	}
 	lib.AtgLogspaceOut(ptr, out.ctensor, start.cscalar, end.cscalar, cstepsVal, cstepsNull, base)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11274,10 +12683,11 @@ func (ts *Tensor) Logsumexp(dim []int64, keepdim bool, del bool) (retVal *Tensor
 		ckeepdim = int32(1)
 	}
 	lib.AtgLogsumexp(ptr, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11293,10 +12703,12 @@ func (ts *Tensor) LogsumexpOut(out *Tensor, dim []int64, keepdim bool, del bool)
 		ckeepdim = int32(1)
 	}
 	lib.AtgLogsumexpOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11308,10 +12720,11 @@ func (ts *Tensor) Lt(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11323,10 +12736,12 @@ func (ts *Tensor) Lt1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11335,6 +12750,7 @@ func (ts *Tensor) Lt_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11346,6 +12762,8 @@ func (ts *Tensor) Lt1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLt1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11360,10 +12778,12 @@ func (ts *Tensor) LtOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLtOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11375,10 +12795,13 @@ func (ts *Tensor) LtOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLtOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11390,10 +12813,13 @@ func (ts *Tensor) LuSolve(lUData *Tensor, lUPivots *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLuSolve(ptr, ts.ctensor, lUData.ctensor, lUPivots.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(lUData)
+	runtime.KeepAlive(lUPivots)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11405,10 +12831,14 @@ func (ts *Tensor) LuSolveOut(out *Tensor, lUData *Tensor, lUPivots *Tensor, del
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgLuSolveOut(ptr, out.ctensor, ts.ctensor, lUData.ctensor, lUPivots.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(lUData)
+	runtime.KeepAlive(lUPivots)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11417,10 +12847,13 @@ func MarginRankingLoss(input1 *Tensor, input2 *Tensor, target *Tensor, margin fl
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMarginRankingLoss(ptr, input1.ctensor, input2.ctensor, target.ctensor, margin, reduction)
+	runtime.KeepAlive(input1)
+	runtime.KeepAlive(input2)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11432,10 +12865,12 @@ func (ts *Tensor) MaskedFill(mask *Tensor, value *Scalar, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill(ptr, ts.ctensor, mask.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11447,10 +12882,13 @@ func (ts *Tensor) MaskedFill1(mask *Tensor, value *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill1(ptr, ts.ctensor, mask.ctensor, value.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
+	runtime.KeepAlive(value)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11459,6 +12897,8 @@ func (ts *Tensor) MaskedFill_(mask *Tensor, value *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill_(ptr, ts.ctensor, mask.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11470,6 +12910,9 @@ func (ts *Tensor) MaskedFill1_(mask *Tensor, value *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedFill1_(ptr, ts.ctensor, mask.ctensor, value.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
+	runtime.KeepAlive(value)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11484,10 +12927,13 @@ func (ts *Tensor) MaskedScatter(mask *Tensor, source *Tensor, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedScatter(ptr, ts.ctensor, mask.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11496,6 +12942,9 @@ func (ts *Tensor) MaskedScatter_(mask *Tensor, source *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedScatter_(ptr, ts.ctensor, mask.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -11510,10 +12959,12 @@ func (ts *Tensor) MaskedSelect(mask *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedSelect(ptr, ts.ctensor, mask.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11522,10 +12973,13 @@ func MaskedSelectBackward(grad *Tensor, input *Tensor, mask *Tensor) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedSelectBackward(ptr, grad.ctensor, input.ctensor, mask.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11537,10 +12991,13 @@ func (ts *Tensor) MaskedSelectOut(out *Tensor, mask *Tensor, del bool) (retVal *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaskedSelectOut(ptr, out.ctensor, ts.ctensor, mask.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11552,10 +13009,12 @@ func (ts *Tensor) Matmul(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatmul(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11567,10 +13026,13 @@ func (ts *Tensor) MatmulOut(out *Tensor, other *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatmulOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11582,10 +13044,11 @@ func (ts *Tensor) MatrixExp(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatrixExp(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11597,10 +13060,12 @@ func (ts *Tensor) MatrixExpBackward(grad *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatrixExpBackward(ptr, ts.ctensor, grad.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11612,10 +13077,11 @@ func (ts *Tensor) MatrixPower(n int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMatrixPower(ptr, ts.ctensor, n)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11631,10 +13097,11 @@ func (ts *Tensor) MatrixRank(symmetric bool, del bool) (retVal *Tensor, err erro
 		csymmetric = int32(1)
 	}
 	lib.AtgMatrixRank(ptr, ts.ctensor, csymmetric)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11650,10 +13117,11 @@ func (ts *Tensor) MatrixRank1(tol float64, symmetric bool, del bool) (retVal *Te
 		csymmetric = int32(1)
 	}
 	lib.AtgMatrixRank1(ptr, ts.ctensor, tol, csymmetric)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11665,10 +13133,11 @@ func (ts *Tensor) Max(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMax(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11680,10 +13149,12 @@ func (ts *Tensor) Max1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMax1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11695,10 +13166,13 @@ func (ts *Tensor) MaxOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11714,10 +13188,11 @@ func (ts *Tensor) MaxPool1d(kernelSize []int64, stride []int64, padding []int64,
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool1d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11733,10 +13208,11 @@ func (ts *Tensor) MaxPool2d(kernelSize []int64, stride []int64, padding []int64,
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11752,10 +13228,13 @@ func (ts *Tensor) MaxPool2dWithIndicesBackward(gradOutput *Tensor, kernelSize []
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool2dWithIndicesBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11771,10 +13250,14 @@ func (ts *Tensor) MaxPool2dWithIndicesBackwardOut(gradInput *Tensor, gradOutput
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool2dWithIndicesBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11790,10 +13273,11 @@ func (ts *Tensor) MaxPool3d(kernelSize []int64, stride []int64, padding []int64,
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool3d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11809,10 +13293,13 @@ func (ts *Tensor) MaxPool3dWithIndicesBackward(gradOutput *Tensor, kernelSize []
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool3dWithIndicesBackward(ptr, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11828,10 +13315,14 @@ func (ts *Tensor) MaxPool3dWithIndicesBackwardOut(gradInput *Tensor, gradOutput
 		cceilMode = int32(1)
 	}
 	lib.AtgMaxPool3dWithIndicesBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode, indices.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11843,10 +13334,12 @@ func (ts *Tensor) MaxUnpool2d(indices *Tensor, outputSize []int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2d(ptr, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11858,10 +13351,13 @@ func (ts *Tensor) MaxUnpool2dBackward(gradOutput *Tensor, indices *Tensor, outpu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11873,10 +13369,14 @@ func (ts *Tensor) MaxUnpool2dBackwardOut(gradInput *Tensor, gradOutput *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11888,10 +13388,13 @@ func (ts *Tensor) MaxUnpool2dOut(out *Tensor, indices *Tensor, outputSize []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool2dOut(ptr, out.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11903,10 +13406,12 @@ func (ts *Tensor) MaxUnpool3d(indices *Tensor, outputSize []int64, stride []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3d(ptr, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11918,10 +13423,13 @@ func (ts *Tensor) MaxUnpool3dBackward(gradOutput *Tensor, indices *Tensor, outpu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3dBackward(ptr, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11933,10 +13441,14 @@ func (ts *Tensor) MaxUnpool3dBackwardOut(gradInput *Tensor, gradOutput *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11948,10 +13460,13 @@ func (ts *Tensor) MaxUnpool3dOut(out *Tensor, indices *Tensor, outputSize []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaxUnpool3dOut(ptr, out.ctensor, ts.ctensor, indices.ctensor, outputSize, len(outputSize), stride, len(stride), padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11963,10 +13478,12 @@ func (ts *Tensor) Maximum(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaximum(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11978,10 +13495,13 @@ func (ts *Tensor) MaximumOut(out *Tensor, other *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMaximumOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -11993,10 +13513,11 @@ func (ts *Tensor) Mean(dtype gotch.DType, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMean(ptr, ts.ctensor, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12012,10 +13533,11 @@ func (ts *Tensor) Mean1(dim []int64, keepdim bool, dtype gotch.DType, del bool)
 		ckeepdim = int32(1)
 	}
 	lib.AtgMean1(ptr, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12031,10 +13553,12 @@ func (ts *Tensor) MeanOut(out *Tensor, dim []int64, keepdim bool, dtype gotch.DT
 		ckeepdim = int32(1)
 	}
 	lib.AtgMeanOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12046,10 +13570,11 @@ func (ts *Tensor) Median(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMedian(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12061,10 +13586,11 @@ func (ts *Tensor) Min(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMin(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12076,10 +13602,12 @@ func (ts *Tensor) Min1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMin1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12091,10 +13619,13 @@ func (ts *Tensor) MinOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMinOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12106,10 +13637,12 @@ func (ts *Tensor) Minimum(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMinimum(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12121,10 +13654,13 @@ func (ts *Tensor) MinimumOut(out *Tensor, other *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMinimumOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12144,10 +13680,13 @@ func (ts *Tensor) MiopenConvolution(weight *Tensor, bias *Tensor, padding []int6
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolution(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12156,10 +13695,11 @@ func MiopenConvolutionBackwardBias(gradOutput *Tensor) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMiopenConvolutionBackwardBias(ptr, gradOutput.ctensor)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12176,10 +13716,12 @@ func MiopenConvolutionBackwardInput(selfSize []int64, gradOutput *Tensor, weight
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12199,10 +13741,12 @@ func (ts *Tensor) MiopenConvolutionBackwardWeight(weightSize []int64, gradOutput
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12222,10 +13766,13 @@ func (ts *Tensor) MiopenConvolutionTranspose(weight *Tensor, bias *Tensor, paddi
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionTranspose(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), outputPadding, len(outputPadding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12242,10 +13789,12 @@ func MiopenConvolutionTransposeBackwardInput(gradOutput *Tensor, weight *Tensor,
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionTransposeBackwardInput(ptr, gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12265,10 +13814,12 @@ func (ts *Tensor) MiopenConvolutionTransposeBackwardWeight(weightSize []int64, g
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenConvolutionTransposeBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12288,10 +13839,13 @@ func (ts *Tensor) MiopenDepthwiseConvolution(weight *Tensor, bias *Tensor, paddi
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenDepthwiseConvolution(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12308,10 +13862,12 @@ func MiopenDepthwiseConvolutionBackwardInput(selfSize []int64, gradOutput *Tenso
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenDepthwiseConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12331,10 +13887,12 @@ func (ts *Tensor) MiopenDepthwiseConvolutionBackwardWeight(weightSize []int64, g
 		cdeterministic = int32(1)
 	}
 	lib.AtgMiopenDepthwiseConvolutionBackwardWeight(ptr, weightSize, len(weightSize), gradOutput.ctensor, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbenchmark, cdeterministic)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12346,10 +13904,11 @@ func (ts *Tensor) MkldnnAdaptiveAvgPool2d(outputSize []int64, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnAdaptiveAvgPool2d(ptr, ts.ctensor, outputSize, len(outputSize))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12361,10 +13920,13 @@ func (ts *Tensor) MkldnnConvolution(weight *Tensor, bias *Tensor, padding []int6
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnConvolution(ptr, ts.ctensor, weight.ctensor, bias.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12377,10 +13939,12 @@ func MkldnnConvolutionBackwardInput(selfSize []int64, gradOutput *Tensor, weight
 		cbiasDefined = int32(1)
 	}
 	lib.AtgMkldnnConvolutionBackwardInput(ptr, selfSize, len(selfSize), gradOutput.ctensor, weight.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups, cbiasDefined)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12389,10 +13953,13 @@ func MkldnnLinear(input *Tensor, weight *Tensor, bias *Tensor) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnLinear(ptr, input.ctensor, weight.ctensor, bias.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12408,10 +13975,11 @@ func (ts *Tensor) MkldnnMaxPool2d(kernelSize []int64, stride []int64, padding []
 		cceilMode = int32(1)
 	}
 	lib.AtgMkldnnMaxPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12427,10 +13995,11 @@ func (ts *Tensor) MkldnnMaxPool3d(kernelSize []int64, stride []int64, padding []
 		cceilMode = int32(1)
 	}
 	lib.AtgMkldnnMaxPool3d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12442,10 +14011,11 @@ func (ts *Tensor) MkldnnReorderConv2dWeight(padding []int64, stride []int64, dil
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnReorderConv2dWeight(ptr, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12457,10 +14027,11 @@ func (ts *Tensor) MkldnnReorderConv3dWeight(padding []int64, stride []int64, dil
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMkldnnReorderConv3dWeight(ptr, ts.ctensor, padding, len(padding), stride, len(stride), dilation, len(dilation), groups)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12472,10 +14043,12 @@ func (ts *Tensor) Mm(mat2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMm(ptr, ts.ctensor, mat2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12487,10 +14060,13 @@ func (ts *Tensor) MmOut(out *Tensor, mat2 *Tensor, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMmOut(ptr, out.ctensor, ts.ctensor, mat2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12502,10 +14078,11 @@ func (ts *Tensor) Movedim(source []int64, destination []int64, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMovedim(ptr, ts.ctensor, source, len(source), destination, len(destination))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12517,10 +14094,11 @@ func (ts *Tensor) Movedim1(source int64, destination int64, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMovedim1(ptr, ts.ctensor, source, destination)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12532,10 +14110,12 @@ func (ts *Tensor) MseLoss(target *Tensor, reduction int64, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLoss(ptr, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12547,10 +14127,13 @@ func (ts *Tensor) MseLossBackward(gradOutput *Tensor, target *Tensor, reduction
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12562,10 +14145,14 @@ func (ts *Tensor) MseLossBackwardOut(gradInput *Tensor, gradOutput *Tensor, targ
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12577,10 +14164,13 @@ func (ts *Tensor) MseLossOut(out *Tensor, target *Tensor, reduction int64, del b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMseLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12592,10 +14182,12 @@ func (ts *Tensor) Mul(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12607,10 +14199,11 @@ func (ts *Tensor) Mul1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12619,6 +14212,8 @@ func (ts *Tensor) Mul_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -12630,6 +14225,7 @@ func (ts *Tensor) Mul1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMul1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -12644,10 +14240,13 @@ func (ts *Tensor) MulOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMulOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12659,10 +14258,14 @@ func (ts *Tensor) MultiMarginLossBackward(gradOutput *Tensor, target *Tensor, p
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiMarginLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, p.cscalar, margin.cscalar, weight.ctensor, reduction)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12674,10 +14277,15 @@ func (ts *Tensor) MultiMarginLossBackwardOut(gradInput *Tensor, gradOutput *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiMarginLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, p.cscalar, margin.cscalar, weight.ctensor, reduction)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12689,10 +14297,12 @@ func (ts *Tensor) MultilabelMarginLoss(target *Tensor, reduction int64, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLoss(ptr, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12704,10 +14314,14 @@ func (ts *Tensor) MultilabelMarginLossBackward(gradOutput *Tensor, target *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, isTarget.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(isTarget)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12719,10 +14333,15 @@ func (ts *Tensor) MultilabelMarginLossBackwardOut(gradInput *Tensor, gradOutput
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, isTarget.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(isTarget)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12734,10 +14353,13 @@ func (ts *Tensor) MultilabelMarginLossOut(out *Tensor, target *Tensor, reduction
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultilabelMarginLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12753,10 +14375,11 @@ func (ts *Tensor) Multinomial(numSamples int64, replacement bool, del bool) (ret
 		creplacement = int32(1)
 	}
 	lib.AtgMultinomial(ptr, ts.ctensor, numSamples, creplacement)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12772,10 +14395,12 @@ func (ts *Tensor) MultinomialOut(out *Tensor, numSamples int64, replacement bool
 		creplacement = int32(1)
 	}
 	lib.AtgMultinomialOut(ptr, out.ctensor, ts.ctensor, numSamples, creplacement)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12787,10 +14412,12 @@ func (ts *Tensor) Multiply(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12802,10 +14429,11 @@ func (ts *Tensor) Multiply1(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12814,6 +14442,8 @@ func (ts *Tensor) Multiply_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -12825,6 +14455,7 @@ func (ts *Tensor) Multiply1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiply1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -12839,10 +14470,13 @@ func (ts *Tensor) MultiplyOut(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMultiplyOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12854,10 +14488,12 @@ func (ts *Tensor) Mv(vec *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMv(ptr, ts.ctensor, vec.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12869,10 +14505,13 @@ func (ts *Tensor) MvOut(out *Tensor, vec *Tensor, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMvOut(ptr, out.ctensor, ts.ctensor, vec.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12884,10 +14523,11 @@ func (ts *Tensor) Mvlgamma(p int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMvlgamma(ptr, ts.ctensor, p)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12896,6 +14536,7 @@ func (ts *Tensor) Mvlgamma_(p int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgMvlgamma_(ptr, ts.ctensor, p)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -12920,10 +14561,11 @@ func (ts *Tensor) Nanquantile(q float64, dim []int64, keepdim bool, del bool) (r
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantile(ptr, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12945,10 +14587,12 @@ func (ts *Tensor) Nanquantile1(q *Tensor, dim []int64, keepdim bool, del bool) (
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantile1(ptr, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(q)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12970,10 +14614,12 @@ func (ts *Tensor) NanquantileOut(out *Tensor, q float64, dim []int64, keepdim bo
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantileOut(ptr, out.ctensor, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -12995,10 +14641,13 @@ func (ts *Tensor) NanquantileOut1(out *Tensor, q *Tensor, dim []int64, keepdim b
 		ckeepdim = int32(1)
 	}
 	lib.AtgNanquantileOut1(ptr, out.ctensor, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(q)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13010,10 +14659,11 @@ func (ts *Tensor) Nansum(dtype gotch.DType, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNansum(ptr, ts.ctensor, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13029,10 +14679,11 @@ func (ts *Tensor) Nansum1(dim []int64, keepdim bool, dtype gotch.DType, del bool
 		ckeepdim = int32(1)
 	}
 	lib.AtgNansum1(ptr, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13048,10 +14699,12 @@ func (ts *Tensor) NansumOut(out *Tensor, dim []int64, keepdim bool, dtype gotch.
 		ckeepdim = int32(1)
 	}
 	lib.AtgNansumOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13063,10 +14716,11 @@ func (ts *Tensor) Narrow(dim int64, start int64, length int64, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNarrow(ptr, ts.ctensor, dim, start, length)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13078,10 +14732,12 @@ func (ts *Tensor) Narrow1(dim int64, start *Tensor, length int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNarrow1(ptr, ts.ctensor, dim, start.ctensor, length)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(start)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13093,10 +14749,11 @@ func (ts *Tensor) NarrowCopy(dim int64, start int64, length int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNarrowCopy(ptr, ts.ctensor, dim, start, length)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13108,10 +14765,11 @@ func (ts *Tensor) NativeNorm(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNativeNorm(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13127,10 +14785,11 @@ func (ts *Tensor) NativeNorm1(p *Scalar, dim []int64, keepdim bool, dtype gotch.
 		ckeepdim = int32(1)
 	}
 	lib.AtgNativeNorm1(ptr, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13142,10 +14801,11 @@ func (ts *Tensor) Ne(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13157,10 +14817,12 @@ func (ts *Tensor) Ne1(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13169,6 +14831,7 @@ func (ts *Tensor) Ne_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13180,6 +14843,8 @@ func (ts *Tensor) Ne1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNe1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13194,10 +14859,12 @@ func (ts *Tensor) NeOut(out *Tensor, other *Scalar, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13209,10 +14876,13 @@ func (ts *Tensor) NeOut1(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13224,10 +14894,11 @@ func (ts *Tensor) Neg(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeg(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13236,6 +14907,7 @@ func (ts *Tensor) Neg_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNeg_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13250,10 +14922,12 @@ func (ts *Tensor) NegOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13265,10 +14939,11 @@ func (ts *Tensor) Negative(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegative(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13277,6 +14952,7 @@ func (ts *Tensor) Negative_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegative_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13291,10 +14967,12 @@ func (ts *Tensor) NegativeOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNegativeOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13306,10 +14984,11 @@ func (ts *Tensor) NewEmpty(size []int64, optionsKind gotch.DType, optionsDevice
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNewEmpty(ptr, ts.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13321,10 +15000,11 @@ func (ts *Tensor) NewFull(size []int64, fillValue *Scalar, optionsKind gotch.DTy
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNewFull(ptr, ts.ctensor, size, len(size), fillValue.cscalar, optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13336,10 +15016,11 @@ func (ts *Tensor) NewZeros(size []int64, optionsKind gotch.DType, optionsDevice
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNewZeros(ptr, ts.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13351,10 +15032,12 @@ func (ts *Tensor) Nextafter(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNextafter(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13363,6 +15046,8 @@ func (ts *Tensor) Nextafter_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNextafter_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13377,10 +15062,13 @@ func (ts *Tensor) NextafterOut(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNextafterOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13392,10 +15080,13 @@ func (ts *Tensor) NllLoss(target *Tensor, weight *Tensor, reduction int64, ignor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss(ptr, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13407,10 +15098,13 @@ func (ts *Tensor) NllLoss2d(target *Tensor, weight *Tensor, reduction int64, ign
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2d(ptr, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13422,10 +15116,15 @@ func (ts *Tensor) NllLoss2dBackward(gradOutput *Tensor, target *Tensor, weight *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2dBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(totalWeight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13437,10 +15136,16 @@ func (ts *Tensor) NllLoss2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, ta
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(totalWeight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13452,10 +15157,14 @@ func (ts *Tensor) NllLoss2dOut(out *Tensor, target *Tensor, weight *Tensor, redu
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLoss2dOut(ptr, out.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13467,10 +15176,15 @@ func (ts *Tensor) NllLossBackward(gradOutput *Tensor, target *Tensor, weight *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(totalWeight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13482,10 +15196,16 @@ func (ts *Tensor) NllLossBackwardOut(gradInput *Tensor, gradOutput *Tensor, targ
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex, totalWeight.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(totalWeight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13497,10 +15217,14 @@ func (ts *Tensor) NllLossOut(out *Tensor, target *Tensor, weight *Tensor, reduct
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNllLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, weight.ctensor, reduction, ignoreIndex)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13512,10 +15236,11 @@ func (ts *Tensor) Nonzero(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNonzero(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13527,10 +15252,12 @@ func (ts *Tensor) NonzeroOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNonzeroOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13542,10 +15269,11 @@ func (ts *Tensor) Norm(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNorm(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13557,10 +15285,11 @@ func (ts *Tensor) Norm1(p *Scalar, dtype gotch.DType, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNorm1(ptr, ts.ctensor, p.cscalar, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13576,10 +15305,11 @@ func (ts *Tensor) Norm2(p *Scalar, dim []int64, keepdim bool, del bool) (retVal
 		ckeepdim = int32(1)
 	}
 	lib.AtgNorm2(ptr, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13595,10 +15325,11 @@ func (ts *Tensor) Norm3(p *Scalar, dim []int64, keepdim bool, dtype gotch.DType,
 		ckeepdim = int32(1)
 	}
 	lib.AtgNorm3(ptr, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13607,10 +15338,11 @@ func NormExceptDim(v *Tensor, pow int64, dim int64) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormExceptDim(ptr, v.ctensor, pow, dim)
+	runtime.KeepAlive(v)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13626,10 +15358,12 @@ func (ts *Tensor) NormOut(out *Tensor, p *Scalar, dim []int64, keepdim bool, del
 		ckeepdim = int32(1)
 	}
 	lib.AtgNormOut(ptr, out.ctensor, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13645,10 +15379,12 @@ func (ts *Tensor) NormOut1(out *Tensor, p *Scalar, dim []int64, keepdim bool, dt
 		ckeepdim = int32(1)
 	}
 	lib.AtgNormOut1(ptr, out.ctensor, ts.ctensor, p.cscalar, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13657,6 +15393,7 @@ func (ts *Tensor) Normal_(mean float64, std float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormal_(ptr, ts.ctensor, mean, std)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13668,10 +15405,12 @@ func NormalOut(out *Tensor, mean *Tensor, std float64) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut(ptr, out.ctensor, mean.ctensor, std)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(mean)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13680,10 +15419,12 @@ func NormalOut1(out *Tensor, mean float64, std *Tensor) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut1(ptr, out.ctensor, mean, std.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(std)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13692,10 +15433,13 @@ func NormalOut2(out *Tensor, mean *Tensor, std *Tensor) (retVal *Tensor, err err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut2(ptr, out.ctensor, mean.ctensor, std.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(mean)
+	runtime.KeepAlive(std)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13704,10 +15448,11 @@ func NormalOut3(out *Tensor, mean float64, std float64, size []int64) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNormalOut3(ptr, out.ctensor, mean, std, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13719,10 +15464,11 @@ func (ts *Tensor) NotEqual(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13734,10 +15480,12 @@ func (ts *Tensor) NotEqual1(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13746,6 +15494,7 @@ func (ts *Tensor) NotEqual_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13757,6 +15506,8 @@ func (ts *Tensor) NotEqual1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqual1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -13771,10 +15522,12 @@ func (ts *Tensor) NotEqualOut(out *Tensor, other *Scalar, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqualOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13786,10 +15539,13 @@ func (ts *Tensor) NotEqualOut1(out *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNotEqualOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13805,10 +15561,11 @@ func (ts *Tensor) NuclearNorm(keepdim bool, del bool) (retVal *Tensor, err error
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNorm(ptr, ts.ctensor, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13824,10 +15581,11 @@ func (ts *Tensor) NuclearNorm1(dim []int64, keepdim bool, del bool) (retVal *Ten
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNorm1(ptr, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13843,10 +15601,12 @@ func (ts *Tensor) NuclearNormOut(out *Tensor, keepdim bool, del bool) (retVal *T
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNormOut(ptr, out.ctensor, ts.ctensor, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13862,10 +15622,12 @@ func (ts *Tensor) NuclearNormOut1(out *Tensor, dim []int64, keepdim bool, del bo
 		ckeepdim = int32(1)
 	}
 	lib.AtgNuclearNormOut1(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13877,10 +15639,11 @@ func (ts *Tensor) NumpyT(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgNumpyT(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13892,10 +15655,11 @@ func (ts *Tensor) OneHot(numClasses int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOneHot(ptr, ts.ctensor, numClasses)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13907,7 +15671,7 @@ func Ones(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (re
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13919,10 +15683,11 @@ func (ts *Tensor) OnesLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOnesLike(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13931,10 +15696,11 @@ func OnesOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOnesOut(ptr, out.ctensor, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13946,10 +15712,12 @@ func (ts *Tensor) Orgqr(input2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOrgqr(ptr, ts.ctensor, input2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(input2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13961,10 +15729,13 @@ func (ts *Tensor) OrgqrOut(out *Tensor, input2 *Tensor, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOrgqrOut(ptr, out.ctensor, ts.ctensor, input2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(input2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -13984,10 +15755,13 @@ func (ts *Tensor) Ormqr(input2 *Tensor, input3 *Tensor, left bool, transpose boo
 		ctranspose = int32(1)
 	}
 	lib.AtgOrmqr(ptr, ts.ctensor, input2.ctensor, input3.ctensor, cleft, ctranspose)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(input2)
+	runtime.KeepAlive(input3)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14007,10 +15781,14 @@ func (ts *Tensor) OrmqrOut(out *Tensor, input2 *Tensor, input3 *Tensor, left boo
 		ctranspose = int32(1)
 	}
 	lib.AtgOrmqrOut(ptr, out.ctensor, ts.ctensor, input2.ctensor, input3.ctensor, cleft, ctranspose)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(input2)
+	runtime.KeepAlive(input3)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14022,10 +15800,12 @@ func (ts *Tensor) Outer(vec2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOuter(ptr, ts.ctensor, vec2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14037,10 +15817,13 @@ func (ts *Tensor) OuterOut(out *Tensor, vec2 *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgOuterOut(ptr, out.ctensor, ts.ctensor, vec2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(vec2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14053,10 +15836,12 @@ func PairwiseDistance(x1 *Tensor, x2 *Tensor, p float64, eps float64, keepdim bo
 		ckeepdim = int32(1)
 	}
 	lib.AtgPairwiseDistance(ptr, x1.ctensor, x2.ctensor, p, eps, ckeepdim)
+	runtime.KeepAlive(x1)
+	runtime.KeepAlive(x2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14068,10 +15853,11 @@ func (ts *Tensor) Pdist(p float64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPdist(ptr, ts.ctensor, p)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14083,10 +15869,11 @@ func (ts *Tensor) Permute(dims []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPermute(ptr, ts.ctensor, dims, len(dims))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14098,10 +15885,11 @@ func (ts *Tensor) PinMemory(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPinMemory(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14113,10 +15901,11 @@ func (ts *Tensor) Pinverse(rcond float64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPinverse(ptr, ts.ctensor, rcond)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14128,10 +15917,11 @@ func (ts *Tensor) PixelShuffle(upscaleFactor int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPixelShuffle(ptr, ts.ctensor, upscaleFactor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14143,10 +15933,11 @@ func (ts *Tensor) Poisson(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPoisson(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14163,10 +15954,12 @@ func PoissonNllLoss(input *Tensor, target *Tensor, logInput bool, full bool, eps
 		cfull = int32(1)
 	}
 	lib.AtgPoissonNllLoss(ptr, input.ctensor, target.ctensor, clogInput, cfull, eps, reduction)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14175,10 +15968,12 @@ func Polar(abs *Tensor, angle *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolar(ptr, abs.ctensor, angle.ctensor)
+	runtime.KeepAlive(abs)
+	runtime.KeepAlive(angle)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14187,10 +15982,13 @@ func PolarOut(out *Tensor, abs *Tensor, angle *Tensor) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolarOut(ptr, out.ctensor, abs.ctensor, angle.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(abs)
+	runtime.KeepAlive(angle)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14202,10 +16000,11 @@ func (ts *Tensor) Polygamma(n int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolygamma(ptr, n, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14214,6 +16013,7 @@ func (ts *Tensor) Polygamma_(n int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolygamma_(ptr, ts.ctensor, n)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14228,10 +16028,12 @@ func (ts *Tensor) PolygammaOut(out *Tensor, n int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPolygammaOut(ptr, out.ctensor, n, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14243,10 +16045,11 @@ func (ts *Tensor) Pow(exponent *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow(ptr, ts.ctensor, exponent.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14258,10 +16061,12 @@ func (ts *Tensor) Pow1(exponent *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow1(ptr, ts.ctensor, exponent.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(exponent)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14270,10 +16075,11 @@ func Pow2(selfScalar *Scalar, exponent *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow2(ptr, selfScalar.cscalar, exponent.ctensor)
+	runtime.KeepAlive(exponent)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14282,6 +16088,7 @@ func (ts *Tensor) Pow_(exponent *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow_(ptr, ts.ctensor, exponent.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14293,6 +16100,8 @@ func (ts *Tensor) Pow1_(exponent *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPow1_(ptr, ts.ctensor, exponent.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(exponent)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14307,10 +16116,12 @@ func (ts *Tensor) PowOut(out *Tensor, exponent *Scalar, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPowOut(ptr, out.ctensor, ts.ctensor, exponent.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14322,10 +16133,13 @@ func (ts *Tensor) PowOut1(out *Tensor, exponent *Tensor, del bool) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPowOut1(ptr, out.ctensor, ts.ctensor, exponent.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(exponent)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14334,10 +16148,12 @@ func PowOut2(out *Tensor, selfScalar *Scalar, exponent *Tensor) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPowOut2(ptr, out.ctensor, selfScalar.cscalar, exponent.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(exponent)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14349,10 +16165,12 @@ func (ts *Tensor) Prelu(weight *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgPrelu(ptr, ts.ctensor, weight.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14364,10 +16182,11 @@ func (ts *Tensor) Prod(dtype gotch.DType, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgProd(ptr, ts.ctensor, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14383,10 +16202,11 @@ func (ts *Tensor) Prod1(dim int64, keepdim bool, dtype gotch.DType, del bool) (r
 		ckeepdim = int32(1)
 	}
 	lib.AtgProd1(ptr, ts.ctensor, dim, ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14402,10 +16222,12 @@ func (ts *Tensor) ProdOut(out *Tensor, dim int64, keepdim bool, dtype gotch.DTyp
 		ckeepdim = int32(1)
 	}
 	lib.AtgProdOut(ptr, out.ctensor, ts.ctensor, dim, ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14418,6 +16240,9 @@ func (ts *Tensor) Put_(index *Tensor, source *Tensor, accumulate bool) (err erro
 		caccumulate = int32(1)
 	}
 	lib.AtgPut_(ptr, ts.ctensor, index.ctensor, source.ctensor, caccumulate)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14432,10 +16257,11 @@ func (ts *Tensor) QPerChannelScales(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQPerChannelScales(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14447,10 +16273,11 @@ func (ts *Tensor) QPerChannelZeroPoints(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQPerChannelZeroPoints(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14472,10 +16299,11 @@ func (ts *Tensor) Quantile(q float64, dim []int64, keepdim bool, del bool) (retV
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantile(ptr, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14497,10 +16325,12 @@ func (ts *Tensor) Quantile1(q *Tensor, dim []int64, keepdim bool, del bool) (ret
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantile1(ptr, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(q)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14522,10 +16352,12 @@ func (ts *Tensor) QuantileOut(out *Tensor, q float64, dim []int64, keepdim bool,
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantileOut(ptr, out.ctensor, ts.ctensor, q, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14547,10 +16379,13 @@ func (ts *Tensor) QuantileOut1(out *Tensor, q *Tensor, dim []int64, keepdim bool
 		ckeepdim = int32(1)
 	}
 	lib.AtgQuantileOut1(ptr, out.ctensor, ts.ctensor, q.ctensor, cdimVal, cdimNull, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(q)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14562,10 +16397,13 @@ func (ts *Tensor) QuantizePerChannel(scales *Tensor, zeroPoints *Tensor, axis in
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizePerChannel(ptr, ts.ctensor, scales.ctensor, zeroPoints.ctensor, axis, dtype.CInt())
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(scales)
+	runtime.KeepAlive(zeroPoints)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14577,10 +16415,11 @@ func (ts *Tensor) QuantizePerTensor(scale float64, zeroPoint int64, dtype gotch.
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizePerTensor(ptr, ts.ctensor, scale, zeroPoint, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14589,10 +16428,15 @@ func QuantizedBatchNorm(input *Tensor, weight *Tensor, bias *Tensor, mean *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedBatchNorm(ptr, input.ctensor, weight.ctensor, bias.ctensor, mean.ctensor, vari.ctensor, eps, outputScale, outputZeroPoint)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
+	runtime.KeepAlive(mean)
+	runtime.KeepAlive(vari)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14601,10 +16445,20 @@ func QuantizedGruCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedGruCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor, packedIh.ctensor, packedHh.ctensor, colOffsetsIh.ctensor, colOffsetsHh.ctensor, scaleIh.cscalar, scaleHh.cscalar, zeroPointIh.cscalar, zeroPointHh.cscalar)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(wIh)
+	runtime.KeepAlive(wHh)
+	runtime.KeepAlive(bIh)
+	runtime.KeepAlive(bHh)
+	runtime.KeepAlive(packedIh)
+	runtime.KeepAlive(packedHh)
+	runtime.KeepAlive(colOffsetsIh)
+	runtime.KeepAlive(colOffsetsHh)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14620,10 +16474,11 @@ func (ts *Tensor) QuantizedMaxPool1d(kernelSize []int64, stride []int64, padding
 		cceilMode = int32(1)
 	}
 	lib.AtgQuantizedMaxPool1d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14639,10 +16494,11 @@ func (ts *Tensor) QuantizedMaxPool2d(kernelSize []int64, stride []int64, padding
 		cceilMode = int32(1)
 	}
 	lib.AtgQuantizedMaxPool2d(ptr, ts.ctensor, kernelSize, len(kernelSize), stride, len(stride), padding, len(padding), dilation, len(dilation), cceilMode)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14651,10 +16507,20 @@ func QuantizedRnnReluCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedRnnReluCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor, packedIh.ctensor, packedHh.ctensor, colOffsetsIh.ctensor, colOffsetsHh.ctensor, scaleIh.cscalar, scaleHh.cscalar, zeroPointIh.cscalar, zeroPointHh.cscalar)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(wIh)
+	runtime.KeepAlive(wHh)
+	runtime.KeepAlive(bIh)
+	runtime.KeepAlive(bHh)
+	runtime.KeepAlive(packedIh)
+	runtime.KeepAlive(packedHh)
+	runtime.KeepAlive(colOffsetsIh)
+	runtime.KeepAlive(colOffsetsHh)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14663,10 +16529,20 @@ func QuantizedRnnTanhCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgQuantizedRnnTanhCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor, packedIh.ctensor, packedHh.ctensor, colOffsetsIh.ctensor, colOffsetsHh.ctensor, scaleIh.cscalar, scaleHh.cscalar, zeroPointIh.cscalar, zeroPointHh.cscalar)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(wIh)
+	runtime.KeepAlive(wHh)
+	runtime.KeepAlive(bIh)
+	runtime.KeepAlive(bHh)
+	runtime.KeepAlive(packedIh)
+	runtime.KeepAlive(packedHh)
+	runtime.KeepAlive(colOffsetsIh)
+	runtime.KeepAlive(colOffsetsHh)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14678,10 +16554,11 @@ func (ts *Tensor) Rad2deg(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRad2deg(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14690,6 +16567,7 @@ func (ts *Tensor) Rad2deg_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRad2deg_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14704,10 +16582,12 @@ func (ts *Tensor) Rad2degOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRad2degOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14719,7 +16599,7 @@ func Rand(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (re
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14731,10 +16611,11 @@ func (ts *Tensor) RandLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandLike(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14743,10 +16624,11 @@ func RandOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandOut(ptr, out.ctensor, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14758,7 +16640,7 @@ func Randint(high int64, size []int64, optionsKind gotch.DType, optionsDevice go
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14770,7 +16652,7 @@ func Randint1(low int64, high int64, size []int64, optionsKind gotch.DType, opti
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14782,10 +16664,11 @@ func (ts *Tensor) RandintLike(high int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintLike(ptr, ts.ctensor, high)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14797,10 +16680,11 @@ func (ts *Tensor) RandintLike1(low int64, high int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintLike1(ptr, ts.ctensor, low, high)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14809,10 +16693,11 @@ func RandintOut(out *Tensor, high int64, size []int64) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintOut(ptr, out.ctensor, high, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14821,10 +16706,11 @@ func RandintOut1(out *Tensor, low int64, high int64, size []int64) (retVal *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandintOut1(ptr, out.ctensor, low, high, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14836,7 +16722,7 @@ func Randn(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14848,10 +16734,11 @@ func (ts *Tensor) RandnLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandnLike(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14860,10 +16747,11 @@ func RandnOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandnOut(ptr, out.ctensor, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14872,6 +16760,7 @@ func (ts *Tensor) Random_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandom_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14883,6 +16772,7 @@ func (ts *Tensor) Random1_(to int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandom1_(ptr, ts.ctensor, to)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14900,6 +16790,7 @@ func (ts *Tensor) Random2(from int64, to []int64) (err error) {
 		ctoNull = 0
 	}
 	lib.AtgRandom2(ptr, ts.ctensor, from, ctoVal, ctoNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -14914,7 +16805,7 @@ func Randperm(n int64, optionsKind gotch.DType, optionsDevice gotch.Device) (ret
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14923,10 +16814,11 @@ func RandpermOut(out *Tensor, n int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRandpermOut(ptr, out.ctensor, n)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14938,7 +16830,7 @@ func Range(start *Scalar, end *Scalar, optionsKind gotch.DType, optionsDevice go
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14950,7 +16842,7 @@ func Range1(start *Scalar, end *Scalar, optionsKind gotch.DType, optionsDevice g
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14959,10 +16851,11 @@ func RangeOut(out *Tensor, start *Scalar, end *Scalar) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRangeOut(ptr, out.ctensor, start.cscalar, end.cscalar)
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14974,10 +16867,11 @@ func (ts *Tensor) Real(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReal(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -14989,10 +16883,11 @@ func (ts *Tensor) Reciprocal(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReciprocal(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15001,6 +16896,7 @@ func (ts *Tensor) Reciprocal_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReciprocal_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15015,10 +16911,12 @@ func (ts *Tensor) ReciprocalOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReciprocalOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15030,10 +16928,11 @@ func (ts *Tensor) ReflectionPad1d(padding []int64, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1d(ptr, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15045,10 +16944,12 @@ func (ts *Tensor) ReflectionPad1dBackward(gradOutput *Tensor, padding []int64, d
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15060,10 +16961,13 @@ func (ts *Tensor) ReflectionPad1dBackwardOut(gradInput *Tensor, gradOutput *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15075,10 +16979,12 @@ func (ts *Tensor) ReflectionPad1dOut(out *Tensor, padding []int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad1dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15090,10 +16996,11 @@ func (ts *Tensor) ReflectionPad2d(padding []int64, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2d(ptr, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15105,10 +17012,12 @@ func (ts *Tensor) ReflectionPad2dBackward(gradOutput *Tensor, padding []int64, d
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15120,10 +17029,13 @@ func (ts *Tensor) ReflectionPad2dBackwardOut(gradInput *Tensor, gradOutput *Tens
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15135,10 +17047,12 @@ func (ts *Tensor) ReflectionPad2dOut(out *Tensor, padding []int64, del bool) (re
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReflectionPad2dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15150,10 +17064,11 @@ func (ts *Tensor) Relu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRelu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15162,6 +17077,7 @@ func (ts *Tensor) Relu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRelu_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15176,10 +17092,11 @@ func (ts *Tensor) Remainder(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15191,10 +17108,12 @@ func (ts *Tensor) Remainder1(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder1(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15203,6 +17122,7 @@ func (ts *Tensor) Remainder_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15214,6 +17134,8 @@ func (ts *Tensor) Remainder1_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainder1_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15228,10 +17150,12 @@ func (ts *Tensor) RemainderOut(out *Tensor, other *Scalar, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainderOut(ptr, out.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15243,10 +17167,13 @@ func (ts *Tensor) RemainderOut1(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRemainderOut1(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15258,10 +17185,11 @@ func (ts *Tensor) Renorm(p *Scalar, dim int64, maxnorm *Scalar, del bool) (retVa
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRenorm(ptr, ts.ctensor, p.cscalar, dim, maxnorm.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15270,6 +17198,7 @@ func (ts *Tensor) Renorm_(p *Scalar, dim int64, maxnorm *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRenorm_(ptr, ts.ctensor, p.cscalar, dim, maxnorm.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15284,10 +17213,12 @@ func (ts *Tensor) RenormOut(out *Tensor, p *Scalar, dim int64, maxnorm *Scalar,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRenormOut(ptr, out.ctensor, ts.ctensor, p.cscalar, dim, maxnorm.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15299,10 +17230,11 @@ func (ts *Tensor) Repeat(repeats []int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRepeat(ptr, ts.ctensor, repeats, len(repeats))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15311,10 +17243,11 @@ func RepeatInterleave(repeats *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRepeatInterleave(ptr, repeats.ctensor)
+	runtime.KeepAlive(repeats)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15332,10 +17265,12 @@ func (ts *Tensor) RepeatInterleave1(repeats *Tensor, dim []int64, del bool) (ret
 		cdimNull = 0
 	}
 	lib.AtgRepeatInterleave1(ptr, ts.ctensor, repeats.ctensor, cdimVal, cdimNull)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(repeats)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15353,10 +17288,11 @@ func (ts *Tensor) RepeatInterleave2(repeats int64, dim []int64, del bool) (retVa
 		cdimNull = 0
 	}
 	lib.AtgRepeatInterleave2(ptr, ts.ctensor, repeats, cdimVal, cdimNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15368,10 +17304,11 @@ func (ts *Tensor) ReplicationPad1d(padding []int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1d(ptr, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15383,10 +17320,12 @@ func (ts *Tensor) ReplicationPad1dBackward(gradOutput *Tensor, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15398,10 +17337,13 @@ func (ts *Tensor) ReplicationPad1dBackwardOut(gradInput *Tensor, gradOutput *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15413,10 +17355,12 @@ func (ts *Tensor) ReplicationPad1dOut(out *Tensor, padding []int64, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad1dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15428,10 +17372,11 @@ func (ts *Tensor) ReplicationPad2d(padding []int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2d(ptr, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15443,10 +17388,12 @@ func (ts *Tensor) ReplicationPad2dBackward(gradOutput *Tensor, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15458,10 +17405,13 @@ func (ts *Tensor) ReplicationPad2dBackwardOut(gradInput *Tensor, gradOutput *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15473,10 +17423,12 @@ func (ts *Tensor) ReplicationPad2dOut(out *Tensor, padding []int64, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad2dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15488,10 +17440,11 @@ func (ts *Tensor) ReplicationPad3d(padding []int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3d(ptr, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15503,10 +17456,12 @@ func (ts *Tensor) ReplicationPad3dBackward(gradOutput *Tensor, padding []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3dBackward(ptr, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15518,10 +17473,13 @@ func (ts *Tensor) ReplicationPad3dBackwardOut(gradInput *Tensor, gradOutput *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15533,10 +17491,12 @@ func (ts *Tensor) ReplicationPad3dOut(out *Tensor, padding []int64, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReplicationPad3dOut(ptr, out.ctensor, ts.ctensor, padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15549,6 +17509,7 @@ func (ts *Tensor) RequiresGrad_(requiresGrad bool) (err error) {
 		crequiresGrad = int32(1)
 	}
 	lib.AtgRequiresGrad_(ptr, ts.ctensor, crequiresGrad)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15563,10 +17524,11 @@ func (ts *Tensor) Reshape(shape []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReshape(ptr, ts.ctensor, shape, len(shape))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15578,10 +17540,12 @@ func (ts *Tensor) ReshapeAs(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgReshapeAs(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15590,6 +17554,7 @@ func (ts *Tensor) Resize_(size []int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgResize_(ptr, ts.ctensor, size, len(size))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15601,6 +17566,8 @@ func (ts *Tensor) ResizeAs_(theTemplate *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgResizeAs_(ptr, ts.ctensor, theTemplate.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(theTemplate)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15623,10 +17590,11 @@ func (ts *Tensor) Rfft(signalNdim int64, normalized bool, onesided bool, del boo
 		conesided = int32(1)
 	}
 	lib.AtgRfft(ptr, ts.ctensor, signalNdim, cnormalized, conesided)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15635,10 +17603,16 @@ func RnnReluCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRnnReluCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(wIh)
+	runtime.KeepAlive(wHh)
+	runtime.KeepAlive(bIh)
+	runtime.KeepAlive(bHh)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15647,10 +17621,16 @@ func RnnTanhCell(input *Tensor, hx *Tensor, wIh *Tensor, wHh *Tensor, bIh *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRnnTanhCell(ptr, input.ctensor, hx.ctensor, wIh.ctensor, wHh.ctensor, bIh.ctensor, bHh.ctensor)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(hx)
+	runtime.KeepAlive(wIh)
+	runtime.KeepAlive(wHh)
+	runtime.KeepAlive(bIh)
+	runtime.KeepAlive(bHh)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15662,10 +17642,11 @@ func (ts *Tensor) Roll(shifts []int64, dims []int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRoll(ptr, ts.ctensor, shifts, len(shifts), dims, len(dims))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15677,10 +17658,11 @@ func (ts *Tensor) Rot90(k int64, dims []int64, del bool) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRot90(ptr, ts.ctensor, k, dims, len(dims))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15692,10 +17674,11 @@ func (ts *Tensor) Round(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRound(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15704,6 +17687,7 @@ func (ts *Tensor) Round_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRound_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15718,10 +17702,12 @@ func (ts *Tensor) RoundOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRoundOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15737,10 +17723,11 @@ func (ts *Tensor) Rrelu(training bool, del bool) (retVal *Tensor, err error) {
 		ctraining = int32(1)
 	}
 	lib.AtgRrelu(ptr, ts.ctensor, ctraining)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15753,6 +17740,7 @@ func (ts *Tensor) Rrelu_(training bool) (err error) {
 		ctraining = int32(1)
 	}
 	lib.AtgRrelu_(ptr, ts.ctensor, ctraining)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15771,10 +17759,12 @@ func (ts *Tensor) RreluWithNoise(noise *Tensor, training bool, del bool) (retVal
 		ctraining = int32(1)
 	}
 	lib.AtgRreluWithNoise(ptr, ts.ctensor, noise.ctensor, ctraining)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(noise)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15787,6 +17777,8 @@ func (ts *Tensor) RreluWithNoise_(noise *Tensor, training bool) (err error) {
 		ctraining = int32(1)
 	}
 	lib.AtgRreluWithNoise_(ptr, ts.ctensor, noise.ctensor, ctraining)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(noise)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15809,10 +17801,13 @@ func (ts *Tensor) RreluWithNoiseBackward(gradOutput *Tensor, noise *Tensor, lowe
 		cselfIsResult = int32(1)
 	}
 	lib.AtgRreluWithNoiseBackward(ptr, gradOutput.ctensor, ts.ctensor, noise.ctensor, lower.cscalar, upper.cscalar, ctraining, cselfIsResult)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(noise)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15828,10 +17823,13 @@ func (ts *Tensor) RreluWithNoiseOut(out *Tensor, noise *Tensor, training bool, d
 		ctraining = int32(1)
 	}
 	lib.AtgRreluWithNoiseOut(ptr, out.ctensor, ts.ctensor, noise.ctensor, ctraining)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(noise)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15843,10 +17841,11 @@ func (ts *Tensor) Rsqrt(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsqrt(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15855,6 +17854,7 @@ func (ts *Tensor) Rsqrt_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsqrt_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15869,10 +17869,12 @@ func (ts *Tensor) RsqrtOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsqrtOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15884,10 +17886,12 @@ func (ts *Tensor) Rsub(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsub(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15899,10 +17903,11 @@ func (ts *Tensor) Rsub1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgRsub1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15914,7 +17919,7 @@ func ScalarTensor(s *Scalar, optionsKind gotch.DType, optionsDevice gotch.Device
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15926,10 +17931,13 @@ func (ts *Tensor) Scatter(dim int64, index *Tensor, src *Tensor, del bool) (retV
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(src)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15941,10 +17949,12 @@ func (ts *Tensor) Scatter1(dim int64, index *Tensor, value *Scalar, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter1(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -15953,6 +17963,9 @@ func (ts *Tensor) Scatter_(dim int64, index *Tensor, src *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter_(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(src)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15964,6 +17977,8 @@ func (ts *Tensor) Scatter1_(dim int64, index *Tensor, value *Scalar) (err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter1_(ptr, ts.ctensor, dim, index.ctensor, value.cscalar)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15975,6 +17990,9 @@ func (ts *Tensor) Scatter2(dim int64, index *Tensor, src *Tensor, reduce string)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter2(ptr, ts.ctensor, dim, index.ctensor, src.ctensor, reduce)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(src)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -15986,6 +18004,8 @@ func (ts *Tensor) Scatter3(dim int64, index *Tensor, value *Scalar, reduce strin
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatter3(ptr, ts.ctensor, dim, index.ctensor, value.cscalar, reduce)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16000,10 +18020,13 @@ func (ts *Tensor) ScatterAdd(dim int64, index *Tensor, src *Tensor, del bool) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatterAdd(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(src)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16012,6 +18035,9 @@ func (ts *Tensor) ScatterAdd_(dim int64, index *Tensor, src *Tensor) (err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgScatterAdd_(ptr, ts.ctensor, dim, index.ctensor, src.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
+	runtime.KeepAlive(src)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16034,10 +18060,12 @@ func (ts *Tensor) Searchsorted(sortedSequence *Tensor, outInt32 bool, right bool
 		cright = int32(1)
 	}
 	lib.AtgSearchsorted(ptr, sortedSequence.ctensor, ts.ctensor, coutInt32, cright)
+	runtime.KeepAlive(sortedSequence)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16054,10 +18082,11 @@ func Searchsorted1(sortedSequence *Tensor, selfScalar *Scalar, outInt32 bool, ri
 		cright = int32(1)
 	}
 	lib.AtgSearchsorted1(ptr, sortedSequence.ctensor, selfScalar.cscalar, coutInt32, cright)
+	runtime.KeepAlive(sortedSequence)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16077,10 +18106,13 @@ func (ts *Tensor) SearchsortedOut(out *Tensor, sortedSequence *Tensor, outInt32
 		cright = int32(1)
 	}
 	lib.AtgSearchsortedOut(ptr, out.ctensor, sortedSequence.ctensor, ts.ctensor, coutInt32, cright)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(sortedSequence)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16092,10 +18124,11 @@ func (ts *Tensor) Select(dim int64, index int64, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelect(ptr, ts.ctensor, dim, index)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16104,10 +18137,11 @@ func SelectBackward(grad *Tensor, inputSizes []int64, dim int64, index int64) (r
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelectBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), dim, index)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16119,10 +18153,11 @@ func (ts *Tensor) Selu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16131,6 +18166,7 @@ func (ts *Tensor) Selu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSelu_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16142,6 +18178,7 @@ func (ts *Tensor) Set_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSet_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16153,6 +18190,8 @@ func (ts *Tensor) Set1_(source *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSet1_(ptr, ts.ctensor, source.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(source)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16171,10 +18210,11 @@ func (ts *Tensor) SetRequiresGrad(r bool, del bool) (retVal *Tensor, err error)
 		cr = int32(1)
 	}
 	lib.AtgSetRequiresGrad(ptr, ts.ctensor, cr)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16186,10 +18226,11 @@ func (ts *Tensor) Sgn(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSgn(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16198,6 +18239,7 @@ func (ts *Tensor) Sgn_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSgn_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16212,10 +18254,12 @@ func (ts *Tensor) SgnOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSgnOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16227,10 +18271,11 @@ func (ts *Tensor) Sigmoid(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoid(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16239,6 +18284,7 @@ func (ts *Tensor) Sigmoid_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoid_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16250,10 +18296,12 @@ func SigmoidBackward(gradOutput *Tensor, output *Tensor) (retVal *Tensor, err er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoidBackward(ptr, gradOutput.ctensor, output.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16262,10 +18310,13 @@ func SigmoidBackwardOut(gradInput *Tensor, gradOutput *Tensor, output *Tensor) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoidBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, output.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16277,10 +18328,12 @@ func (ts *Tensor) SigmoidOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSigmoidOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16292,10 +18345,11 @@ func (ts *Tensor) Sign(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSign(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16304,6 +18358,7 @@ func (ts *Tensor) Sign_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSign_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16318,10 +18373,12 @@ func (ts *Tensor) SignOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSignOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16333,10 +18390,11 @@ func (ts *Tensor) Signbit(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSignbit(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16348,10 +18406,12 @@ func (ts *Tensor) SignbitOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSignbitOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16363,10 +18423,11 @@ func (ts *Tensor) Silu(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSilu(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16375,6 +18436,7 @@ func (ts *Tensor) Silu_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSilu_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16389,10 +18451,12 @@ func (ts *Tensor) SiluBackward(gradOutput *Tensor, del bool) (retVal *Tensor, er
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSiluBackward(ptr, gradOutput.ctensor, ts.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16404,10 +18468,12 @@ func (ts *Tensor) SiluOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSiluOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16419,10 +18485,11 @@ func (ts *Tensor) Sin(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSin(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16431,6 +18498,7 @@ func (ts *Tensor) Sin_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSin_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16445,10 +18513,12 @@ func (ts *Tensor) SinOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16460,10 +18530,11 @@ func (ts *Tensor) Sinh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16472,6 +18543,7 @@ func (ts *Tensor) Sinh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16486,10 +18558,12 @@ func (ts *Tensor) SinhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSinhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16501,10 +18575,11 @@ func (ts *Tensor) Slice(dim int64, start int64, end int64, step int64, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlice(ptr, ts.ctensor, dim, start, end, step)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16513,10 +18588,11 @@ func SliceBackward(grad *Tensor, inputSizes []int64, dim int64, start int64, end
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSliceBackward(ptr, grad.ctensor, inputSizes, len(inputSizes), dim, start, end, step)
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16528,10 +18604,13 @@ func (ts *Tensor) SlowConv3d(weight *Tensor, kernelSize []int64, bias *Tensor, s
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConv3d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16543,10 +18622,14 @@ func (ts *Tensor) SlowConv3dOut(out *Tensor, weight *Tensor, kernelSize []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConv3dOut(ptr, out.ctensor, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16558,10 +18641,13 @@ func (ts *Tensor) SlowConvDilated2d(weight *Tensor, kernelSize []int64, bias *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvDilated2d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16573,10 +18659,13 @@ func (ts *Tensor) SlowConvDilated3d(weight *Tensor, kernelSize []int64, bias *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvDilated3d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), dilation, len(dilation))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16588,10 +18677,13 @@ func (ts *Tensor) SlowConvTranspose2d(weight *Tensor, kernelSize []int64, bias *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose2d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16603,10 +18695,14 @@ func (ts *Tensor) SlowConvTranspose2dOut(out *Tensor, weight *Tensor, kernelSize
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose2dOut(ptr, out.ctensor, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16618,10 +18714,13 @@ func (ts *Tensor) SlowConvTranspose3d(weight *Tensor, kernelSize []int64, bias *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose3d(ptr, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16633,10 +18732,14 @@ func (ts *Tensor) SlowConvTranspose3dOut(out *Tensor, weight *Tensor, kernelSize
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSlowConvTranspose3dOut(ptr, out.ctensor, ts.ctensor, weight.ctensor, kernelSize, len(kernelSize), bias.ctensor, stride, len(stride), padding, len(padding), outputPadding, len(outputPadding), dilation, len(dilation))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(weight)
+	runtime.KeepAlive(bias)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16648,10 +18751,12 @@ func (ts *Tensor) Smm(mat2 *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmm(ptr, ts.ctensor, mat2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16663,10 +18768,12 @@ func (ts *Tensor) SmoothL1Loss(target *Tensor, reduction int64, beta float64, de
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1Loss(ptr, ts.ctensor, target.ctensor, reduction, beta)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16678,10 +18785,13 @@ func (ts *Tensor) SmoothL1LossBackward(gradOutput *Tensor, target *Tensor, reduc
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1LossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, beta)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16693,10 +18803,14 @@ func (ts *Tensor) SmoothL1LossBackwardOut(gradInput *Tensor, gradOutput *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1LossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction, beta)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16708,10 +18822,13 @@ func (ts *Tensor) SmoothL1LossOut(out *Tensor, target *Tensor, reduction int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSmoothL1LossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction, beta)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16723,10 +18840,12 @@ func (ts *Tensor) SoftMarginLoss(target *Tensor, reduction int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLoss(ptr, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16738,10 +18857,13 @@ func (ts *Tensor) SoftMarginLossBackward(gradOutput *Tensor, target *Tensor, red
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLossBackward(ptr, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16753,10 +18875,14 @@ func (ts *Tensor) SoftMarginLossBackwardOut(gradInput *Tensor, gradOutput *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLossBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16768,10 +18894,13 @@ func (ts *Tensor) SoftMarginLossOut(out *Tensor, target *Tensor, reduction int64
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftMarginLossOut(ptr, out.ctensor, ts.ctensor, target.ctensor, reduction)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(target)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16783,10 +18912,11 @@ func (ts *Tensor) Softmax(dim int64, dtype gotch.DType, del bool) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftmax(ptr, ts.ctensor, dim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16798,10 +18928,11 @@ func (ts *Tensor) Softplus(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplus(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16813,10 +18944,13 @@ func (ts *Tensor) SoftplusBackward(gradOutput *Tensor, beta *Scalar, threshold *
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplusBackward(ptr, gradOutput.ctensor, ts.ctensor, beta.cscalar, threshold.cscalar, output.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16828,10 +18962,14 @@ func (ts *Tensor) SoftplusBackwardOut(gradInput *Tensor, gradOutput *Tensor, bet
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplusBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, beta.cscalar, threshold.cscalar, output.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16843,10 +18981,12 @@ func (ts *Tensor) SoftplusOut(out *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftplusOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16858,10 +18998,11 @@ func (ts *Tensor) Softshrink(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrink(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16873,10 +19014,12 @@ func (ts *Tensor) SoftshrinkBackward(gradOutput *Tensor, lambd *Scalar, del bool
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrinkBackward(ptr, gradOutput.ctensor, ts.ctensor, lambd.cscalar)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16888,10 +19031,13 @@ func (ts *Tensor) SoftshrinkBackwardOut(gradInput *Tensor, gradOutput *Tensor, l
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrinkBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, ts.ctensor, lambd.cscalar)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16903,10 +19049,12 @@ func (ts *Tensor) SoftshrinkOut(out *Tensor, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSoftshrinkOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16918,7 +19066,7 @@ func SparseCooTensor(size []int64, optionsKind gotch.DType, optionsDevice gotch.
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16927,10 +19075,12 @@ func SparseCooTensor1(indices *Tensor, values *Tensor, optionsKind gotch.DType,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseCooTensor1(ptr, indices.ctensor, values.ctensor, optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16939,10 +19089,12 @@ func SparseCooTensor2(indices *Tensor, values *Tensor, size []int64, optionsKind
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseCooTensor2(ptr, indices.ctensor, values.ctensor, size, len(size), optionsKind.CInt(), optionsDevice.CInt())
+	runtime.KeepAlive(indices)
+	runtime.KeepAlive(values)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16954,10 +19106,12 @@ func (ts *Tensor) SparseMask(mask *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseMask(ptr, ts.ctensor, mask.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mask)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -16966,6 +19120,7 @@ func (ts *Tensor) SparseResize_(size []int64, sparseDim int64, denseDim int64) (
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseResize_(ptr, ts.ctensor, size, len(size), sparseDim, denseDim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16977,6 +19132,7 @@ func (ts *Tensor) SparseResizeAndClear_(size []int64, sparseDim int64, denseDim
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSparseResizeAndClear_(ptr, ts.ctensor, size, len(size), sparseDim, denseDim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -16991,10 +19147,11 @@ func (ts *Tensor) Sqrt(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqrt(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17003,6 +19160,7 @@ func (ts *Tensor) Sqrt_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqrt_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17017,10 +19175,12 @@ func (ts *Tensor) SqrtOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqrtOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17032,10 +19192,11 @@ func (ts *Tensor) Square(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSquare(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17044,6 +19205,7 @@ func (ts *Tensor) Square_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSquare_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17058,10 +19220,11 @@ func (ts *Tensor) Squeeze(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17073,10 +19236,11 @@ func (ts *Tensor) Squeeze1(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze1(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17085,6 +19249,7 @@ func (ts *Tensor) Squeeze_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17096,6 +19261,7 @@ func (ts *Tensor) Squeeze1_(dim int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSqueeze1_(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17110,10 +19276,13 @@ func (ts *Tensor) Sspaddmm(mat1 *Tensor, mat2 *Tensor, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSspaddmm(ptr, ts.ctensor, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17125,15 +19294,23 @@ func (ts *Tensor) SspaddmmOut(out *Tensor, mat1 *Tensor, mat2 *Tensor, del bool)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSspaddmmOut(ptr, out.ctensor, ts.ctensor, mat1.ctensor, mat2.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(mat1)
+	runtime.KeepAlive(mat2)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func Stack(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("Stack() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -17141,15 +19318,20 @@ func Stack(tensors []Tensor, dim int64) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgStack(ptr, ctensors, len(ctensors), dim)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func StackOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("StackOut() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -17157,10 +19339,12 @@ func StackOut(out *Tensor, tensors []Tensor, dim int64) (retVal *Tensor, err err
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgStackOut(ptr, out.ctensor, ctensors, len(ctensors), dim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17176,10 +19360,11 @@ func (ts *Tensor) Std(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.AtgStd(ptr, ts.ctensor, cunbiased)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17199,10 +19384,11 @@ func (ts *Tensor) Std1(dim []int64, unbiased bool, keepdim bool, del bool) (retV
 		ckeepdim = int32(1)
 	}
 	lib.AtgStd1(ptr, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17222,10 +19408,12 @@ func (ts *Tensor) StdOut(out *Tensor, dim []int64, unbiased bool, keepdim bool,
 		ckeepdim = int32(1)
 	}
 	lib.AtgStdOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17261,10 +19449,12 @@ func (ts *Tensor) Stft(nFft int64, hopLength []int64, winLength []int64, window
 		creturnComplex = int32(1)
 	}
 	lib.AtgStft(ptr, ts.ctensor, nFft, chopLengthVal, chopLengthNull, cwinLengthVal, cwinLengthNull, window.ctensor, cnormalized, conesided, creturnComplex)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(window)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17276,10 +19466,12 @@ func (ts *Tensor) Sub(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17291,10 +19483,11 @@ func (ts *Tensor) Sub1(other *Scalar, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17303,6 +19496,8 @@ func (ts *Tensor) Sub_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17314,6 +19509,7 @@ func (ts *Tensor) Sub1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSub1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17328,10 +19524,13 @@ func (ts *Tensor) SubOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17343,10 +19542,12 @@ func (ts *Tensor) Subtract(other *Tensor, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17358,10 +19559,11 @@ func (ts *Tensor) Subtract1(other *Scalar, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17370,6 +19572,8 @@ func (ts *Tensor) Subtract_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17381,6 +19585,7 @@ func (ts *Tensor) Subtract1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtract1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17395,10 +19600,13 @@ func (ts *Tensor) SubtractOut(out *Tensor, other *Tensor, del bool) (retVal *Ten
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSubtractOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17410,10 +19618,11 @@ func (ts *Tensor) Sum(dtype gotch.DType, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSum(ptr, ts.ctensor, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17429,10 +19638,11 @@ func (ts *Tensor) Sum1(dim []int64, keepdim bool, dtype gotch.DType, del bool) (
 		ckeepdim = int32(1)
 	}
 	lib.AtgSum1(ptr, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17448,10 +19658,12 @@ func (ts *Tensor) SumOut(out *Tensor, dim []int64, keepdim bool, dtype gotch.DTy
 		ckeepdim = int32(1)
 	}
 	lib.AtgSumOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), ckeepdim, dtype.CInt())
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17463,10 +19675,11 @@ func (ts *Tensor) SumToSize(size []int64, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgSumToSize(ptr, ts.ctensor, size, len(size))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17478,10 +19691,11 @@ func (ts *Tensor) T(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgT(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17490,6 +19704,7 @@ func (ts *Tensor) T_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgT_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17504,10 +19719,12 @@ func (ts *Tensor) Take(index *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTake(ptr, ts.ctensor, index.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17516,10 +19733,13 @@ func TakeBackward(grad *Tensor, input *Tensor, index *Tensor) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTakeBackward(ptr, grad.ctensor, input.ctensor, index.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17531,10 +19751,13 @@ func (ts *Tensor) TakeOut(out *Tensor, index *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTakeOut(ptr, out.ctensor, ts.ctensor, index.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(index)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17546,10 +19769,11 @@ func (ts *Tensor) Tan(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTan(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17558,6 +19782,7 @@ func (ts *Tensor) Tan_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTan_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17572,10 +19797,12 @@ func (ts *Tensor) TanOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17587,10 +19814,11 @@ func (ts *Tensor) Tanh(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanh(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17599,6 +19827,7 @@ func (ts *Tensor) Tanh_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanh_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17610,10 +19839,12 @@ func TanhBackward(gradOutput *Tensor, output *Tensor) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanhBackward(ptr, gradOutput.ctensor, output.ctensor)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17622,10 +19853,13 @@ func TanhBackwardOut(gradInput *Tensor, gradOutput *Tensor, output *Tensor) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanhBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, output.ctensor)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(output)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17637,10 +19871,12 @@ func (ts *Tensor) TanhOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTanhOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17652,10 +19888,12 @@ func (ts *Tensor) Tensordot(other *Tensor, dimsSelf []int64, dimsOther []int64,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTensordot(ptr, ts.ctensor, other.ctensor, dimsSelf, len(dimsSelf), dimsOther, len(dimsOther))
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17667,10 +19905,11 @@ func (ts *Tensor) Threshold(threshold *Scalar, value *Scalar, del bool) (retVal
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThreshold(ptr, ts.ctensor, threshold.cscalar, value.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17679,6 +19918,7 @@ func (ts *Tensor) Threshold_(threshold *Scalar, value *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThreshold_(ptr, ts.ctensor, threshold.cscalar, value.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17693,10 +19933,12 @@ func (ts *Tensor) ThresholdBackward(gradOutput *Tensor, threshold *Scalar, del b
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThresholdBackward(ptr, gradOutput.ctensor, ts.ctensor, threshold.cscalar)
+	runtime.KeepAlive(gradOutput)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17708,10 +19950,12 @@ func (ts *Tensor) ThresholdOut(out *Tensor, threshold *Scalar, value *Scalar, de
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgThresholdOut(ptr, out.ctensor, ts.ctensor, threshold.cscalar, value.cscalar)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17723,10 +19967,11 @@ func (ts *Tensor) To(device gotch.Device, del bool) (retVal *Tensor, err error)
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTo(ptr, ts.ctensor, device.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17746,10 +19991,11 @@ func (ts *Tensor) To1(optionsKind gotch.DType, optionsDevice gotch.Device, nonBl
 		ccopy = int32(1)
 	}
 	lib.AtgTo1(ptr, ts.ctensor, optionsKind.CInt(), optionsDevice.CInt(), cnonBlocking, ccopy)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17769,10 +20015,11 @@ func (ts *Tensor) To2(dtype gotch.DType, nonBlocking bool, copy bool, del bool)
 		ccopy = int32(1)
 	}
 	lib.AtgTo2(ptr, ts.ctensor, dtype.CInt(), cnonBlocking, ccopy)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17792,10 +20039,12 @@ func (ts *Tensor) To3(other *Tensor, nonBlocking bool, copy bool, del bool) (ret
 		ccopy = int32(1)
 	}
 	lib.AtgTo3(ptr, ts.ctensor, other.ctensor, cnonBlocking, ccopy)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17815,10 +20064,11 @@ func (ts *Tensor) To4(device gotch.Device, dtype gotch.DType, nonBlocking bool,
 		ccopy = int32(1)
 	}
 	lib.AtgTo4(ptr, ts.ctensor, device.CInt(), dtype.CInt(), cnonBlocking, ccopy)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17830,10 +20080,11 @@ func (ts *Tensor) ToDense(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToDense(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17842,10 +20093,12 @@ func ToDenseBackward(grad *Tensor, input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToDenseBackward(ptr, grad.ctensor, input.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17857,10 +20110,11 @@ func (ts *Tensor) ToMkldnn(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToMkldnn(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17869,10 +20123,12 @@ func ToMkldnnBackward(grad *Tensor, input *Tensor) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToMkldnnBackward(ptr, grad.ctensor, input.ctensor)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(input)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17884,10 +20140,11 @@ func (ts *Tensor) ToSparse(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToSparse(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17899,10 +20156,11 @@ func (ts *Tensor) ToSparse1(sparseDim int64, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgToSparse1(ptr, ts.ctensor, sparseDim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17914,10 +20172,11 @@ func (ts *Tensor) Totype(scalarType gotch.DType, del bool) (retVal *Tensor, err
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTotype(ptr, ts.ctensor, scalarType.CInt())
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17929,10 +20188,11 @@ func (ts *Tensor) Trace(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrace(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17941,10 +20201,11 @@ func TraceBackward(grad *Tensor, sizes []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTraceBackward(ptr, grad.ctensor, sizes, len(sizes))
+	runtime.KeepAlive(grad)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17956,10 +20217,11 @@ func (ts *Tensor) Transpose(dim0 int64, dim1 int64, del bool) (retVal *Tensor, e
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTranspose(ptr, ts.ctensor, dim0, dim1)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17968,6 +20230,7 @@ func (ts *Tensor) Transpose_(dim0 int64, dim1 int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTranspose_(ptr, ts.ctensor, dim0, dim1)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -17979,10 +20242,12 @@ func Trapz(y *Tensor, x *Tensor, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrapz(ptr, y.ctensor, x.ctensor, dim)
+	runtime.KeepAlive(y)
+	runtime.KeepAlive(x)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -17991,10 +20256,11 @@ func Trapz1(y *Tensor, dx float64, dim int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrapz1(ptr, y.ctensor, dx, dim)
+	runtime.KeepAlive(y)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18006,10 +20272,11 @@ func (ts *Tensor) Tril(diagonal int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTril(ptr, ts.ctensor, diagonal)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18018,6 +20285,7 @@ func (ts *Tensor) Tril_(diagonal int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTril_(ptr, ts.ctensor, diagonal)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18032,7 +20300,7 @@ func TrilIndices(row int64, col int64, offset int64, optionsKind gotch.DType, op
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18044,10 +20312,12 @@ func (ts *Tensor) TrilOut(out *Tensor, diagonal int64, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrilOut(ptr, out.ctensor, ts.ctensor, diagonal)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18060,10 +20330,13 @@ func TripletMarginLoss(anchor *Tensor, positive *Tensor, negative *Tensor, margi
 		cswap = int32(1)
 	}
 	lib.AtgTripletMarginLoss(ptr, anchor.ctensor, positive.ctensor, negative.ctensor, margin, p, eps, cswap, reduction)
+	runtime.KeepAlive(anchor)
+	runtime.KeepAlive(positive)
+	runtime.KeepAlive(negative)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18075,10 +20348,11 @@ func (ts *Tensor) Triu(diagonal int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriu(ptr, ts.ctensor, diagonal)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18087,6 +20361,7 @@ func (ts *Tensor) Triu_(diagonal int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriu_(ptr, ts.ctensor, diagonal)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18101,7 +20376,7 @@ func TriuIndices(row int64, col int64, offset int64, optionsKind gotch.DType, op
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18113,10 +20388,12 @@ func (ts *Tensor) TriuOut(out *Tensor, diagonal int64, del bool) (retVal *Tensor
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTriuOut(ptr, out.ctensor, ts.ctensor, diagonal)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18128,10 +20405,12 @@ func (ts *Tensor) TrueDivide(other *Tensor, del bool) (retVal *Tensor, err error
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18143,10 +20422,11 @@ func (ts *Tensor) TrueDivide1(other *Scalar, del bool) (retVal *Tensor, err erro
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide1(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18155,6 +20435,8 @@ func (ts *Tensor) TrueDivide_(other *Tensor) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide_(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18166,6 +20448,7 @@ func (ts *Tensor) TrueDivide1_(other *Scalar) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivide1_(ptr, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18180,10 +20463,13 @@ func (ts *Tensor) TrueDivideOut(out *Tensor, other *Tensor, del bool) (retVal *T
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrueDivideOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18195,10 +20481,11 @@ func (ts *Tensor) Trunc(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrunc(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18207,6 +20494,7 @@ func (ts *Tensor) Trunc_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTrunc_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18221,10 +20509,12 @@ func (ts *Tensor) TruncOut(out *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTruncOut(ptr, out.ctensor, ts.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18236,10 +20526,12 @@ func (ts *Tensor) TypeAs(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgTypeAs(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18251,10 +20543,11 @@ func (ts *Tensor) Unflatten(dim int64, sizes []int64, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnflatten(ptr, ts.ctensor, dim, sizes, len(sizes))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18266,10 +20559,11 @@ func (ts *Tensor) Unfold(dimension int64, size int64, step int64, del bool) (ret
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnfold(ptr, ts.ctensor, dimension, size, step)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18278,10 +20572,11 @@ func UnfoldBackward(gradIn *Tensor, inputSizes []int64, dim int64, size int64, s
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnfoldBackward(ptr, gradIn.ctensor, inputSizes, len(inputSizes), dim, size, step)
+	runtime.KeepAlive(gradIn)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18290,6 +20585,7 @@ func (ts *Tensor) Uniform_(from float64, to float64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUniform_(ptr, ts.ctensor, from, to)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18304,10 +20600,11 @@ func (ts *Tensor) Unsqueeze(dim int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnsqueeze(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18316,6 +20613,7 @@ func (ts *Tensor) Unsqueeze_(dim int64) (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgUnsqueeze_(ptr, ts.ctensor, dim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -18346,10 +20644,11 @@ func (ts *Tensor) UpsampleBicubic2d(outputSize []int64, alignCorners bool, scale
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18374,10 +20673,11 @@ func UpsampleBicubic2dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18402,10 +20702,12 @@ func UpsampleBicubic2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18433,10 +20735,12 @@ func (ts *Tensor) UpsampleBicubic2dOut(out *Tensor, outputSize []int64, alignCor
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBicubic2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18464,10 +20768,11 @@ func (ts *Tensor) UpsampleBilinear2d(outputSize []int64, alignCorners bool, scal
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18492,10 +20797,11 @@ func UpsampleBilinear2dBackward(gradOutput *Tensor, outputSize []int64, inputSiz
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18520,10 +20826,12 @@ func UpsampleBilinear2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, output
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18551,10 +20859,12 @@ func (ts *Tensor) UpsampleBilinear2dOut(out *Tensor, outputSize []int64, alignCo
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleBilinear2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18576,10 +20886,11 @@ func (ts *Tensor) UpsampleLinear1d(outputSize []int64, alignCorners bool, scales
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesVal, cscalesNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18598,10 +20909,11 @@ func UpsampleLinear1dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesVal, cscalesNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18620,10 +20932,12 @@ func UpsampleLinear1dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputSi
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesVal, cscalesNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18645,10 +20959,12 @@ func (ts *Tensor) UpsampleLinear1dOut(out *Tensor, outputSize []int64, alignCorn
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleLinear1dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesVal, cscalesNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18666,10 +20982,11 @@ func (ts *Tensor) UpsampleNearest1d(outputSize []int64, scales []float64, del bo
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1d(ptr, ts.ctensor, outputSize, len(outputSize), cscalesVal, cscalesNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18684,10 +21001,11 @@ func UpsampleNearest1dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesVal, cscalesNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18702,10 +21020,12 @@ func UpsampleNearest1dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesVal, cscalesNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18723,10 +21043,12 @@ func (ts *Tensor) UpsampleNearest1dOut(out *Tensor, outputSize []int64, scales [
 		cscalesNull = 0
 	}
 	lib.AtgUpsampleNearest1dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), cscalesVal, cscalesNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18750,10 +21072,11 @@ func (ts *Tensor) UpsampleNearest2d(outputSize []int64, scalesH []float64, scale
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2d(ptr, ts.ctensor, outputSize, len(outputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18774,10 +21097,11 @@ func UpsampleNearest2dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18798,10 +21122,12 @@ func UpsampleNearest2dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18825,10 +21151,12 @@ func (ts *Tensor) UpsampleNearest2dOut(out *Tensor, outputSize []int64, scalesH
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest2dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18858,10 +21186,11 @@ func (ts *Tensor) UpsampleNearest3d(outputSize []int64, scalesD []float64, scale
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3d(ptr, ts.ctensor, outputSize, len(outputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18888,10 +21217,11 @@ func UpsampleNearest3dBackward(gradOutput *Tensor, outputSize []int64, inputSize
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18918,10 +21248,12 @@ func UpsampleNearest3dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outputS
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18951,10 +21283,12 @@ func (ts *Tensor) UpsampleNearest3dOut(out *Tensor, outputSize []int64, scalesD
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleNearest3dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -18988,10 +21322,11 @@ func (ts *Tensor) UpsampleTrilinear3d(outputSize []int64, alignCorners bool, sca
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3d(ptr, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19022,10 +21357,11 @@ func UpsampleTrilinear3dBackward(gradOutput *Tensor, outputSize []int64, inputSi
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3dBackward(ptr, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19056,10 +21392,12 @@ func UpsampleTrilinear3dBackwardOut(gradInput *Tensor, gradOutput *Tensor, outpu
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3dBackwardOut(ptr, gradInput.ctensor, gradOutput.ctensor, outputSize, len(outputSize), inputSize, len(inputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(gradInput)
+	runtime.KeepAlive(gradOutput)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19093,10 +21431,12 @@ func (ts *Tensor) UpsampleTrilinear3dOut(out *Tensor, outputSize []int64, alignC
 		cscalesWNull = 0
 	}
 	lib.AtgUpsampleTrilinear3dOut(ptr, out.ctensor, ts.ctensor, outputSize, len(outputSize), calignCorners, cscalesDVal, cscalesDNull, cscalesHVal, cscalesHNull, cscalesWVal, cscalesWNull)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19109,10 +21449,12 @@ func ValueSelectingReductionBackward(grad *Tensor, dim int64, indices *Tensor, s
 		ckeepdim = int32(1)
 	}
 	lib.AtgValueSelectingReductionBackward(ptr, grad.ctensor, dim, indices.ctensor, sizes, len(sizes), ckeepdim)
+	runtime.KeepAlive(grad)
+	runtime.KeepAlive(indices)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19124,10 +21466,11 @@ func (ts *Tensor) Values(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgValues(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19146,10 +21489,11 @@ func Vander(x *Tensor, n []int64, increasing bool) (retVal *Tensor, err error) {
 		cincreasing = int32(1)
 	}
 	lib.AtgVander(ptr, x.ctensor, cnVal, cnNull, cincreasing)
+	runtime.KeepAlive(x)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19165,10 +21509,11 @@ func (ts *Tensor) Var(unbiased bool, del bool) (retVal *Tensor, err error) {
 		cunbiased = int32(1)
 	}
 	lib.AtgVar(ptr, ts.ctensor, cunbiased)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19188,10 +21533,11 @@ func (ts *Tensor) Var1(dim []int64, unbiased bool, keepdim bool, del bool) (retV
 		ckeepdim = int32(1)
 	}
 	lib.AtgVar1(ptr, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19211,10 +21557,12 @@ func (ts *Tensor) VarOut(out *Tensor, dim []int64, unbiased bool, keepdim bool,
 		ckeepdim = int32(1)
 	}
 	lib.AtgVarOut(ptr, out.ctensor, ts.ctensor, dim, len(dim), cunbiased, ckeepdim)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19226,10 +21574,12 @@ func (ts *Tensor) Vdot(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgVdot(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19241,10 +21591,13 @@ func (ts *Tensor) VdotOut(out *Tensor, other *Tensor, del bool) (retVal *Tensor,
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgVdotOut(ptr, out.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19256,10 +21609,11 @@ func (ts *Tensor) View(size []int64, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgView(ptr, ts.ctensor, size, len(size))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19271,10 +21625,12 @@ func (ts *Tensor) ViewAs(other *Tensor, del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgViewAs(ptr, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19286,10 +21642,11 @@ func (ts *Tensor) ViewAsComplex(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgViewAsComplex(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19301,15 +21658,20 @@ func (ts *Tensor) ViewAsReal(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgViewAsReal(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func Vstack(tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("Vstack() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -19317,15 +21679,20 @@ func Vstack(tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgVstack(ptr, ctensors, len(ctensors))
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
 
 func VstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
+	if len(tensors) == 0 {
+		err = fmt.Errorf("VstackOut() failed: empty tensor list input (tensors)\n")
+		return
+	}
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	var ctensors []lib.Ctensor
@@ -19333,10 +21700,12 @@ func VstackOut(out *Tensor, tensors []Tensor) (retVal *Tensor, err error) {
 		ctensors = append(ctensors, t.ctensor)
 	}
 	lib.AtgVstackOut(ptr, out.ctensor, ctensors, len(ctensors))
+	runtime.KeepAlive(out)
+	runtime.KeepAlive(tensors)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19348,10 +21717,13 @@ func (ts *Tensor) Where1(condition *Tensor, other *Tensor, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere1(ptr, condition.ctensor, ts.ctensor, other.ctensor)
+	runtime.KeepAlive(condition)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19360,10 +21732,12 @@ func Where2(condition *Tensor, selfScalar *Scalar, other *Tensor) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere2(ptr, condition.ctensor, selfScalar.cscalar, other.ctensor)
+	runtime.KeepAlive(condition)
+	runtime.KeepAlive(other)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19375,10 +21749,12 @@ func (ts *Tensor) Where3(condition *Tensor, other *Scalar, del bool) (retVal *Te
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere3(ptr, condition.ctensor, ts.ctensor, other.cscalar)
+	runtime.KeepAlive(condition)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19387,10 +21763,11 @@ func Where4(condition *Tensor, selfScalar *Scalar, other *Scalar) (retVal *Tenso
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgWhere4(ptr, condition.ctensor, selfScalar.cscalar, other.cscalar)
+	runtime.KeepAlive(condition)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19399,6 +21776,7 @@ func (ts *Tensor) Zero_() (err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZero_(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -19413,7 +21791,7 @@ func Zeros(size []int64, optionsKind gotch.DType, optionsDevice gotch.Device) (r
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19425,10 +21803,11 @@ func (ts *Tensor) ZerosLike(del bool) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZerosLike(ptr, ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
@@ -19437,10 +21816,11 @@ func ZerosOut(out *Tensor, size []int64) (retVal *Tensor, err error) {
 	ptr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
 
 	lib.AtgZerosOut(ptr, out.ctensor, size, len(size))
+	runtime.KeepAlive(out)
 	if err = TorchErr(); err != nil {
 		return retVal, err
 	}
-	retVal = &Tensor{ctensor: *ptr}
+	retVal = newTensor(*ptr)
 
 	return retVal, err
 }
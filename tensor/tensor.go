@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"runtime"
 	"unsafe"
 
 	gotch "github.com/sugarme/gotch"
@@ -19,6 +20,7 @@ import (
 
 type Tensor struct {
 	ctensor lib.Ctensor
+	guard   *tensorGuard // nil unless created with auto free enabled
 }
 
 // None is an undefined tensor.
@@ -29,11 +31,12 @@ var None = NewTensor()
 // NewTensor creates a new tensor
 func NewTensor() *Tensor {
 	ctensor := lib.AtNewTensor()
-	return &Tensor{ctensor}
+	return newTensor(ctensor)
 }
 
 func (ts *Tensor) Dim() uint64 {
 	dim := lib.AtDim(ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err := TorchErr(); err != nil {
 		log.Fatal(err)
 	}
@@ -47,6 +50,7 @@ func (ts *Tensor) Dim() uint64 {
 // to that slice.
 func (ts *Tensor) Size() ([]int64, error) {
 	dim := lib.AtDim(ts.ctensor)
+	runtime.KeepAlive(ts)
 	sz := make([]int64, dim)
 	szPtr, err := DataAsPtr(sz)
 	if err != nil {
@@ -55,6 +59,7 @@ func (ts *Tensor) Size() ([]int64, error) {
 	defer C.free(unsafe.Pointer(szPtr))
 
 	lib.AtShape(ts.ctensor, szPtr)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return nil, err
 	}
@@ -193,7 +198,7 @@ func OfSlice(data interface{}) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // OfDataSize creates Tensor from input byte data, shape and dtype.
@@ -229,7 +234,7 @@ func OfDataSize(data []byte, shape []int64, dtype gotch.DType) (*Tensor, error)
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustOfDataSize create Tensor from input byte data and specified shape and dtype
@@ -253,6 +258,33 @@ func MustOfSlice(data interface{}) *Tensor {
 	return ts
 }
 
+// OfSliceKind creates a tensor of given dtype from a slice data, converting
+// values if data has a different Go type, e.g. a gotch.Half tensor from
+// `[]float32` data.
+func OfSliceKind(data interface{}, dtype gotch.DType) (*Tensor, error) {
+	x, err := OfSlice(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.DType() == dtype {
+		return x, nil
+	}
+
+	return x.Totype(dtype, true)
+}
+
+// MustOfSliceKind creates a tensor of given dtype from a slice data. It panics
+// if error.
+func MustOfSliceKind(data interface{}, dtype gotch.DType) *Tensor {
+	ts, err := OfSliceKind(data, dtype)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return ts
+}
+
 // TensorFrom create a tensor from slice of data. It will be panic if error.
 func TensorFrom(data interface{}) *Tensor {
 	ts, err := OfSlice(data)
@@ -262,12 +294,20 @@ func TensorFrom(data interface{}) *Tensor {
 	return ts
 }
 
-// Print prints tensor values to console.
+// Print prints tensor values to console using default print options.
+//
+// NOTE: large tensors are summarized. See `PrintOpts` to change formatting.
+func (ts *Tensor) Print() {
+	ts.PrintOpts(DefaultPrintOptions())
+}
+
+// PrintFull prints tensor values to console.
 //
 // NOTE: it is printed from C and will print ALL elements of tensor
 // with no truncation at all.
-func (ts *Tensor) Print() {
+func (ts *Tensor) PrintFull() {
 	lib.AtPrint(ts.ctensor)
+	runtime.KeepAlive(ts)
 	if err := TorchErr(); err != nil {
 		log.Fatal(err)
 	}
@@ -317,11 +357,48 @@ func NewTensorFromData(data interface{}, shape []int64) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
+}
+
+// NewTensorFromDataWithShape creates tensor from (nested) slice data.
+//
+// If shape is nil, it is inferred from data, e.g. a `[][]float32` of 2 rows
+// of 3 elements creates a tensor of shape [2, 3]. Otherwise, data can be flat
+// or nested and its number of elements must match the given shape. One
+// dimension of shape can be -1 to be inferred (see `InferShape`).
+func NewTensorFromDataWithShape(data interface{}, shape []int64) (*Tensor, error) {
+	dataShape, err := DataShape(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if shape == nil {
+		shape = dataShape
+	}
+
+	shape, err = InferShape(shape, ElementCount(dataShape))
+	if err != nil {
+		err = fmt.Errorf("NewTensorFromDataWithShape() failed: data of shape %v: %v", dataShape, err)
+		return nil, err
+	}
+
+	return NewTensorFromData(data, shape)
+}
+
+// MustNewTensorFromDataWithShape creates tensor from (nested) slice data. It
+// will panic if error.
+func MustNewTensorFromDataWithShape(data interface{}, shape []int64) *Tensor {
+	ts, err := NewTensorFromDataWithShape(data, shape)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return ts
 }
 
 func (ts *Tensor) DType() gotch.DType {
 	cint := lib.AtScalarType(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	dtype, err := gotch.CInt2DType(cint)
 	if err != nil {
@@ -337,6 +414,7 @@ func (ts *Tensor) Device() (gotch.Device, error) {
 		err    error
 	)
 	cInt := lib.AtDevice(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	if err = TorchErr(); err != nil {
 		return retVal, err
@@ -396,6 +474,7 @@ func (ts *Tensor) Float64Value(idx []int64) (float64, error) {
 	defer C.free(unsafe.Pointer(idxPtr))
 
 	f64Val := lib.AtDoubleValueAtIndexes(ts.ctensor, idxPtr, len(idx))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return 0, err
 	}
@@ -426,6 +505,7 @@ func (ts *Tensor) Int64Value(idx []int64) (int64, error) {
 	defer C.free(unsafe.Pointer(idxPtr))
 
 	int64Val := lib.AtInt64ValueAtIndexes(ts.ctensor, idxPtr, len(idx))
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return 0, err
 	}
@@ -444,6 +524,7 @@ func (ts *Tensor) MustInt64Value(idx []int64) int64 {
 // RequiresGrad returns true if gradient are currently tracked for this tensor.
 func (ts *Tensor) RequiresGrad() (bool, error) {
 	state := lib.AtRequiresGrad(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	if err := TorchErr(); err != nil {
 		return false, err
@@ -465,6 +546,7 @@ func (ts *Tensor) MustRequiresGrad() bool {
 func (ts *Tensor) DataPtr() (unsafe.Pointer, error) {
 
 	datPtr := lib.AtDataPtr(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	if err := TorchErr(); err != nil {
 		return nil, err
@@ -476,6 +558,7 @@ func (ts *Tensor) DataPtr() (unsafe.Pointer, error) {
 // Defined returns true is the tensor is defined.
 func (ts *Tensor) Defined() (bool, error) {
 	state := lib.AtDefined(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	if err := TorchErr(); err != nil {
 		return false, err
@@ -496,6 +579,7 @@ func (ts *Tensor) MustDefined() bool {
 // IsSparse returns true is the tensor is spare.
 func (ts *Tensor) IsSparse() (bool, error) {
 	state := lib.AtIsSparse(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	if err := TorchErr(); err != nil {
 		return false, err
@@ -504,6 +588,28 @@ func (ts *Tensor) IsSparse() (bool, error) {
 	return state, nil
 }
 
+// IsPinned returns whether the tensor is in pinned (page-locked) host memory.
+func (ts *Tensor) IsPinned() (bool, error) {
+	state := lib.AtIsPinned(ts.ctensor)
+	runtime.KeepAlive(ts)
+
+	if err := TorchErr(); err != nil {
+		return false, err
+	}
+
+	return state, nil
+}
+
+// MustIsPinned returns whether the tensor is in pinned host memory. It panics if error.
+func (ts *Tensor) MustIsPinned() bool {
+	state, err := ts.IsPinned()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return state
+}
+
 // ZeroGrad zeroes the gradient tensor attached to this tensor if defined.
 func (ts *Tensor) ZeroGrad() {
 	grad := ts.MustGrad(false)
@@ -517,8 +623,17 @@ func (ts *Tensor) ZeroGrad() {
 // which gradients are tracked.
 //
 // Gradients tracking can be turned on via `SetRequiresGrad`.
+//
+// NOTE: the tensor must be a scalar (single element) tensor. Use `RunBackward`
+// for non-scalar tensors.
 func (ts *Tensor) Backward() error {
+	if numel := ts.Numel(); numel != 1 {
+		err := fmt.Errorf("Backward() failed: tensor must be a scalar (single element) tensor, got %v elements. Use RunBackward for non-scalar tensors.\n", numel)
+		return err
+	}
+
 	lib.AtBackward(ts.ctensor, 0, 0)
+	runtime.KeepAlive(ts)
 	if err := TorchErr(); err != nil {
 		return err
 	}
@@ -532,34 +647,30 @@ func (ts *Tensor) MustBackward() {
 	}
 }
 
-// RunBackward runs the backward ...
+// RunBackward computes and returns the gradients of the sum of `tensors` with
+// respect to `inputs`. Unlike `Backward`, gradients are returned rather than
+// accumulated in the `Grad()` of the inputs.
+//
+// All inputs must require grad. Set `keepGraphB` to keep the graph for further
+// backward passes and `createGraphB` to build the graph of the derivatives so
+// that higher order derivatives can be computed.
 func RunBackward(tensors []Tensor, inputs []Tensor, keepGraphB bool, createGraphB bool) ([]Tensor, error) {
-	// NOTE: outputs is a slice of tensors with length = len(inputs)
-	var outputsPtr []*lib.Ctensor
-	// Are they allocated contigously??? Definitely not.
-	// TODO. calculate C memory size = C pointer size x n pointers
-	// Then C.malloc such calculated amount
-	// NOTE. replace with the following code and test.
-	/*
-	 *   ntensors := len(inputs)
-	 *   nbytes := C.size_t(ntensors) * C.size_t(unsafe.Sizeof(uintptr(0)))
-	 *   ctensorsPtr := (*[1 << 30]lib.Ctensor)(C.malloc(nbytes))
-	 *   for i :=0; i < ntensors; i++ {
-	 *     outputPtr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
-	 *     outputsPtr[i] = outputPtr
-	 *   }
-	 *  */
-	for i := 0; i < len(inputs); i++ {
-		outputPtr := (*lib.Ctensor)(unsafe.Pointer(C.malloc(0)))
-		defer C.free(unsafe.Pointer(outputPtr))
-		outputsPtr = append(outputsPtr, outputPtr)
-	}
-
-	// Get first element pointer
-	ctensor := tensors[0].ctensor
-	cinput := inputs[0].ctensor
-	tensorsPtr := (*lib.Ctensor)(unsafe.Pointer(&ctensor))
-	inputsPtr := (*lib.Ctensor)(unsafe.Pointer(&cinput))
+	if len(tensors) == 0 || len(inputs) == 0 {
+		err := fmt.Errorf("RunBackward() failed: tensors and inputs must not be empty.\n")
+		return nil, err
+	}
+
+	// NOTE. C side expects contiguous arrays of C tensor pointers.
+	ctensors := make([]lib.Ctensor, len(tensors))
+	for i, t := range tensors {
+		ctensors[i] = t.ctensor
+	}
+	cinputs := make([]lib.Ctensor, len(inputs))
+	for i, t := range inputs {
+		cinputs[i] = t.ctensor
+	}
+	coutputs := make([]lib.Ctensor, len(inputs))
+
 	var keepGraph int = 0
 	if keepGraphB {
 		keepGraph = 1
@@ -569,20 +680,32 @@ func RunBackward(tensors []Tensor, inputs []Tensor, keepGraphB bool, createGraph
 		createGraph = 1
 	}
 
-	lib.AtRunBackward(tensorsPtr, len(tensors), inputsPtr, len(inputs), outputsPtr[0], keepGraph, createGraph)
+	lib.AtRunBackward(&ctensors[0], len(tensors), &cinputs[0], len(inputs), &coutputs[0], keepGraph, createGraph)
+	runtime.KeepAlive(tensors)
+	runtime.KeepAlive(inputs)
 	if err := TorchErr(); err != nil {
 		return nil, err
 	}
 
 	var oTensors []Tensor
-	for i := 0; i < len(inputs); i++ {
-		outputPtr := outputsPtr[i]
-		oTensors = append(oTensors, Tensor{ctensor: *outputPtr})
+	for _, ctensor := range coutputs {
+		oTensors = append(oTensors, *newTensor(ctensor))
 	}
 
 	return oTensors, nil
 }
 
+// MustRunBackward computes and returns the gradients of the sum of `tensors`
+// with respect to `inputs`. It will panic if error.
+func MustRunBackward(tensors []Tensor, inputs []Tensor, keepGraphB bool, createGraphB bool) []Tensor {
+	grads, err := RunBackward(tensors, inputs, keepGraphB, createGraphB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return grads
+}
+
 // CopyDataUint8 copies `numel` elements from `self` to `dst`.
 //
 // NOTE: `dst` located in Go memory. Should it be?
@@ -601,6 +724,7 @@ func (ts *Tensor) CopyDataUint8(dst []uint8, numel uint) error {
 		return err
 	}
 	lib.AtCopyData(ts.ctensor, vs, numel, elt_size_in_bytes)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -656,10 +780,18 @@ func (ts *Tensor) CopyData(dst interface{}, numel uint) error {
 		vs = unsafe.Pointer(&dst.([]int32)[0])
 	case gotch.Int64:
 		vs = unsafe.Pointer(&dst.([]int64)[0])
+	case gotch.Half:
+		vs = unsafe.Pointer(&dst.([]gotch.GoFloat16)[0])
+	case gotch.BFloat16:
+		vs = unsafe.Pointer(&dst.([]gotch.GoBFloat16)[0])
 	case gotch.Float:
 		vs = unsafe.Pointer(&dst.([]float32)[0])
 	case gotch.Double:
 		vs = unsafe.Pointer(&dst.([]float64)[0])
+	case gotch.ComplexFloat:
+		vs = unsafe.Pointer(&dst.([]complex64)[0])
+	case gotch.ComplexDouble:
+		vs = unsafe.Pointer(&dst.([]complex128)[0])
 	case gotch.Bool:
 		vs = unsafe.Pointer(&dst.([]bool)[0])
 	default:
@@ -672,6 +804,7 @@ func (ts *Tensor) CopyData(dst interface{}, numel uint) error {
 		return err
 	}
 	lib.AtCopyData(ts.ctensor, vs, numel, elt_size_in_bytes)
+	runtime.KeepAlive(ts)
 	if err = TorchErr(); err != nil {
 		return err
 	}
@@ -697,15 +830,22 @@ func (ts *Tensor) Numel() uint {
 }
 
 // ShallowClone returns a new tensor that share storage with the input tensor.
+//
+// The returned tensor is another handle to the very same tensor: it shares
+// storage, autograd graph and gradient with the input. It is mostly used to
+// own a tensor independently of its source lifetime. See also:
+//   - Clone: a deep copy (new storage) which stays in the autograd graph.
+//   - Detach: shares storage but is detached from the autograd graph.
 func (ts *Tensor) ShallowClone() (*Tensor, error) {
 
 	ctensor := lib.AtShallowClone(ts.ctensor)
+	runtime.KeepAlive(ts)
 
 	if err := TorchErr(); err != nil {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustShallowClone returns a new tensor that share storage with the input
@@ -719,15 +859,110 @@ func (ts *Tensor) MustShallowClone() *Tensor {
 	return newTs
 }
 
+// Clone returns a deep copy of the tensor.
+//
+// The returned tensor has its own storage but stays in the autograd graph:
+// gradients flowing through the clone are propagated back to the input.
+// Use `Detach` to get a tensor detached from the graph and `ShallowClone` to
+// get another handle to the same tensor.
+func (ts *Tensor) Clone() (*Tensor, error) {
+	// NOTE. `copy = true` forces a copy even when dtype and device are unchanged.
+	return ts.To2(ts.DType(), false, true, false)
+}
+
+// MustClone returns a deep copy of the tensor. It will panic if error.
+func (ts *Tensor) MustClone() *Tensor {
+	newTs, err := ts.Clone()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return newTs
+}
+
+// ToKindDevice returns a tensor with given dtype on given device.
+//
+// If the tensor already has the target dtype and device, a shallow clone is
+// returned so that the caller always owns the returned tensor.
+func (ts *Tensor) ToKindDevice(dtype gotch.DType, device gotch.Device) (*Tensor, error) {
+	return ts.toKindDevice(dtype, device, false)
+}
+
+func (ts *Tensor) toKindDevice(dtype gotch.DType, device gotch.Device, nonBlocking bool) (*Tensor, error) {
+	currDevice, err := ts.Device()
+	if err != nil {
+		return nil, err
+	}
+
+	if ts.DType() == dtype && currDevice == device {
+		return ts.ShallowClone()
+	}
+
+	return ts.To4(device, dtype, nonBlocking, false, false)
+}
+
+// MustToKindDevice returns a tensor with given dtype on given device. It panics if error.
+func (ts *Tensor) MustToKindDevice(dtype gotch.DType, device gotch.Device) *Tensor {
+	retVal, err := ts.ToKindDevice(dtype, device)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// ToDevice returns a tensor on given device, keeping its dtype.
+//
+// If the tensor is already on the target device, a shallow clone is returned.
+// If nonBlocking is true, a copy from pinned host memory (see `PinMemory`) to
+// CUDA is asynchronous with respect to the host, so that the transfer can
+// overlap with computation. It has no effect otherwise.
+func (ts *Tensor) ToDevice(device gotch.Device, nonBlocking bool) (*Tensor, error) {
+	return ts.toKindDevice(ts.DType(), device, nonBlocking)
+}
+
+// MustToDevice returns a tensor on given device. It panics if error.
+func (ts *Tensor) MustToDevice(device gotch.Device, nonBlocking bool) *Tensor {
+	retVal, err := ts.ToDevice(device, nonBlocking)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// ToDType returns a tensor with given dtype, keeping its device.
+//
+// If the tensor already has the target dtype, a shallow clone is returned.
+func (ts *Tensor) ToDType(dtype gotch.DType) (*Tensor, error) {
+	device, err := ts.Device()
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.ToKindDevice(dtype, device)
+}
+
+// MustToDType returns a tensor with given dtype. It panics if error.
+func (ts *Tensor) MustToDType(dtype gotch.DType) *Tensor {
+	retVal, err := ts.ToDType(dtype)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
 // Get gets the sub-tensor at the given index.
 func (ts *Tensor) Get(index int) (*Tensor, error) {
 
 	ctensor := lib.AtGet(ts.ctensor, index)
+	runtime.KeepAlive(ts)
 	if err := TorchErr(); err != nil {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustGet gets the sub-tensor at the given index. It will panic if error
@@ -746,6 +981,8 @@ func (ts *Tensor) MustGet(index int) *Tensor {
 func Copy_(self, src *Tensor) {
 
 	lib.AtCopy_(self.ctensor, src.ctensor)
+	runtime.KeepAlive(self)
+	runtime.KeepAlive(src)
 	if err := TorchErr(); err != nil {
 		log.Fatal(err)
 	}
@@ -755,15 +992,87 @@ func Copy_(self, src *Tensor) {
 func (ts *Tensor) Copy_(src *Tensor) {
 
 	lib.AtCopy_(ts.ctensor, src.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(src)
 	if err := TorchErr(); err != nil {
 		log.Fatal(err)
 	}
 }
 
+// CopyFrom_ copies in-place values from src, which should broadcast to the
+// shape of ts, to ts. Unlike `Copy_`, it returns an error instead of exiting,
+// e.g. for a shape mismatch or a leaf tensor requiring grad outside `NoGrad`.
+func (ts *Tensor) CopyFrom_(src *Tensor) error {
+	lib.AtCopy_(ts.ctensor, src.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(src)
+	return TorchErr()
+}
+
+// SetData_ replaces in-place values of the tensor with values of `other`
+// without tracking gradients.
+//
+// The tensor keeps its identity (e.g. it is still tracked by optimizers and
+// still requires gradients). `other` should have the same shape and dtype.
+func (ts *Tensor) SetData_(other *Tensor) error {
+	if ts.DType() != other.DType() {
+		err := fmt.Errorf("SetData_() failed: mismatched dtype - tensor: %v, other: %v\n", ts.DType(), other.DType())
+		return err
+	}
+
+	shape, err := ts.Size()
+	if err != nil {
+		return err
+	}
+	otherShape, err := other.Size()
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(shape, otherShape) {
+		err = fmt.Errorf("SetData_() failed: mismatched shape - tensor: %v, other: %v\n", shape, otherShape)
+		return err
+	}
+
+	NoGrad(func() {
+		ts.Copy_(other)
+	})
+
+	return nil
+}
+
+// MustSetData_ replaces in-place values of the tensor with values of `other`.
+// It panics if error.
+func (ts *Tensor) MustSetData_(other *Tensor) {
+	if err := ts.SetData_(other); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ReplaceData_ makes the tensor share the data of `other` in-place.
+//
+// Unlike SetData_, `other` can have a different shape, dtype or device. The
+// tensor keeps its identity, so every handle to it (e.g. a layer weight and
+// the same variable in a var-store) sees the new data.
+func (ts *Tensor) ReplaceData_(other *Tensor) error {
+	lib.AtSetData(ts.ctensor, other.ctensor)
+	runtime.KeepAlive(ts)
+	runtime.KeepAlive(other)
+	return TorchErr()
+}
+
+// MustReplaceData_ makes the tensor share the data of `other` in-place. It
+// panics if error.
+func (ts *Tensor) MustReplaceData_(other *Tensor) {
+	if err := ts.ReplaceData_(other); err != nil {
+		log.Fatal(err)
+	}
+}
+
 // Save saves a tensor to a file.
 func (ts *Tensor) Save(path string) error {
 
 	lib.AtSave(ts.ctensor, path)
+	runtime.KeepAlive(ts)
 	if err := TorchErr(); err != nil {
 		return err
 	}
@@ -786,7 +1095,7 @@ func Load(path string) (*Tensor, error) {
 		return nil, err
 	}
 
-	return &Tensor{ctensor}, nil
+	return newTensor(ctensor), nil
 }
 
 // MustLoad loads a tensor to a file. It will panic if error
@@ -819,6 +1128,7 @@ func SaveMulti(namedTensors []NamedTensor, path string) error {
 	}
 
 	lib.AtSaveMulti(ctensors, names, len(namedTensors), path)
+	runtime.KeepAlive(namedTensors)
 	if err := TorchErr(); err != nil {
 		return err
 	}
@@ -852,7 +1162,7 @@ func LoadMulti(path string) ([]NamedTensor, error) {
 	for _, v := range data.NamedCtensors {
 		namedTensor := NamedTensor{
 			Name:   v.Name,
-			Tensor: &Tensor{v.Ctensor},
+			Tensor: newTensor(v.Ctensor),
 		}
 
 		namedTensors = append(namedTensors, namedTensor)
@@ -887,7 +1197,7 @@ func LoadMultiWithDevice(path string, device gotch.Device) ([]NamedTensor, error
 	for _, v := range data.NamedCtensors {
 		namedTensor := NamedTensor{
 			Name:   v.Name,
-			Tensor: &Tensor{v.Ctensor},
+			Tensor: newTensor(v.Ctensor),
 		}
 
 		namedTensors = append(namedTensors, namedTensor)
@@ -913,6 +1223,7 @@ func MustLoadMultiWithDevice(path string, device gotch.Device) []NamedTensor {
 // large tensors.
 func (ts *Tensor) ToString(lw int64) (string, error) {
 	tensorStr := lib.AtToString(ts.ctensor, lw)
+	runtime.KeepAlive(ts)
 	if err := TorchErr(); err != nil {
 		return "", err
 	}
@@ -934,6 +1245,11 @@ func (ts *Tensor) MustToString(lw int64) string {
 
 // Drop drops (frees) the tensor
 func (ts *Tensor) Drop() error {
+	if ts.guard != nil && !ts.guard.markFreed() {
+		// already freed
+		return nil
+	}
+	untrackTensor(ts.ctensor)
 	lib.AtFree(ts.ctensor)
 	if err := TorchErr(); err != nil {
 		return err
@@ -999,8 +1315,18 @@ func MustGradSetEnabled(b bool) bool {
 	return state
 }
 
+// GradIsEnabled returns whether gradient tracking is enabled.
+func GradIsEnabled() bool {
+	state := MustGradSetEnabled(true)
+	MustGradSetEnabled(state)
+
+	return state
+}
+
 // NoGrad runs a closure without keeping track of gradients.
-func NoGrad(fn interface{}) {
+//
+// The previous grad mode is restored when the closure returns, even if it panics.
+func NoGrad(fn func()) {
 
 	// TODO: This is weird but somehow we need to trigger C++ print
 	// to get loss function updated. Probably it is related to
@@ -1012,19 +1338,68 @@ func NoGrad(fn interface{}) {
 
 	// Switch off Grad
 	prev := MustGradSetEnabled(false)
+	// Restore Grad to its previous state
+	defer MustGradSetEnabled(prev)
+
+	fn()
+}
+
+// WithGrad runs a closure with gradient tracking enabled. It is the
+// counterpart of NoGrad and can be used to re-enable gradients inside a
+// no-grad scope.
+//
+// The previous grad mode is restored when the closure returns, even if it panics.
+func WithGrad(fn func()) {
+	prev := MustGradSetEnabled(true)
+	defer MustGradSetEnabled(prev)
 
-	// Analyze input as function. If not, throw error
-	f, err := NewFunc(fn)
+	fn()
+}
+
+// AutocastSetEnabled enables or disables CUDA automatic mixed precision and
+// returns the previous state.
+func AutocastSetEnabled(b bool) (bool, error) {
+	prev := lib.AtAutocastSetEnabled(b)
+	if err := TorchErr(); err != nil {
+		return false, err
+	}
+
+	return prev, nil
+}
+
+// MustAutocastSetEnabled enables or disables CUDA automatic mixed precision
+// and returns the previous state. It panics if error.
+func MustAutocastSetEnabled(b bool) bool {
+	prev, err := AutocastSetEnabled(b)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// invokes the function
-	f.Invoke()
+	return prev
+}
 
-	// Switch on Grad
-	_ = MustGradSetEnabled(prev)
+// AutocastIsEnabled returns whether CUDA automatic mixed precision is enabled.
+func AutocastIsEnabled() bool {
+	return lib.AtAutocastIsEnabled()
+}
+
+// Autocast runs a closure with CUDA automatic mixed precision enabled or
+// disabled. Within an enabled region, eligible CUDA ops (e.g. matmul, conv)
+// run in half precision while precision-sensitive ops stay in float.
+//
+// The previous autocast mode is restored when the closure returns, even if it
+// panics. The cast cache is cleared when the outermost region exits.
+func Autocast(enabled bool, fn func()) {
+	lib.AtAutocastIncrementNesting()
+	prev := MustAutocastSetEnabled(enabled)
+	defer func() {
+		MustAutocastSetEnabled(prev)
+		if lib.AtAutocastDecrementNesting() == 0 {
+			lib.AtAutocastClearCache()
+		}
+	}()
 
+	fn()
 }
 
 func NoGrad1(fn func() interface{}) interface{} {
@@ -1076,7 +1451,8 @@ func (ngg *NoGradGuard) Enable() {
 	_ = MustGradSetEnabled(ngg.enabled)
 }
 
-// Reduction type is an enum-like type
+// Reduction type is an enum-like type for the reduction applied to the
+// output of loss functions. Use `ToInt()` for the libtorch integer value.
 type Reduction int
 
 const (
@@ -1106,6 +1482,22 @@ func (r Reduction) ToInt() int {
 	return -1
 }
 
+// String implements fmt.Stringer interface for Reduction.
+func (r Reduction) String() string {
+	switch r {
+	case ReductionNone:
+		return "none"
+	case ReductionMean:
+		return "mean"
+	case ReductionSum:
+		return "sum"
+	case ReductionOther:
+		return "other"
+	}
+
+	return fmt.Sprintf("Reduction(%d)", int(r))
+}
+
 // Float64Values returns values of tensor in a slice of float64.
 func (ts *Tensor) Float64Values() []float64 {
 	numel := ts.Numel()
@@ -1132,10 +1524,60 @@ func (ts *Tensor) Int64Values() []int64 {
 	return vec
 }
 
-// Vals returns tensor values in a slice
+// Vals returns tensor values in a flat slice of the Go type equivalent to the
+// tensor DType (e.g. `[]float32` for gotch.Float). It is the inverse of
+// `OfSlice`.
+//
+// Non-contiguous tensors are made contiguous first. Values of tensors on a
+// CUDA device are copied to CPU. See `CPUVals` for an error-returning version
+// rejecting CUDA tensors.
 // NOTE: need a type insersion to get runtime type
 // E.g. res := xs.Vals().([]int64)
 func (ts *Tensor) Vals() interface{} {
+	retVal, err := ts.vals()
+	if err != nil {
+		log.Fatalf("Vals() failed: %v", err)
+	}
+
+	return retVal
+}
+
+// CPUVals returns tensor values in a flat slice of the Go type equivalent to
+// the tensor DType, as `Vals` does. Unlike `Vals`, it returns an error for
+// tensors on a CUDA device, which should be moved to CPU first.
+// NOTE: need a type insersion to get runtime type
+// E.g. res, err := xs.CPUVals(); vals := res.([]int64)
+func (ts *Tensor) CPUVals() (interface{}, error) {
+	device, err := ts.Device()
+	if err != nil {
+		return nil, err
+	}
+	if device.IsCuda() {
+		err = fmt.Errorf("CPUVals() failed: tensor is on device %v. Move it to CPU first, e.g. `ts.MustTo(gotch.CPU, false)`.\n", device)
+		return nil, err
+	}
+
+	retVal, err := ts.vals()
+	if err != nil {
+		return nil, fmt.Errorf("CPUVals() failed: %v", err)
+	}
+
+	return retVal, nil
+}
+
+// MustCPUVals returns tensor values in a flat slice. It panics if error.
+func (ts *Tensor) MustCPUVals() interface{} {
+	retVal, err := ts.CPUVals()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// vals copies tensor values to a flat slice of the Go type equivalent to the
+// tensor DType. `CopyData` copies CUDA tensors to CPU.
+func (ts *Tensor) vals() (interface{}, error) {
 	dtype := ts.DType()
 	numel := ts.Numel()
 
@@ -1152,17 +1594,108 @@ func (ts *Tensor) Vals() interface{} {
 		retVal = make([]int32, numel)
 	case "int64":
 		retVal = make([]int64, numel)
+	case "GoFloat16":
+		retVal = make([]gotch.GoFloat16, numel)
+	case "GoBFloat16":
+		retVal = make([]gotch.GoBFloat16, numel)
 	case "float32":
 		retVal = make([]float32, numel)
 	case "float64":
 		retVal = make([]float64, numel)
+	case "complex64":
+		retVal = make([]complex64, numel)
+	case "complex128":
+		retVal = make([]complex128, numel)
 	case "bool":
 		retVal = make([]bool, numel)
 	default:
-		log.Fatalf("Unsupported dtype (%v)", dtype)
+		return nil, fmt.Errorf("unsupported dtype (%v).\n", dtype)
+	}
+
+	if numel == 0 {
+		return retVal, nil
+	}
+
+	contiguousTs, err := ts.Contiguous(false)
+	if err != nil {
+		return nil, err
+	}
+	defer contiguousTs.MustDrop()
+
+	if err := contiguousTs.CopyData(retVal, numel); err != nil {
+		return nil, err
+	}
+
+	return retVal, nil
+}
+
+// Item returns the value of a single-element tensor (0-dim or holding exactly
+// one element) as the Go type equivalent to the tensor DType (e.g. `float32`
+// for gotch.Float). An error is returned for tensors with more or fewer than
+// one element.
+func (ts *Tensor) Item() (interface{}, error) {
+	if numel := ts.Numel(); numel != 1 {
+		err := fmt.Errorf("Item() failed: expected a tensor with a single element, got %v elements (shape %v).\n", numel, ts.MustSize())
+		return nil, err
+	}
+
+	vals, err := ts.vals()
+	if err != nil {
+		return nil, fmt.Errorf("Item() failed: %v", err)
+	}
+
+	return reflect.ValueOf(vals).Index(0).Interface(), nil
+}
+
+// MustItem returns the value of a single-element tensor. It panics if error.
+func (ts *Tensor) MustItem() interface{} {
+	retVal, err := ts.Item()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Float64Item returns the value of a single-element tensor of any dtype as
+// float64. An error is returned for tensors with more or fewer than one element.
+func (ts *Tensor) Float64Item() (float64, error) {
+	if numel := ts.Numel(); numel != 1 {
+		err := fmt.Errorf("Float64Item() failed: expected a tensor with a single element, got %v elements (shape %v).\n", numel, ts.MustSize())
+		return 0, err
+	}
+
+	return ts.Float64Value(make([]int64, ts.Dim()))
+}
+
+// MustFloat64Item returns the value of a single-element tensor as float64. It panics if error.
+func (ts *Tensor) MustFloat64Item() float64 {
+	retVal, err := ts.Float64Item()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return retVal
+}
+
+// Int64Item returns the value of a single-element tensor of any dtype as
+// int64. An error is returned for tensors with more or fewer than one element.
+func (ts *Tensor) Int64Item() (int64, error) {
+	if numel := ts.Numel(); numel != 1 {
+		err := fmt.Errorf("Int64Item() failed: expected a tensor with a single element, got %v elements (shape %v).\n", numel, ts.MustSize())
+		return 0, err
+	}
+
+	return ts.Int64Value(make([]int64, ts.Dim()))
+}
+
+// MustInt64Item returns the value of a single-element tensor as int64. It panics if error.
+func (ts *Tensor) MustInt64Item() int64 {
+	retVal, err := ts.Int64Item()
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	ts.CopyData(retVal, numel)
 	return retVal
 }
 
@@ -1200,13 +1733,15 @@ func (ts *Tensor) MustZeroPad2d(left, right, top, bottom int64, del bool) *Tenso
 // Elements of the input vector are expected to be between 0 and labels-1.
 //
 // NOTE: There's other `ts.OneHot` and `ts.MustOneHot` generated from Atg C++ API
+// which return int64 values, infer the number of classes when it is -1 and
+// return an error for out-of-range indices.
 func (ts *Tensor) Onehot(labels int64) *Tensor {
 	dims := ts.MustSize()
 	dims = append(dims, labels)
 	unsqueezeTs := ts.MustUnsqueeze(-1, false)
 	inputTs := unsqueezeTs.MustTotype(gotch.Int64, true)
 
-	zerosTs := MustZeros(dims, gotch.Float, gotch.CPU)
+	zerosTs := MustZeros(dims, gotch.Float, ts.MustDevice())
 	retVal := zerosTs.MustScatter1(-1, inputTs, FloatScalar(1.0), true)
 	inputTs.MustDrop()
 
@@ -1237,6 +1772,7 @@ func SaveMultiNew(namedTensors []NamedTensor, path string) error {
 	}
 
 	lib.AtSaveMultiNew(tensors, names, path)
+	runtime.KeepAlive(namedTensors)
 	if err := TorchErr(); err != nil {
 		return err
 	}
@@ -1,6 +1,9 @@
 package tensor_test
 
 import (
+	"math"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -110,6 +113,771 @@ func TestOnehot(t *testing.T) {
 
 }
 
+func TestOneHot(t *testing.T) {
+	xs := ts.MustOfSlice([]int64{2, 0, 1})
+
+	onehot, err := xs.OneHot(4, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int64{3, 4}, onehot.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected onehot shape: %v\n", want)
+		t.Errorf("Got onehot shape: %v\n", got)
+	}
+	want := []int64{0, 0, 1, 0, 1, 0, 0, 0, 0, 1, 0, 0}
+	if got := onehot.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected onehot tensor values: %v\n", want)
+		t.Errorf("Got onehot tensor values: %v\n", got)
+	}
+
+	// number of classes inferred from max index
+	if want, got := []int64{3, 3}, xs.MustOneHot(-1, false).MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected inferred onehot shape: %v\n", want)
+		t.Errorf("Got inferred onehot shape: %v\n", got)
+	}
+
+	if _, err := xs.OneHot(2, false); err == nil {
+		t.Errorf("Expected error for out-of-range index, got nil\n")
+	}
+	if _, err := ts.MustOfSlice([]float64{0, 1}).OneHot(2, false); err == nil {
+		t.Errorf("Expected error for non-integer indices, got nil\n")
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	filename := "tensor-save-load.test"
+	filenameAbs, err := filepath.Abs(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tensor := ts.MustOfSlice([]float64{3.14, 15.926, 5.3589, 79.0, 3.2, 38.46}).MustView([]int64{2, 3}, true)
+	err = tensor.Save(filenameAbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := ts.Load(filenameAbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.DType() != gotch.Double {
+		t.Errorf("Expected loaded tensor dtype: %v\n", gotch.Double)
+		t.Errorf("Got loaded tensor dtype: %v\n", loaded.DType())
+	}
+
+	wantShape := tensor.MustSize()
+	gotShape := loaded.MustSize()
+	if !reflect.DeepEqual(wantShape, gotShape) {
+		t.Errorf("Expected loaded tensor shape: %v\n", wantShape)
+		t.Errorf("Got loaded tensor shape: %v\n", gotShape)
+	}
+
+	want := tensor.Float64Values()
+	got := loaded.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected loaded tensor values: %v\n", want)
+		t.Errorf("Got loaded tensor values: %v\n", got)
+	}
+
+	err = os.Remove(filenameAbs)
+	if err != nil {
+		t.Errorf("Failed deleting tensor saved file: %v\n", filenameAbs)
+	}
+}
+
+func TestSaveLoadMulti(t *testing.T) {
+	filename := "tensor-save-load-multi.test"
+	filenameAbs, err := filepath.Abs(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ts.NamedTensor{
+		{Name: "weight", Tensor: ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)},
+		{Name: "bias", Tensor: ts.MustZeros([]int64{3}, gotch.Double, gotch.CPU)},
+		{Name: "steps", Tensor: ts.MustOfSlice([]int64{1, 2, 3, 4}).MustView([]int64{2, 2}, true)},
+	}
+
+	err = ts.SaveMulti(want, filenameAbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ts.LoadMulti(filenameAbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v loaded tensors, got %v\n", len(want), len(got))
+	}
+
+	loaded := make(map[string]*ts.Tensor)
+	for _, nt := range got {
+		loaded[nt.Name] = nt.Tensor
+	}
+
+	for _, nt := range want {
+		x, ok := loaded[nt.Name]
+		if !ok {
+			t.Errorf("Expected loaded tensor named: %q\n", nt.Name)
+			continue
+		}
+		if x.DType() != nt.Tensor.DType() {
+			t.Errorf("Expected %q dtype: %v\n", nt.Name, nt.Tensor.DType())
+			t.Errorf("Got %q dtype: %v\n", nt.Name, x.DType())
+		}
+		if !reflect.DeepEqual(nt.Tensor.MustSize(), x.MustSize()) {
+			t.Errorf("Expected %q shape: %v\n", nt.Name, nt.Tensor.MustSize())
+			t.Errorf("Got %q shape: %v\n", nt.Name, x.MustSize())
+		}
+	}
+
+	err = os.Remove(filenameAbs)
+	if err != nil {
+		t.Errorf("Failed deleting tensor saved file: %v\n", filenameAbs)
+	}
+}
+
+func TestLoadMultiMissingFile(t *testing.T) {
+	_, err := ts.LoadMulti("no-such-file.test")
+	if err == nil {
+		t.Errorf("Expected error loading a missing file, got nil\n")
+	}
+}
+
+func TestIndexCopy_(t *testing.T) {
+	x := ts.MustZeros([]int64{4, 3}, gotch.Float, gotch.CPU)
+	source := ts.MustOfSlice([]float32{1, 2, 3, 4, 5, 6}).MustView([]int64{2, 3}, true)
+	index := ts.MustOfSlice([]int64{3, 1})
+
+	x.MustIndexCopy_(0, index, source)
+
+	// row 0 and 2 unchanged
+	want := []float64{0, 0, 0, 4, 5, 6, 0, 0, 0, 1, 2, 3}
+	got := x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+}
+
+func TestIndexAdd_(t *testing.T) {
+	x := ts.MustOnes([]int64{4, 2}, gotch.Float, gotch.CPU)
+	source := ts.MustOfSlice([]float32{1, 2, 3, 4, 5, 6}).MustView([]int64{3, 2}, true)
+	// duplicated indices are accumulated
+	index := ts.MustOfSlice([]int64{0, 2, 0})
+
+	x.MustIndexAdd_(0, index, source)
+
+	want := []float64{7, 9, 1, 1, 4, 5, 1, 1}
+	got := x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+}
+
+func TestTensorVals(t *testing.T) {
+	tests := []interface{}{
+		[]float32{3.14, 15.926, 5.3589, 79.0},
+		[]float64{3.14, 15.926, 5.3589, 79.0},
+		[]int64{3, 1, 4, 1},
+	}
+
+	for _, want := range tests {
+		x := ts.MustOfSlice(want)
+		got := x.Vals()
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected slice: %v\n", want)
+			t.Errorf("Got slice: %v\n", got)
+		}
+	}
+
+	// non-contiguous tensor
+	x := ts.MustOfSlice([]int64{1, 2, 3, 4, 5, 6}).MustView([]int64{2, 3}, true).MustT(true)
+	want := []int64{1, 4, 2, 5, 3, 6}
+	got := x.Vals()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected slice: %v\n", want)
+		t.Errorf("Got slice: %v\n", got)
+	}
+}
+
+func TestTensorCPUVals(t *testing.T) {
+	want := []float32{3.14, 15.926, 5.3589, 79.0}
+	x := ts.MustOfSlice(want)
+	got, err := x.CPUVals()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected slice: %v\n", want)
+		t.Errorf("Got slice: %v\n", got)
+	}
+
+	if !gotch.CudaIsAvailable() {
+		return
+	}
+	xCuda := x.MustTo(gotch.CudaIfAvailable(), false)
+	if _, err := xCuda.CPUVals(); err == nil {
+		t.Errorf("Expected error for a CUDA tensor, got nil\n")
+	}
+	if got := xCuda.Vals(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected slice: %v\n", want)
+		t.Errorf("Got slice: %v\n", got)
+	}
+}
+
+func TestItem(t *testing.T) {
+	// 0-dim tensor
+	x := ts.MustOfSlice([]float64{1.0, 1.5}).MustSum(gotch.Double, true)
+	got, err := x.Float64Item()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 2.5 {
+		t.Errorf("Expected value: %v\n", 2.5)
+		t.Errorf("Got value: %v\n", got)
+	}
+
+	// 1-element tensor keeps its dtype
+	y := ts.MustOfSlice([]int64{7})
+	item, err := y.Item()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(item, int64(7)) {
+		t.Errorf("Expected item: %v\n", int64(7))
+		t.Errorf("Got item: %v (%T)\n", item, item)
+	}
+	if got := y.MustFloat64Item(); got != 7.0 {
+		t.Errorf("Expected value: %v\n", 7.0)
+		t.Errorf("Got value: %v\n", got)
+	}
+
+	z := ts.MustOfSlice([]float32{1, 2, 3})
+	if _, err := z.Item(); err == nil {
+		t.Errorf("Expected error for Item() on multi-element tensor\n")
+	}
+	if _, err := z.Float64Item(); err == nil {
+		t.Errorf("Expected error for Float64Item() on multi-element tensor\n")
+	}
+	if _, err := z.Int64Item(); err == nil {
+		t.Errorf("Expected error for Int64Item() on multi-element tensor\n")
+	}
+}
+
+func TestToKindDevice(t *testing.T) {
+	x := ts.MustOfSlice([]float32{1.5, 2.0, -3.0})
+
+	y := x.MustToDType(gotch.Int64)
+	if y.DType() != gotch.Int64 {
+		t.Errorf("Expected dtype: %v\n", gotch.Int64)
+		t.Errorf("Got dtype: %v\n", y.DType())
+	}
+	want := []int64{1, 2, -3}
+	got := y.Int64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+
+	// no-op returns a new tensor sharing storage
+	z := x.MustToDevice(gotch.CPU, false)
+	if z.DType() != gotch.Float || z.MustDevice() != gotch.CPU {
+		t.Errorf("Expected Float tensor on CPU, got %v on %v\n", z.DType(), z.MustDevice())
+	}
+	z.MustDrop()
+	if !reflect.DeepEqual([]float64{1.5, 2.0, -3.0}, x.Float64Values()) {
+		t.Errorf("Expected original tensor unaffected by dropping the no-op result\n")
+	}
+
+	if gotch.CUDA.IsAvailable() {
+		device := gotch.CudaBuilder(0)
+		g := x.MustToKindDevice(gotch.Int64, device)
+		if g.DType() != gotch.Int64 || g.MustDevice() != device {
+			t.Errorf("Expected Int64 tensor on %v, got %v on %v\n", device, g.DType(), g.MustDevice())
+		}
+	}
+}
+
+func TestPinMemory(t *testing.T) {
+	if !gotch.CUDA.IsAvailable() {
+		t.Skip("CUDA is not available")
+	}
+
+	x := ts.MustOfSlice([]float32{1, 2, 3, 4})
+	if x.MustIsPinned() {
+		t.Errorf("Expected tensor not to be pinned\n")
+	}
+
+	pinned := x.MustPinMemory(false)
+	if !pinned.MustIsPinned() {
+		t.Errorf("Expected tensor to be pinned\n")
+	}
+
+	device := gotch.CudaBuilder(0)
+	g := pinned.MustToDevice(device, true)
+	if g.MustDevice() != device {
+		t.Errorf("Expected tensor on %v, got %v\n", device, g.MustDevice())
+	}
+	// reading values back synchronizes
+	if want, got := []float64{1, 2, 3, 4}, g.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected transferred values: %v\n", want)
+		t.Errorf("Got transferred values: %v\n", got)
+	}
+}
+
+func TestSetData_(t *testing.T) {
+	x := ts.MustZeros([]int64{2, 2}, gotch.Float, gotch.CPU)
+	x.MustRequiresGrad_(true)
+	other := ts.MustOfSlice([]float32{1, 2, 3, 4}).MustView([]int64{2, 2}, true)
+
+	x.MustSetData_(other)
+
+	want := []float64{1, 2, 3, 4}
+	got := x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected tensor values: %v\n", want)
+		t.Errorf("Got tensor values: %v\n", got)
+	}
+
+	// still a leaf tracking gradients
+	if !x.MustRequiresGrad() {
+		t.Errorf("Expected tensor to still require grad after SetData_\n")
+	}
+	x.MustSum(gotch.Float, false).MustBackward()
+	wantGrad := []float64{1, 1, 1, 1}
+	gotGrad := x.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(wantGrad, gotGrad) {
+		t.Errorf("Expected grad values: %v\n", wantGrad)
+		t.Errorf("Got grad values: %v\n", gotGrad)
+	}
+
+	err := x.SetData_(ts.MustOnes([]int64{4}, gotch.Float, gotch.CPU))
+	if err == nil {
+		t.Errorf("Expected error for mismatched shape, got nil\n")
+	}
+	err = x.SetData_(ts.MustOnes([]int64{2, 2}, gotch.Double, gotch.CPU))
+	if err == nil {
+		t.Errorf("Expected error for mismatched dtype, got nil\n")
+	}
+}
+
+func TestGradAccessors(t *testing.T) {
+	x := ts.MustOnes([]int64{3}, gotch.Float, gotch.CPU)
+	if x.MustRequiresGrad() {
+		t.Errorf("Expected new tensor not to require grad\n")
+	}
+	if x.MustGrad(false).MustDefined() {
+		t.Errorf("Expected grad of tensor without gradient to be undefined\n")
+	}
+
+	x = x.MustSetRequiresGrad(true, true)
+	if !x.MustRequiresGrad() {
+		t.Errorf("Expected tensor to require grad after SetRequiresGrad\n")
+	}
+
+	y := x.MustSum(gotch.Float, false)
+	y.MustBackward()
+
+	grad := x.MustGrad(false)
+	if !grad.MustDefined() {
+		t.Fatalf("Expected grad to be defined after backward\n")
+	}
+	want := []float64{1, 1, 1}
+	got := grad.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values: %v\n", want)
+		t.Errorf("Got grad values: %v\n", got)
+	}
+
+	x.ZeroGrad()
+	want = []float64{0, 0, 0}
+	got = x.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values after ZeroGrad: %v\n", want)
+		t.Errorf("Got grad values after ZeroGrad: %v\n", got)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3})
+	x.MustRequiresGrad_(true)
+
+	// loss = sum(x * x) => dloss/dx = 2x
+	loss := x.MustMul(x, false).MustSum(gotch.Double, true)
+	if err := loss.Backward(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []float64{2, 4, 6}
+	got := x.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values: %v\n", want)
+		t.Errorf("Got grad values: %v\n", got)
+	}
+
+	y := x.MustMul1(ts.FloatScalar(2.0), false)
+	if err := y.Backward(); err == nil {
+		t.Errorf("Expected error for backward on non-scalar tensor, got nil\n")
+	}
+}
+
+func TestRunBackward(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2})
+	x.MustRequiresGrad_(true)
+
+	// y = x^3 + 2x => dy/dx = 3x^2 + 2, d2y/dx2 = 6x
+	y := x.MustPow(ts.FloatScalar(3.0), false).MustAdd(x.MustMul1(ts.FloatScalar(2.0), false), true)
+
+	grads, err := ts.RunBackward([]ts.Tensor{*y}, []ts.Tensor{*x}, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{5, 14}
+	got := grads[0].Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values: %v\n", want)
+		t.Errorf("Got grad values: %v\n", got)
+	}
+
+	// leaf grads are not accumulated by RunBackward
+	if x.MustGrad(false).MustDefined() {
+		t.Errorf("Expected input grad to be undefined after RunBackward\n")
+	}
+
+	grads2 := ts.MustRunBackward([]ts.Tensor{grads[0]}, []ts.Tensor{*x}, false, false)
+	want = []float64{6, 12}
+	got = grads2[0].Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected second order grad values: %v\n", want)
+		t.Errorf("Got second order grad values: %v\n", got)
+	}
+}
+
+func TestClone(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3})
+	x.MustRequiresGrad_(true)
+
+	c := x.MustClone()
+	if !c.MustRequiresGrad() {
+		t.Errorf("Expected clone to stay in the autograd graph\n")
+	}
+
+	// clone has its own storage
+	ts.NoGrad(func() {
+		c.MustFill_(ts.FloatScalar(0.0))
+	})
+	want := []float64{1, 2, 3}
+	got := x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected source values unchanged: %v\n", want)
+		t.Errorf("Got source values: %v\n", got)
+	}
+
+	// gradients flow back through the clone
+	x.MustClone().MustSum(gotch.Double, true).MustBackward()
+	want = []float64{1, 1, 1}
+	got = x.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values: %v\n", want)
+		t.Errorf("Got grad values: %v\n", got)
+	}
+}
+
+func TestDetach(t *testing.T) {
+	x := ts.MustOfSlice([]float64{1, 2, 3})
+	x.MustRequiresGrad_(true)
+	w := ts.MustOfSlice([]float64{2, 2, 2})
+	w.MustRequiresGrad_(true)
+
+	// detached: backward populates w grad only.
+	d := x.MustDetach(false)
+	w.MustMul(d, false).MustSum(gotch.Double, true).MustBackward()
+	if x.MustGrad(false).MustDefined() {
+		t.Errorf("Expected no grad on source of detached tensor\n")
+	}
+	want := []float64{1, 2, 3}
+	got := w.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values: %v\n", want)
+		t.Errorf("Got grad values: %v\n", got)
+	}
+
+	// not detached: backward populates x grad.
+	w.MustMul(x, false).MustSum(gotch.Double, true).MustBackward()
+	want = []float64{2, 2, 2}
+	got = x.MustGrad(false).Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected grad values: %v\n", want)
+		t.Errorf("Got grad values: %v\n", got)
+	}
+
+	// detached tensor shares storage with its source.
+	d.MustFill_(ts.FloatScalar(5.0))
+	want = []float64{5, 5, 5}
+	got = x.Float64Values()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected source values: %v\n", want)
+		t.Errorf("Got source values: %v\n", got)
+	}
+}
+
+func TestNewTensorFromDataWithShape(t *testing.T) {
+	// 2D, inferred shape
+	x, err := ts.NewTensorFromDataWithShape([][]float32{{1, 2, 3}, {4, 5, 6}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int64{2, 3}, x.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+	if want, got := []float64{1, 2, 3, 4, 5, 6}, x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected values: %v\n", want)
+		t.Errorf("Got values: %v\n", got)
+	}
+
+	// 3D, inferred shape
+	data3D := [][][]int64{
+		{{1, 2}, {3, 4}, {5, 6}},
+		{{7, 8}, {9, 10}, {11, 12}},
+	}
+	y := ts.MustNewTensorFromDataWithShape(data3D, nil)
+	if want, got := []int64{2, 3, 2}, y.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+	if want, got := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, y.Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected values: %v\n", want)
+		t.Errorf("Got values: %v\n", got)
+	}
+	if y.DType() != gotch.Int64 {
+		t.Errorf("Expected dtype: %v\n", gotch.Int64)
+		t.Errorf("Got dtype: %v\n", y.DType())
+	}
+
+	// flat data with explicit shape
+	z := ts.MustNewTensorFromDataWithShape([]float64{1, 2, 3, 4, 5, 6}, []int64{3, 2})
+	if want, got := []int64{3, 2}, z.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+
+	// mismatched shape and data
+	if _, err := ts.NewTensorFromDataWithShape([]float64{1, 2, 3, 4, 5, 6}, []int64{4, 2}); err == nil {
+		t.Errorf("Expected error for shape and data mismatched, got nil\n")
+	}
+
+	// ragged data
+	if _, err := ts.NewTensorFromDataWithShape([][]float64{{1, 2}, {3}}, nil); err == nil {
+		t.Errorf("Expected error for ragged data, got nil\n")
+	}
+}
+
+func TestInferShape(t *testing.T) {
+	got, err := ts.InferShape([]int64{2, -1, 3}, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{2, 4, 3}; !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+
+	invalid := [][]int64{
+		{-1, -1},   // more than one inferred dim
+		{5, -1},    // 24 not divisible by 5
+		{4, 5},     // 20 elements
+		{-2, 12},   // negative size
+		{0, -1, 3}, // cannot infer with zero-sized dim
+	}
+	for _, shape := range invalid {
+		if _, err := ts.InferShape(shape, 24); err == nil {
+			t.Errorf("Expected error for shape %v with 24 elements, got nil\n", shape)
+		}
+	}
+
+	x := ts.MustNewTensorFromDataWithShape([]float64{1, 2, 3, 4, 5, 6}, []int64{-1, 2})
+	if want, got := []int64{3, 2}, x.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+}
+
+func TestViewPermute(t *testing.T) {
+	x := ts.MustArange(ts.IntScalar(6), gotch.Int64, gotch.CPU)
+
+	v := x.MustView([]int64{-1, 3}, false)
+	if want, got := []int64{2, 3}, v.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected view shape: %v\n", want)
+		t.Errorf("Got view shape: %v\n", got)
+	}
+
+	p := v.MustPermute([]int64{1, 0}, false)
+	if want, got := []int64{3, 2}, p.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected permuted shape: %v\n", want)
+		t.Errorf("Got permuted shape: %v\n", got)
+	}
+	if want, got := []int64{0, 3, 1, 4, 2, 5}, p.MustContiguous(false).Int64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected permuted values: %v\n", want)
+		t.Errorf("Got permuted values: %v\n", got)
+	}
+
+	if _, err := x.View([]int64{-1, 4}, false); err == nil {
+		t.Errorf("Expected error for view of 6 elements as [-1, 4], got nil\n")
+	}
+}
+
+func TestStackSplit(t *testing.T) {
+	var xs []ts.Tensor
+	for i := 0; i < 3; i++ {
+		x := ts.MustOnes([]int64{2, 2}, gotch.Float, gotch.CPU).MustMul1(ts.IntScalar(int64(i)), true)
+		xs = append(xs, *x)
+	}
+
+	stacked, err := ts.Stack(xs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int64{3, 2, 2}, stacked.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+
+	parts, err := stacked.Split(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("Expected 3 parts, got %v\n", len(parts))
+	}
+	for i, part := range parts {
+		want := xs[i].Float64Values()
+		got := part.MustSqueeze1(0, false).Float64Values()
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("Expected part %v values: %v\n", i, want)
+			t.Errorf("Got part %v values: %v\n", i, got)
+		}
+	}
+
+	chunks := stacked.MustChunk(3, 0, false)
+	if len(chunks) != 3 {
+		t.Errorf("Expected 3 chunks, got %v\n", len(chunks))
+	}
+
+	cat, err := ts.Cat(xs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int64{6, 2}, cat.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+}
+
+func TestStackCatEmpty(t *testing.T) {
+	if _, err := ts.Stack([]ts.Tensor{}, 0); err == nil {
+		t.Errorf("Expected error for stacking empty tensor list, got nil\n")
+	}
+	if _, err := ts.Cat(nil, 0); err == nil {
+		t.Errorf("Expected error for concatenating empty tensor list, got nil\n")
+	}
+
+	// the receiver is not dropped when the input is rejected
+	x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+	if _, err := x.Index(nil, true); err == nil {
+		t.Errorf("Expected error for indexing with empty tensor list, got nil\n")
+	}
+	want := []float64{1, 1}
+	if got := x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected receiver values after error: %v\n", want)
+		t.Errorf("Got receiver values: %v\n", got)
+	}
+}
+
+// gradEnabled reports the current global grad mode without changing it.
+func gradEnabled() bool {
+	state := ts.MustGradSetEnabled(true)
+	ts.MustGradSetEnabled(state)
+	return state
+}
+
+func TestNoGrad(t *testing.T) {
+	x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+	x.MustRequiresGrad_(true)
+
+	var y *ts.Tensor
+	ts.NoGrad(func() {
+		y = x.MustMul1(ts.FloatScalar(2.0), false)
+	})
+	if y.MustRequiresGrad() {
+		t.Errorf("Expected tensor created inside NoGrad not to require grad\n")
+	}
+	if !gradEnabled() {
+		t.Errorf("Expected grad mode to be restored after NoGrad\n")
+	}
+}
+
+func TestNoGradNested(t *testing.T) {
+	x := ts.MustOnes([]int64{2}, gotch.Float, gotch.CPU)
+	x.MustRequiresGrad_(true)
+
+	var inner, outer *ts.Tensor
+	ts.NoGrad(func() {
+		ts.WithGrad(func() {
+			inner = x.MustMul1(ts.FloatScalar(2.0), false)
+		})
+		// WithGrad must restore the outer no-grad state.
+		outer = x.MustMul1(ts.FloatScalar(2.0), false)
+	})
+
+	if !inner.MustRequiresGrad() {
+		t.Errorf("Expected tensor created inside WithGrad to require grad\n")
+	}
+	if outer.MustRequiresGrad() {
+		t.Errorf("Expected outer NoGrad state to be restored after WithGrad\n")
+	}
+	if !gradEnabled() {
+		t.Errorf("Expected grad mode to be restored after nested scopes\n")
+	}
+}
+
+func TestNoGradPanic(t *testing.T) {
+	func() {
+		defer func() { recover() }()
+		ts.NoGrad(func() {
+			panic("boom")
+		})
+	}()
+
+	if !gradEnabled() {
+		t.Errorf("Expected grad mode to be restored after panic inside NoGrad\n")
+	}
+}
+
+func TestGradSetEnabled(t *testing.T) {
+	prev, err := ts.GradSetEnabled(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !prev {
+		t.Errorf("Expected previous grad mode to be enabled\n")
+	}
+
+	prev, err = ts.GradSetEnabled(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prev {
+		t.Errorf("Expected previous grad mode to be disabled\n")
+	}
+}
+
 /*
  *     let xs = Tensor::of_slice(&[0, 1, 2, 3]);
  *     let onehot = xs.onehot(4);
@@ -118,3 +886,122 @@ func TestOnehot(t *testing.T) {
  *         vec![1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]
  *     );
  *     assert_eq!(onehot.size(), vec![4, 4]) */
+
+func TestMaskedOps(t *testing.T) {
+	scores := ts.MustOnes([]int64{2, 2}, gotch.Float, gotch.CPU)
+	// causal mask: true above the diagonal
+	// [[false true]
+	//  [false false]]
+	mask := ts.MustOnes([]int64{2, 2}, gotch.Float, gotch.CPU).MustTriu(1, true).MustEq(ts.FloatScalar(1), true)
+
+	filled := scores.MustMaskedFill(mask, ts.FloatScalar(math.Inf(-1)), false)
+	want := []float64{1, math.Inf(-1), 1, 1}
+	if got := filled.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected masked fill values: %v\n", want)
+		t.Errorf("Got masked fill values: %v\n", got)
+	}
+
+	probs := filled.MustSoftmax(-1, gotch.Float, true)
+	wantProbs := []float64{1, 0, 0.5, 0.5}
+	if got := probs.Float64Values(); !reflect.DeepEqual(wantProbs, got) {
+		t.Errorf("Expected attention probs: %v\n", wantProbs)
+		t.Errorf("Got attention probs: %v\n", got)
+	}
+
+	// in-place
+	inplace := scores.MustShallowClone()
+	inplace.MustMaskedFill_(mask, ts.FloatScalar(0))
+	if want, got := []float64{1, 0, 1, 1}, inplace.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected in-place masked fill values: %v\n", want)
+		t.Errorf("Got in-place masked fill values: %v\n", got)
+	}
+
+	x := ts.MustArange(ts.IntScalar(4), gotch.Float, gotch.CPU).MustView([]int64{2, 2}, true)
+	selected := x.MustMaskedSelect(mask, false)
+	if want, got := []float64{1}, selected.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected masked select values: %v\n", want)
+		t.Errorf("Got masked select values: %v\n", got)
+	}
+
+	// where(mask, x, -x)
+	neg := x.MustNeg(false)
+	where := x.MustWhere1(mask, neg, false)
+	if want, got := []float64{0, 1, -2, -3}, where.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected where values: %v\n", want)
+		t.Errorf("Got where values: %v\n", got)
+	}
+
+	// mask should be a bool tensor broadcastable to the input
+	floatMask := ts.MustOnes([]int64{2, 2}, gotch.Float, gotch.CPU)
+	if _, err := x.MaskedFill(floatMask, ts.FloatScalar(0), false); err == nil {
+		t.Errorf("Expected error for non-bool mask, got nil\n")
+	}
+	badMask := ts.MustOnes([]int64{3}, gotch.Float, gotch.CPU).MustEq(ts.FloatScalar(1), true)
+	if _, err := x.MaskedSelect(badMask, false); err == nil {
+		t.Errorf("Expected error for non-broadcastable mask, got nil\n")
+	}
+}
+
+func TestHalfTensor(t *testing.T) {
+	data := []float32{1.0, -2.5, 0.1, 1000.3}
+
+	for _, dtype := range []gotch.DType{gotch.Half, gotch.BFloat16} {
+		x := ts.MustOfSliceKind(data, dtype)
+		if x.DType() != dtype {
+			t.Errorf("Expected dtype: %v\n", dtype)
+			t.Errorf("Got dtype: %v\n", x.DType())
+		}
+
+		size, err := gotch.DTypeSize(x.DType())
+		if err != nil || size != 2 {
+			t.Errorf("Expected %v element size: 2\n", dtype)
+			t.Errorf("Got %v element size: %v (err: %v)\n", dtype, size, err)
+		}
+
+		// read back raw values
+		var got []float32
+		switch vals := x.Vals().(type) {
+		case []gotch.GoFloat16:
+			got = gotch.HalfToFloat32s(vals)
+		case []gotch.GoBFloat16:
+			got = gotch.BFloat16ToFloat32s(vals)
+		default:
+			t.Fatalf("Unexpected %v slice type: %T\n", dtype, vals)
+		}
+		for i := range data {
+			if math.Abs(float64(got[i]-data[i])) > math.Abs(float64(data[i]))/128 {
+				t.Errorf("Expected %v values close to: %v\n", dtype, data)
+				t.Errorf("Got %v values: %v\n", dtype, got)
+				break
+			}
+		}
+
+		// upcast
+		f := x.MustToDType(gotch.Float)
+		if f.DType() != gotch.Float {
+			t.Errorf("Expected upcast dtype: %v\n", gotch.Float)
+			t.Errorf("Got upcast dtype: %v\n", f.DType())
+		}
+		upcast := f.Vals().([]float32)
+		if !reflect.DeepEqual(got, upcast) {
+			t.Errorf("Expected upcast values: %v\n", got)
+			t.Errorf("Got upcast values: %v\n", upcast)
+		}
+
+		x.MustDrop()
+		f.MustDrop()
+	}
+
+	// half values converted in Go
+	h := ts.MustOfSlice(gotch.Float32sToHalf(data))
+	if want, got := gotch.Half, h.DType(); want != got {
+		t.Errorf("Expected dtype: %v\n", want)
+		t.Errorf("Got dtype: %v\n", got)
+	}
+	want := gotch.HalfToFloat32s(gotch.Float32sToHalf(data))
+	got := h.MustToDType(gotch.Float).Vals().([]float32)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected half values: %v\n", want)
+		t.Errorf("Got half values: %v\n", got)
+	}
+}
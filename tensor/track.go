@@ -0,0 +1,98 @@
+package tensor
+
+// Tracking of live tensors to help finding C memory leaks.
+
+import (
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	lib "github.com/sugarme/gotch/libtch"
+)
+
+// TensorInfo describes a tracked tensor that has not been dropped yet.
+type TensorInfo struct {
+	ID    uint64  // creation order
+	Shape []int64 // nil if the shape can not be read
+	Stack string  // stack trace at creation
+}
+
+var (
+	trackEnabled int32
+	tracker      = struct {
+		sync.Mutex
+		nextID uint64
+		live   map[lib.Ctensor]TensorInfo
+	}{live: make(map[lib.Ctensor]TensorInfo)}
+)
+
+// SetTrackTensors enables or disables recording of each allocated tensor with
+// the stack trace of its creation. Disabling clears all records.
+//
+// NOTE: capturing stack traces is slow. It is meant for debugging only.
+func SetTrackTensors(b bool) {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	if b {
+		atomic.StoreInt32(&trackEnabled, 1)
+		return
+	}
+
+	atomic.StoreInt32(&trackEnabled, 0)
+	tracker.live = make(map[lib.Ctensor]TensorInfo)
+}
+
+// LiveTensorCount returns the number of tracked tensors not dropped yet.
+func LiveTensorCount() int {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	return len(tracker.live)
+}
+
+// ReportLiveTensors returns tracked tensors not dropped yet in creation order.
+func ReportLiveTensors() []TensorInfo {
+	tracker.Lock()
+	var retVal []TensorInfo
+	for ctensor, info := range tracker.live {
+		info.Shape, _ = (&Tensor{ctensor: ctensor}).Size()
+		retVal = append(retVal, info)
+	}
+	tracker.Unlock()
+
+	sort.Slice(retVal, func(i, j int) bool {
+		return retVal[i].ID < retVal[j].ID
+	})
+
+	return retVal
+}
+
+// newTensor wraps a C tensor, recording it if tracking is enabled and
+// attaching a finalizer if auto free is enabled.
+func newTensor(ctensor lib.Ctensor) *Tensor {
+	if atomic.LoadInt32(&trackEnabled) == 1 {
+		stack := string(debug.Stack())
+		tracker.Lock()
+		tracker.nextID++
+		tracker.live[ctensor] = TensorInfo{ID: tracker.nextID, Stack: stack}
+		tracker.Unlock()
+	}
+
+	ts := &Tensor{ctensor: ctensor}
+	if atomic.LoadInt32(&autoFreeEnabled) == 1 {
+		ts.guard = newTensorGuard(ctensor)
+	}
+
+	return ts
+}
+
+// untrackTensor removes a dropped C tensor from the records.
+func untrackTensor(ctensor lib.Ctensor) {
+	if atomic.LoadInt32(&trackEnabled) == 1 {
+		tracker.Lock()
+		delete(tracker.live, ctensor)
+		tracker.Unlock()
+	}
+}
@@ -0,0 +1,40 @@
+package tensor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+func TestTrackTensors(t *testing.T) {
+	ts.SetTrackTensors(true)
+	defer ts.SetTrackTensors(false)
+
+	baseline := ts.LiveTensorCount()
+
+	x := ts.MustOnes([]int64{2, 3}, gotch.Float, gotch.CPU)
+	y := x.MustMul1(ts.FloatScalar(2.0), false)
+
+	if want, got := baseline+2, ts.LiveTensorCount(); want != got {
+		t.Errorf("Expected live tensor count: %v\n", want)
+		t.Errorf("Got live tensor count: %v\n", got)
+	}
+
+	infos := ts.ReportLiveTensors()
+	last := infos[len(infos)-1]
+	if last.Shape == nil || last.Shape[0] != 2 || last.Shape[1] != 3 {
+		t.Errorf("Expected shape of last live tensor: [2 3], got %v\n", last.Shape)
+	}
+	if !strings.Contains(last.Stack, "TestTrackTensors") {
+		t.Errorf("Expected stack trace mentioning the test, got:\n%v\n", last.Stack)
+	}
+
+	x.MustDrop()
+	y.MustDrop()
+	if want, got := baseline, ts.LiveTensorCount(); want != got {
+		t.Errorf("Expected live tensor count back to baseline: %v\n", want)
+		t.Errorf("Got live tensor count: %v\n", got)
+	}
+}
@@ -74,7 +74,7 @@ func EncodeTensor(w *bytes.Buffer, v reflect.Value, shape []int64) error {
 		if err := w.WriteByte(b); err != nil {
 			return err
 		}
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 		if err := binary.Write(w, nativeEndian, v.Interface()); err != nil {
 			return err
 		}
@@ -92,7 +92,7 @@ func EncodeTensor(w *bytes.Buffer, v reflect.Value, shape []int64) error {
 		// Optimisation: if only one dimension is left we can use binary.Write() directly for this slice
 		if len(shape) == 1 && v.Len() > 0 {
 			switch v.Index(0).Kind() {
-			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 				return binary.Write(w, nativeEndian, v.Interface())
 			}
 		}
@@ -121,7 +121,7 @@ func DecodeTensor(r *bytes.Reader, shape []int64, typ reflect.Type, ptr reflect.
 			return err
 		}
 		ptr.Elem().SetBool(b == 1)
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 		if err := binary.Read(r, nativeEndian, ptr.Interface()); err != nil {
 			return err
 		}
@@ -133,7 +133,7 @@ func DecodeTensor(r *bytes.Reader, shape []int64, typ reflect.Type, ptr reflect.
 		// Optimization: if only one dimension is left we can use binary.Read() directly for this slice
 		if len(shape) == 1 && val.Len() > 0 {
 			switch val.Index(0).Kind() {
-			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+			case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
 				return binary.Read(r, nativeEndian, val.Interface())
 			}
 		}
@@ -159,6 +159,50 @@ func ElementCount(shape []int64) int64 {
 	return n
 }
 
+// InferShape resolves a shape for a tensor of numel elements, e.g. for
+// `View` or `Reshape`. At most one dimension can be -1, in which case its size
+// is inferred from numel and the other dimensions.
+//
+// It returns an error if the shape has more than one -1, a negative size, or
+// does not match numel.
+func InferShape(shape []int64, numel int64) ([]int64, error) {
+	inferIdx := -1
+	known := int64(1)
+	for i, d := range shape {
+		switch {
+		case d == -1 && inferIdx >= 0:
+			err := fmt.Errorf("InferShape() failed: only one dimension can be inferred, got shape %v.\n", shape)
+			return nil, err
+		case d == -1:
+			inferIdx = i
+		case d < 0:
+			err := fmt.Errorf("InferShape() failed: invalid size %v at dim %v of shape %v.\n", d, i, shape)
+			return nil, err
+		default:
+			known *= d
+		}
+	}
+
+	retVal := make([]int64, len(shape))
+	copy(retVal, shape)
+
+	if inferIdx < 0 {
+		if known != numel {
+			err := fmt.Errorf("InferShape() failed: shape %v (%v elements) mismatched with %v elements.\n", shape, known, numel)
+			return nil, err
+		}
+		return retVal, nil
+	}
+
+	if known == 0 || numel%known != 0 {
+		err := fmt.Errorf("InferShape() failed: shape %v cannot hold %v elements.\n", shape, numel)
+		return nil, err
+	}
+	retVal[inferIdx] = numel / known
+
+	return retVal, nil
+}
+
 // DataDim returns number of elements in data
 // NOTE: only support scalar and (nested) slice/array of scalar type
 func DataDim(data interface{}) (retVal int, err error) {
@@ -199,7 +243,7 @@ func dataCheck(data interface{}, count int) (k reflect.Type, n int, err error) {
 
 		return goType, total, nil
 
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		total++
 		if goType.String() != "invalid" {
 			goType = v.Type()
@@ -212,6 +256,45 @@ func dataCheck(data interface{}, count int) (k reflect.Type, n int, err error) {
 	return goType, total, nil
 }
 
+// DataShape infers the shape of (nested) slice/array data, e.g. a
+// `[][]float32` of 2 rows of 3 elements has shape [2, 3]. A scalar has an
+// empty shape.
+//
+// It returns an error if data is ragged (sub-slices of different lengths) or
+// its element type is not supported.
+func DataShape(data interface{}) ([]int64, error) {
+	v := reflect.ValueOf(data)
+	switch v.Kind() {
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
+		return []int64{}, nil
+	case reflect.Slice, reflect.Array:
+		shape := []int64{int64(v.Len())}
+		if v.Len() == 0 {
+			return shape, nil
+		}
+
+		subShape, err := DataShape(v.Index(0).Interface())
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < v.Len(); i++ {
+			s, err := DataShape(v.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			if !reflect.DeepEqual(s, subShape) {
+				err = fmt.Errorf("Input Data: ragged data, element %v has shape %v, expected %v\n", i, s, subShape)
+				return nil, err
+			}
+		}
+
+		return append(shape, subShape...), nil
+	default:
+		err := fmt.Errorf("Input Data: unsupported data structure or type: %v\n", v.Kind())
+		return nil, err
+	}
+}
+
 // DataAsPtr write to C memory and returns a C pointer.
 //
 // NOTE:
@@ -311,6 +394,13 @@ func FlattenData(data interface{}) (fData interface{}, err error) {
 			retVal = append(retVal, v.(int16))
 		}
 		return retVal, nil
+	case reflect.Uint16:
+		// Half precision types, e.g. []gotch.GoFloat16.
+		retVal := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(ele)), 0, len(flat))
+		for _, v := range flat {
+			retVal = reflect.Append(retVal, reflect.ValueOf(v))
+		}
+		return retVal.Interface(), nil
 	case reflect.Int32:
 		var retVal []int32
 		for _, v := range flat {
@@ -335,6 +425,18 @@ func FlattenData(data interface{}) (fData interface{}, err error) {
 			retVal = append(retVal, v.(float64))
 		}
 		return retVal, nil
+	case reflect.Complex64:
+		var retVal []complex64
+		for _, v := range flat {
+			retVal = append(retVal, v.(complex64))
+		}
+		return retVal, nil
+	case reflect.Complex128:
+		var retVal []complex128
+		for _, v := range flat {
+			retVal = append(retVal, v.(complex128))
+		}
+		return retVal, nil
 	case reflect.Bool:
 		var retVal []bool
 		for _, v := range flat {
@@ -370,7 +472,7 @@ func flattenData(data interface{}, round int, flat []interface{}) (f []interface
 
 		return flatData, nil
 
-	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool:
+	case reflect.Uint8, reflect.Int8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Bool:
 		flatData = append(flatData, data)
 	}
 
@@ -32,7 +32,9 @@ func chwToHWC(tensor *ts.Tensor) *ts.Tensor {
 
 // Load loads an image from a file.
 //
-// On success returns a tensor of shape [channel, height, width].
+// On success returns a uint8 tensor of shape [3, height, width] with values
+// in [0, 255]. Grayscale images are expanded to 3 (RGB) channels and the
+// alpha channel, if any, is dropped.
 func Load(path string) (*ts.Tensor, error) {
 	var tensor *ts.Tensor
 	tensor, err := ts.LoadHwc(path)
@@ -49,7 +51,8 @@ func Load(path string) (*ts.Tensor, error) {
 // The image format is based on the filename suffix, supported suffixes
 // are jpg, png, tga, and bmp.
 // The tensor input should be of kind UInt8 with values ranging from
-// 0 to 255.
+// 0 to 255. Supported number of channels are 1 (grayscale), 3 (RGB) and
+// 4 (RGBA).
 func Save(tensor *ts.Tensor, path string) error {
 	t, err := tensor.Totype(gotch.Uint8, false) // false to keep the input tensor
 	if err != nil {
@@ -63,6 +66,19 @@ func Save(tensor *ts.Tensor, path string) error {
 		return err
 	}
 
+	var channels int64
+	switch len(shape) {
+	case 3:
+		channels = shape[0]
+	case 4:
+		channels = shape[1]
+	}
+	if channels != 0 && channels != 1 && channels != 3 && channels != 4 {
+		t.MustDrop()
+		err = fmt.Errorf("Save - unsupported number of channels (%v), expected 1, 3 or 4.\n", channels)
+		return err
+	}
+
 	switch {
 	case len(shape) == 4 && shape[0] == 1:
 		return ts.SaveHwc(chwToHWC(t.MustSqueeze1(int64(0), true).MustTo(gotch.CPU, true)), path)
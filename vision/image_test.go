@@ -0,0 +1,129 @@
+package vision_test
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+	"github.com/sugarme/gotch/vision"
+)
+
+func writePNG(t *testing.T, path string, img image.Image) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-image")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// 4x2 RGB image with pixel (x, y) = (10*x, 20*y, 100)
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, color.NRGBA{uint8(10 * x), uint8(20 * y), 100, 255})
+		}
+	}
+	path := filepath.Join(dir, "rgb.png")
+	writePNG(t, path, img)
+
+	x, err := vision.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int64{3, 2, 4}, x.MustSize(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected shape: %v\n", want)
+		t.Errorf("Got shape: %v\n", got)
+	}
+	if x.DType() != gotch.Uint8 {
+		t.Errorf("Expected dtype: %v\n", gotch.Uint8)
+		t.Errorf("Got dtype: %v\n", x.DType())
+	}
+	want := []float64{
+		0, 10, 20, 30, 0, 10, 20, 30, // red
+		0, 0, 0, 0, 20, 20, 20, 20, // green
+		100, 100, 100, 100, 100, 100, 100, 100, // blue
+	}
+	if got := x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected values: %v\n", want)
+		t.Errorf("Got values: %v\n", got)
+	}
+
+	// round trip
+	outPath := filepath.Join(dir, "out.png")
+	if err := vision.Save(x, outPath); err != nil {
+		t.Fatal(err)
+	}
+	y, err := vision.Load(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := y.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected round-trip values: %v\n", want)
+		t.Errorf("Got round-trip values: %v\n", got)
+	}
+}
+
+func TestLoadSaveGray(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotch-image")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	img := image.NewGray(image.Rect(0, 0, 3, 1))
+	for x := 0; x < 3; x++ {
+		img.SetGray(x, 0, color.Gray{uint8(50 * x)})
+	}
+	path := filepath.Join(dir, "gray.png")
+	writePNG(t, path, img)
+
+	// grayscale is expanded to 3 channels
+	x, err := vision.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{0, 50, 100, 0, 50, 100, 0, 50, 100}
+	if got := x.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected values: %v\n", want)
+		t.Errorf("Got values: %v\n", got)
+	}
+
+	// 1-channel tensor can be saved
+	gray := x.MustNarrow(0, 0, 1, false)
+	outPath := filepath.Join(dir, "out.png")
+	if err := vision.Save(gray, outPath); err != nil {
+		t.Fatal(err)
+	}
+	y, err := vision.Load(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := y.Float64Values(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected round-trip values: %v\n", want)
+		t.Errorf("Got round-trip values: %v\n", got)
+	}
+
+	// unsupported number of channels
+	invalid := ts.MustZeros([]int64{2, 1, 3}, gotch.Uint8, gotch.CPU)
+	if err := vision.Save(invalid, filepath.Join(dir, "invalid.png")); err == nil {
+		t.Errorf("Expected error for 2-channel image, got nil\n")
+	}
+}
@@ -0,0 +1,42 @@
+package vision
+
+// Losses commonly used for image generation and restoration.
+
+import (
+	"log"
+
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// TotalVariationLoss computes the total variation of a batch of images.
+//
+// It is the sum of absolute differences between vertically and horizontally
+// neighbouring pixels and is used as a smoothness regularizer for generated
+// or denoised images. The input should have shape [N, C, H, W].
+func TotalVariationLoss(img *ts.Tensor) *ts.Tensor {
+	size, err := img.Size4()
+	if err != nil {
+		log.Fatalf("TotalVariationLoss - expected an input of shape [N, C, H, W]: %v\n", err)
+	}
+	h, w := size[2], size[3]
+	dtype := img.DType()
+
+	// vertical neighbours
+	bottom := img.MustNarrow(2, 1, h-1, false)
+	top := img.MustNarrow(2, 0, h-1, false)
+	diffH := bottom.MustSub(top, true)
+	top.MustDrop()
+	tvH := diffH.MustAbs(true).MustSum(dtype, true)
+
+	// horizontal neighbours
+	right := img.MustNarrow(3, 1, w-1, false)
+	left := img.MustNarrow(3, 0, w-1, false)
+	diffW := right.MustSub(left, true)
+	left.MustDrop()
+	tvW := diffW.MustAbs(true).MustSum(dtype, true)
+
+	retVal := tvH.MustAdd(tvW, true)
+	tvW.MustDrop()
+
+	return retVal
+}
@@ -0,0 +1,37 @@
+package vision_test
+
+import (
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+	"github.com/sugarme/gotch/vision"
+)
+
+func TestTotalVariationLoss(t *testing.T) {
+	// constant image has no variation
+	constant := ts.MustOnes([]int64{1, 3, 4, 4}, gotch.Float, gotch.CPU)
+	tv := vision.TotalVariationLoss(constant)
+	got := tv.Float64Values()[0]
+	if got != 0.0 {
+		t.Errorf("Expected total variation of a constant image: 0\n")
+		t.Errorf("Got total variation: %v\n", got)
+	}
+
+	// 4x4 checkerboard: every pixel differs by 1 from each neighbour.
+	// 4 rows x 3 horizontal pairs + 4 columns x 3 vertical pairs = 24
+	var data []float32
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			data = append(data, float32((i+j)%2))
+		}
+	}
+	checkerboard := ts.MustOfSlice(data).MustView([]int64{1, 1, 4, 4}, true)
+	tv = vision.TotalVariationLoss(checkerboard)
+	got = tv.Float64Values()[0]
+	want := 24.0
+	if got != want {
+		t.Errorf("Expected total variation of a checkerboard: %v\n", want)
+		t.Errorf("Got total variation: %v\n", got)
+	}
+}
@@ -0,0 +1,116 @@
+package vision
+
+// Image quality metrics.
+
+import (
+	"log"
+	"math"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+)
+
+// PSNR computes the peak signal-to-noise ratio (in dB) between two images.
+//
+// maxVal is the maximum possible pixel value (e.g. 1.0 or 255.0). It returns
+// +Inf if the two images are identical.
+func PSNR(x, y *ts.Tensor, maxVal float64) float64 {
+	mseTs := x.MustMseLoss(y, int64(ts.ReductionMean.ToInt()), false)
+	mse := mseTs.Float64Values()[0]
+	mseTs.MustDrop()
+
+	if mse == 0 {
+		return math.Inf(1)
+	}
+
+	return 10 * math.Log10(maxVal*maxVal/mse)
+}
+
+// SSIM computes the mean structural similarity index between two batches of
+// images of shape [N, C, H, W].
+//
+// Local statistics are computed with a Gaussian window of size `windowSize`
+// (sigma 1.5). Pixel values are expected to be in range [0, 1]. It returns a
+// scalar tensor which equals 1 for identical images.
+func SSIM(x, y *ts.Tensor, windowSize int64) *ts.Tensor {
+	size, err := x.Size4()
+	if err != nil {
+		log.Fatalf("SSIM - expected an input of shape [N, C, H, W]: %v\n", err)
+	}
+	channels := size[1]
+
+	const (
+		c1 = 0.01 * 0.01
+		c2 = 0.03 * 0.03
+	)
+
+	window := gaussianWindow(windowSize, 1.5, channels, x.DType(), x.MustDevice())
+	defer window.MustDrop()
+
+	pad := windowSize / 2
+	filter := func(t *ts.Tensor) *ts.Tensor {
+		return ts.MustConv2d(t, window, ts.NewTensor(), []int64{1, 1}, []int64{pad, pad}, []int64{1, 1}, channels)
+	}
+
+	mu1 := filter(x)
+	mu2 := filter(y)
+	mu1Sq := mu1.MustMul(mu1, false)
+	mu2Sq := mu2.MustMul(mu2, false)
+	mu12 := mu1.MustMul(mu2, true)
+	mu2.MustDrop()
+
+	xx := x.MustMul(x, false)
+	sigma1Sq := filter(xx).MustSub(mu1Sq, true)
+	xx.MustDrop()
+	yy := y.MustMul(y, false)
+	sigma2Sq := filter(yy).MustSub(mu2Sq, true)
+	yy.MustDrop()
+	xy := x.MustMul(y, false)
+	sigma12 := filter(xy).MustSub(mu12, true)
+	xy.MustDrop()
+
+	// numerator: (2*mu1*mu2 + c1) * (2*sigma12 + c2)
+	num1 := mu12.MustMul1(ts.FloatScalar(2.0), true).MustAdd1(ts.FloatScalar(c1), true)
+	num2 := sigma12.MustMul1(ts.FloatScalar(2.0), true).MustAdd1(ts.FloatScalar(c2), true)
+	num := num1.MustMul(num2, true)
+	num2.MustDrop()
+
+	// denominator: (mu1^2 + mu2^2 + c1) * (sigma1^2 + sigma2^2 + c2)
+	den1 := mu1Sq.MustAdd(mu2Sq, true).MustAdd1(ts.FloatScalar(c1), true)
+	mu2Sq.MustDrop()
+	den2 := sigma1Sq.MustAdd(sigma2Sq, true).MustAdd1(ts.FloatScalar(c2), true)
+	sigma2Sq.MustDrop()
+	den := den1.MustMul(den2, true)
+	den2.MustDrop()
+
+	ssimMap := num.MustDiv(den, true)
+	den.MustDrop()
+
+	return ssimMap.MustMean(x.DType(), true)
+}
+
+// gaussianWindow creates a depthwise convolution weight of shape
+// [channels, 1, size, size] holding a normalized 2D Gaussian kernel.
+func gaussianWindow(size int64, sigma float64, channels int64, dtype gotch.DType, device gotch.Device) *ts.Tensor {
+	g := make([]float64, size)
+	center := float64(size-1) / 2.0
+	var sum float64
+	for i := range g {
+		d := float64(i) - center
+		g[i] = math.Exp(-d * d / (2 * sigma * sigma))
+		sum += g[i]
+	}
+
+	data := make([]float64, size*size)
+	for i := int64(0); i < size; i++ {
+		for j := int64(0); j < size; j++ {
+			data[i*size+j] = g[i] * g[j] / (sum * sum)
+		}
+	}
+
+	return ts.MustOfSlice(data).
+		MustView([]int64{1, 1, size, size}, true).
+		MustRepeat([]int64{channels, 1, 1, 1}, true).
+		MustTotype(dtype, true).
+		MustTo(device, true)
+}
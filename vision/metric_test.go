@@ -0,0 +1,44 @@
+package vision_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sugarme/gotch"
+	ts "github.com/sugarme/gotch/tensor"
+	"github.com/sugarme/gotch/vision"
+)
+
+func TestPSNR(t *testing.T) {
+	x := ts.MustRand([]int64{1, 3, 8, 8}, gotch.Float, gotch.CPU)
+
+	got := vision.PSNR(x, x, 1.0)
+	if !math.IsInf(got, 1) {
+		t.Errorf("Expected PSNR of identical images: +Inf\n")
+		t.Errorf("Got PSNR: %v\n", got)
+	}
+
+	// MSE = 0.01 => PSNR = 10 * log10(1/0.01) = 20dB
+	y := x.MustAdd1(ts.FloatScalar(0.1), false)
+	got = vision.PSNR(x, y, 1.0)
+	if math.Abs(got-20.0) > 1e-3 {
+		t.Errorf("Expected PSNR: 20.0\n")
+		t.Errorf("Got PSNR: %v\n", got)
+	}
+}
+
+func TestSSIM(t *testing.T) {
+	x := ts.MustRand([]int64{2, 3, 16, 16}, gotch.Float, gotch.CPU)
+
+	got := vision.SSIM(x, x, 11).Float64Values()[0]
+	if math.Abs(got-1.0) > 1e-4 {
+		t.Errorf("Expected SSIM of identical images: 1.0\n")
+		t.Errorf("Got SSIM: %v\n", got)
+	}
+
+	y := ts.MustRand([]int64{2, 3, 16, 16}, gotch.Float, gotch.CPU)
+	got = vision.SSIM(x, y, 11).Float64Values()[0]
+	if got >= 1.0 {
+		t.Errorf("Expected SSIM of different images < 1.0, got %v\n", got)
+	}
+}